@@ -0,0 +1,590 @@
+// Package ipc encodes and decodes query results in the Apache Arrow IPC streaming format.
+//
+// Every table is written as its own stream: a schema message, a record batch for
+// every buffer of the table and the end of stream marker. The name of the result and
+// the group key of the table are part of the custom metadata of the schema, so that
+// Arrow readers can load the tables without knowing about flux, for example with
+// pyarrow.ipc.open_stream, called once for every table.
+package ipc
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	arrowipc "github.com/apache/arrow/go/arrow/ipc"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/iocounter"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/values"
+	"github.com/pkg/errors"
+)
+
+// The keys of the custom metadata of the schema of a table.
+const (
+	// ResultMetadataKey is the name of the result of the table.
+	ResultMetadataKey = "flux.result"
+	// GroupKeyMetadataKey is a JSON array with the labels of the group key columns.
+	GroupKeyMetadataKey = "flux.groupKey"
+	// GroupKeyValuesMetadataKey is a JSON array with the values of the group key columns,
+	// formatted as strings, or null. Times are RFC3339 timestamps.
+	GroupKeyValuesMetadataKey = "flux.groupKeyValues"
+	// ErrorMetadataKey is the message of an error that ended the results.
+	// The schema of an error has no fields.
+	ErrorMetadataKey = "flux.error"
+)
+
+const defaultResultName = "_result"
+
+// ResultEncoder encodes the tables of a result as Arrow IPC streams.
+type ResultEncoder struct{}
+
+// NewResultEncoder creates a new Arrow result encoder.
+func NewResultEncoder() *ResultEncoder {
+	return &ResultEncoder{}
+}
+
+type arrowEncoderError struct {
+	msg string
+}
+
+func (e *arrowEncoderError) Error() string {
+	return e.msg
+}
+
+func (e *arrowEncoderError) IsEncoderError() bool {
+	return true
+}
+
+func wrapEncodingError(err error) error {
+	return errors.Wrap(&arrowEncoderError{msg: err.Error()}, "arrow encoder error")
+}
+
+func (e *ResultEncoder) Encode(w io.Writer, result flux.Result) (int64, error) {
+	wc := &iocounter.Writer{Writer: w}
+	err := result.Tables().Do(func(tbl flux.Table) error {
+		return encodeTable(wc, result.Name(), tbl)
+	})
+	return wc.Count(), err
+}
+
+func encodeTable(w io.Writer, name string, tbl flux.Table) error {
+	schema, err := tableSchema(name, tbl)
+	if err != nil {
+		return wrapEncodingError(err)
+	}
+	sw := arrowipc.NewWriter(w, arrowipc.WithSchema(schema))
+	err = tbl.Do(func(cr flux.ColReader) error {
+		if cr.Len() == 0 {
+			return nil
+		}
+		rec := newRecord(schema, cr)
+		defer rec.Release()
+		return sw.Write(rec)
+	})
+	// The stream is ended even if reading the table fails, so that the error can follow it.
+	if closeErr := sw.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// tableSchema returns the Arrow schema of a table, with the result name
+// and the group key in its custom metadata.
+func tableSchema(name string, tbl flux.Table) (*arrow.Schema, error) {
+	fields := make([]arrow.Field, len(tbl.Cols()))
+	for j, c := range tbl.Cols() {
+		typ, err := arrowType(c.Type)
+		if err != nil {
+			return nil, errors.Wrapf(err, "column %s", c.Label)
+		}
+		fields[j] = arrow.Field{Name: c.Label, Type: typ, Nullable: true}
+	}
+
+	key := tbl.Key()
+	labels := make([]string, len(key.Cols()))
+	vs := make([]*string, len(key.Cols()))
+	for j, c := range key.Cols() {
+		labels[j] = c.Label
+		if !key.IsNull(j) {
+			s := formatKeyValue(key.Value(j))
+			vs[j] = &s
+		}
+	}
+	labelsJSON, err := json.Marshal(labels)
+	if err != nil {
+		return nil, err
+	}
+	vsJSON, err := json.Marshal(vs)
+	if err != nil {
+		return nil, err
+	}
+	metadata := arrow.NewMetadata(
+		[]string{ResultMetadataKey, GroupKeyMetadataKey, GroupKeyValuesMetadataKey},
+		[]string{name, string(labelsJSON), string(vsJSON)},
+	)
+	return arrow.NewSchema(fields, &metadata), nil
+}
+
+// arrowType returns the Arrow type of a column type.
+func arrowType(typ flux.ColType) (arrow.DataType, error) {
+	switch typ {
+	case flux.TFloat:
+		return arrow.PrimitiveTypes.Float64, nil
+	case flux.TInt:
+		return arrow.PrimitiveTypes.Int64, nil
+	case flux.TUInt:
+		return arrow.PrimitiveTypes.Uint64, nil
+	case flux.TString:
+		return arrow.BinaryTypes.String, nil
+	case flux.TBool:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case flux.TTime:
+		return arrow.FixedWidthTypes.Timestamp_ns, nil
+	default:
+		return nil, fmt.Errorf("unsupported column type %v", typ)
+	}
+}
+
+// colType returns the column type of an Arrow type.
+// Binary fields are read as strings.
+func colType(typ arrow.DataType) (flux.ColType, error) {
+	switch typ := typ.(type) {
+	case *arrow.Float64Type:
+		return flux.TFloat, nil
+	case *arrow.Int64Type:
+		return flux.TInt, nil
+	case *arrow.Uint64Type:
+		return flux.TUInt, nil
+	case *arrow.StringType, *arrow.BinaryType:
+		return flux.TString, nil
+	case *arrow.BooleanType:
+		return flux.TBool, nil
+	case *arrow.TimestampType:
+		if typ.Unit != arrow.Nanosecond {
+			return flux.TInvalid, fmt.Errorf("unsupported timestamp unit %v", typ.Unit)
+		}
+		return flux.TTime, nil
+	default:
+		return flux.TInvalid, fmt.Errorf("unsupported arrow type %v", typ)
+	}
+}
+
+// withType returns the data of an array with a different type that has the same layout.
+// Flux keeps strings in binary arrays and times in int64 arrays.
+func withType(arr array.Interface, typ arrow.DataType) *array.Data {
+	data := arr.Data()
+	return array.NewData(typ, data.Len(), data.Buffers(), nil, data.NullN(), data.Offset())
+}
+
+func formatKeyValue(v values.Value) string {
+	switch v.Type() {
+	case flux.SemanticType(flux.TFloat):
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	case flux.SemanticType(flux.TInt):
+		return strconv.FormatInt(v.Int(), 10)
+	case flux.SemanticType(flux.TUInt):
+		return strconv.FormatUint(v.UInt(), 10)
+	case flux.SemanticType(flux.TBool):
+		return strconv.FormatBool(v.Bool())
+	case flux.SemanticType(flux.TTime):
+		return v.Time().Time().Format(time.RFC3339Nano)
+	default:
+		return v.Str()
+	}
+}
+
+func parseKeyValue(s string, typ flux.ColType) (values.Value, error) {
+	switch typ {
+	case flux.TFloat:
+		f, err := strconv.ParseFloat(s, 64)
+		return values.NewFloat(f), err
+	case flux.TInt:
+		i, err := strconv.ParseInt(s, 10, 64)
+		return values.NewInt(i), err
+	case flux.TUInt:
+		u, err := strconv.ParseUint(s, 10, 64)
+		return values.NewUInt(u), err
+	case flux.TBool:
+		b, err := strconv.ParseBool(s)
+		return values.NewBool(b), err
+	case flux.TTime:
+		t, err := time.Parse(time.RFC3339Nano, s)
+		return values.NewTime(values.ConvertTime(t)), err
+	default:
+		return values.NewString(s), nil
+	}
+}
+
+// newRecord creates a record of the columns of cr.
+func newRecord(schema *arrow.Schema, cr flux.ColReader) array.Record {
+	cols := make([]array.Interface, len(cr.Cols()))
+	for j, c := range cr.Cols() {
+		switch c.Type {
+		case flux.TFloat:
+			cols[j] = cr.Floats(j)
+			cols[j].Retain()
+		case flux.TInt:
+			cols[j] = cr.Ints(j)
+			cols[j].Retain()
+		case flux.TUInt:
+			cols[j] = cr.UInts(j)
+			cols[j].Retain()
+		case flux.TString:
+			cols[j] = array.NewStringData(withType(cr.Strings(j), schema.Field(j).Type))
+		case flux.TBool:
+			cols[j] = cr.Bools(j)
+			cols[j].Retain()
+		case flux.TTime:
+			cols[j] = array.NewTimestampData(withType(cr.Times(j), schema.Field(j).Type))
+		}
+	}
+	rec := array.NewRecord(schema, cols, int64(cr.Len()))
+	for _, col := range cols {
+		col.Release()
+	}
+	return rec
+}
+
+// MultiResultEncoder encodes the tables of every result as Arrow IPC streams.
+// An error of the results is encoded as a last stream without fields,
+// with the error message in the custom metadata of its schema.
+type MultiResultEncoder struct {
+	encoder *ResultEncoder
+}
+
+// NewMultiResultEncoder creates a new Arrow multi result encoder.
+func NewMultiResultEncoder() *MultiResultEncoder {
+	return &MultiResultEncoder{encoder: NewResultEncoder()}
+}
+
+func (e *MultiResultEncoder) Encode(w io.Writer, results flux.ResultIterator) (int64, error) {
+	wc := &iocounter.Writer{Writer: w}
+	for results.More() {
+		if _, err := e.encoder.Encode(wc, results.Next()); err != nil {
+			if flux.IsEncoderError(err) {
+				return wc.Count(), err
+			}
+			err := encodeError(wc, err)
+			return wc.Count(), err
+		}
+	}
+	if err := results.Err(); err != nil {
+		err := encodeError(wc, err)
+		return wc.Count(), err
+	}
+	return wc.Count(), nil
+}
+
+func encodeError(w io.Writer, err error) error {
+	metadata := arrow.NewMetadata([]string{ErrorMetadataKey}, []string{err.Error()})
+	return arrowipc.NewWriter(w, arrowipc.WithSchema(arrow.NewSchema(nil, &metadata))).Close()
+}
+
+// ResultDecoderConfig is the configuration for a result decoder.
+type ResultDecoderConfig struct {
+	// Allocator is the memory allocator used for the tables.
+	// If nil, a new unlimited allocator is used.
+	Allocator *memory.Allocator
+}
+
+// ResultDecoder decodes Arrow IPC streams into a single result.
+// The tables of every stream belong to the result, whatever the result name in the metadata.
+type ResultDecoder struct {
+	config ResultDecoderConfig
+}
+
+// NewResultDecoder creates a new result decoder from config.
+func NewResultDecoder(config ResultDecoderConfig) *ResultDecoder {
+	return &ResultDecoder{config: config}
+}
+
+func (d *ResultDecoder) Decode(r io.Reader) (flux.Result, error) {
+	res := &result{name: defaultResultName}
+	br := bufio.NewReader(r)
+	for first := true; ; first = false {
+		s, err := readStream(br, d.config.Allocator)
+		if err == io.EOF {
+			return res, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if s.err != nil {
+			return nil, s.err
+		}
+		if first {
+			res.name = s.name
+		}
+		res.tables = append(res.tables, s.table)
+	}
+}
+
+// MultiResultDecoder decodes Arrow IPC streams into results.
+// Consecutive tables with the same result name make up a result.
+type MultiResultDecoder struct {
+	config ResultDecoderConfig
+}
+
+// NewMultiResultDecoder creates a new multi result decoder from config.
+func NewMultiResultDecoder(config ResultDecoderConfig) *MultiResultDecoder {
+	return &MultiResultDecoder{config: config}
+}
+
+func (d *MultiResultDecoder) Decode(r io.ReadCloser) (flux.ResultIterator, error) {
+	return &resultIterator{config: d.config, r: r, br: bufio.NewReader(r)}, nil
+}
+
+type resultIterator struct {
+	config ResultDecoderConfig
+	r      io.ReadCloser
+	br     *bufio.Reader
+
+	// pending is the stream that was read ahead, which starts the next result.
+	pending *stream
+	next    *result
+	done    bool
+	err     error
+}
+
+func (ri *resultIterator) More() bool {
+	if ri.done {
+		return false
+	}
+	if ri.pending == nil {
+		if !ri.read() {
+			return false
+		}
+	}
+	if ri.pending.err != nil {
+		ri.err = ri.pending.err
+		ri.Release()
+		return false
+	}
+	ri.next = &result{name: ri.pending.name, tables: []flux.Table{ri.pending.table}}
+	ri.pending = nil
+	for ri.read() {
+		if ri.pending.err != nil || ri.pending.name != ri.next.name {
+			break
+		}
+		ri.next.tables = append(ri.next.tables, ri.pending.table)
+		ri.pending = nil
+	}
+	return true
+}
+
+// read reads the next stream into pending and reports whether there is one.
+func (ri *resultIterator) read() bool {
+	s, err := readStream(ri.br, ri.config.Allocator)
+	if err != nil {
+		if err != io.EOF {
+			ri.err = err
+		}
+		ri.Release()
+		return false
+	}
+	ri.pending = s
+	return true
+}
+
+func (ri *resultIterator) Next() flux.Result {
+	return ri.next
+}
+
+func (ri *resultIterator) Release() {
+	if ri.done {
+		return
+	}
+	ri.done = true
+	ri.r.Close()
+}
+
+func (ri *resultIterator) Err() error {
+	return ri.err
+}
+
+func (ri *resultIterator) Statistics() flux.Statistics {
+	return flux.Statistics{}
+}
+
+type result struct {
+	name   string
+	tables []flux.Table
+}
+
+func (r *result) Name() string {
+	return r.name
+}
+
+func (r *result) Tables() flux.TableIterator {
+	return r
+}
+
+func (r *result) Do(f func(flux.Table) error) error {
+	for _, tbl := range r.tables {
+		if err := f(tbl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stream is a decoded stream, which holds either a table or an encoded error.
+type stream struct {
+	name  string
+	table flux.Table
+	err   error
+}
+
+// readStream reads the next stream. It returns io.EOF if there are no more streams.
+// Streams without result name are part of the default result
+// and streams without group key have an empty group key.
+func readStream(r *bufio.Reader, alloc *memory.Allocator) (s *stream, err error) {
+	if _, err := r.Peek(1); err != nil {
+		return nil, err
+	}
+	// Malformed metadata makes the Arrow reader panic, so panics are returned as errors.
+	defer func() {
+		if r := recover(); r != nil {
+			s, err = nil, fmt.Errorf("invalid arrow stream: %v", r)
+		}
+	}()
+
+	sr, err := arrowipc.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer sr.Release()
+	metadata := sr.Schema().Metadata()
+	if msg, ok := metadataValue(metadata, ErrorMetadataKey); ok {
+		if sr.Next() {
+			return nil, errors.New("arrow error stream has record batches")
+		} else if err := sr.Err(); err != nil {
+			return nil, err
+		}
+		return &stream{err: errors.New(msg)}, nil
+	}
+
+	name, ok := metadataValue(metadata, ResultMetadataKey)
+	if !ok {
+		name = defaultResultName
+	}
+	cols := make([]flux.ColMeta, len(sr.Schema().Fields()))
+	for j, f := range sr.Schema().Fields() {
+		typ, err := colType(f.Type)
+		if err != nil {
+			return nil, errors.Wrapf(err, "column %s", f.Name)
+		}
+		cols[j] = flux.ColMeta{Label: f.Name, Type: typ}
+	}
+	key, err := groupKey(metadata, cols)
+	if err != nil {
+		return nil, err
+	}
+	if alloc == nil {
+		alloc = &memory.Allocator{}
+	}
+	builder := execute.NewColListTableBuilder(key, alloc)
+	for _, c := range cols {
+		if _, err := builder.AddCol(c); err != nil {
+			return nil, err
+		}
+	}
+	for sr.Next() {
+		if err := appendRecord(builder, sr.Record()); err != nil {
+			return nil, err
+		}
+	}
+	if err := sr.Err(); err != nil {
+		return nil, err
+	}
+	tbl, err := builder.Table()
+	if err != nil {
+		return nil, err
+	}
+	return &stream{name: name, table: tbl}, nil
+}
+
+// metadataValue returns the value of the custom metadata with the given key.
+func metadataValue(md arrow.Metadata, key string) (string, bool) {
+	i := md.FindKey(key)
+	if i < 0 {
+		return "", false
+	}
+	return md.Values()[i], true
+}
+
+func groupKey(md arrow.Metadata, cols []flux.ColMeta) (flux.GroupKey, error) {
+	labelsJSON, ok := metadataValue(md, GroupKeyMetadataKey)
+	if !ok {
+		return execute.NewGroupKey(nil, nil), nil
+	}
+	var labels []string
+	if err := json.Unmarshal([]byte(labelsJSON), &labels); err != nil {
+		return nil, errors.Wrap(err, "invalid group key")
+	}
+	vs := make([]*string, len(labels))
+	if vsJSON, ok := metadataValue(md, GroupKeyValuesMetadataKey); ok {
+		if err := json.Unmarshal([]byte(vsJSON), &vs); err != nil {
+			return nil, errors.Wrap(err, "invalid group key values")
+		}
+		if len(vs) != len(labels) {
+			return nil, fmt.Errorf("group key has %d columns and %d values", len(labels), len(vs))
+		}
+	}
+
+	keyCols := make([]flux.ColMeta, len(labels))
+	keyValues := make([]values.Value, len(labels))
+	for j, label := range labels {
+		idx := execute.ColIdx(label, cols)
+		if idx < 0 {
+			return nil, fmt.Errorf("group key column %s does not exist", label)
+		}
+		keyCols[j] = cols[idx]
+		if vs[j] == nil {
+			keyValues[j] = values.NewNull(flux.SemanticType(keyCols[j].Type))
+			continue
+		}
+		v, err := parseKeyValue(*vs[j], keyCols[j].Type)
+		if err != nil {
+			return nil, errors.Wrapf(err, "group key column %s", label)
+		}
+		keyValues[j] = v
+	}
+	return execute.NewGroupKey(keyCols, keyValues), nil
+}
+
+// appendRecord appends the arrays of a record to the columns of the builder.
+func appendRecord(builder *execute.ColListTableBuilder, rec array.Record) error {
+	for j, c := range builder.Cols() {
+		col := rec.Column(j)
+		var err error
+		switch c.Type {
+		case flux.TFloat:
+			err = builder.AppendFloats(j, col.(*array.Float64))
+		case flux.TInt:
+			err = builder.AppendInts(j, col.(*array.Int64))
+		case flux.TUInt:
+			err = builder.AppendUInts(j, col.(*array.Uint64))
+		case flux.TString:
+			arr := array.NewBinaryData(withType(col, arrow.BinaryTypes.Binary))
+			err = builder.AppendStrings(j, arr)
+			arr.Release()
+		case flux.TBool:
+			err = builder.AppendBools(j, col.(*array.Boolean))
+		case flux.TTime:
+			arr := array.NewInt64Data(withType(col, arrow.PrimitiveTypes.Int64))
+			err = builder.AppendTimes(j, arr)
+			arr.Release()
+		}
+		if err != nil {
+			return errors.Wrapf(err, "column %s", c.Label)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package ipc_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	arrowipc "github.com/apache/arrow/go/arrow/ipc"
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/arrow/ipc"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+)
+
+func results() []flux.Result {
+	return []flux.Result{
+		&executetest.Result{
+			Nm: "_result",
+			Tbls: []*executetest.Table{
+				{
+					KeyCols: []string{"_start", "host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_start", Type: flux.TTime},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "float", Type: flux.TFloat},
+						{Label: "int", Type: flux.TInt},
+						{Label: "uint", Type: flux.TUInt},
+						{Label: "bool", Type: flux.TBool},
+						{Label: "string", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(5), execute.Time(10), "a", 1.5, int64(-2), uint64(3), true, "x"},
+						{execute.Time(5), nil, "a", nil, nil, nil, nil, nil},
+						{execute.Time(5), execute.Time(30), "a", -0.5, int64(7), uint64(1 << 63), false, ""},
+						{execute.Time(5), execute.Time(40), "a", 2.0, int64(9), uint64(0), true, "yz"},
+					},
+				},
+				{
+					KeyCols:   []string{"_start", "host"},
+					KeyValues: []interface{}{execute.Time(1556813561098000000), nil},
+					ColMeta: []flux.ColMeta{
+						{Label: "_start", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+				},
+			},
+		},
+		&executetest.Result{
+			Nm: "other",
+			Tbls: []*executetest.Table{
+				{
+					KeyCols: []string{"region", "id"},
+					ColMeta: []flux.ColMeta{
+						{Label: "region", Type: flux.TString},
+						{Label: "id", Type: flux.TInt},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{"west", int64(1), 1.0},
+					},
+				},
+				{
+					KeyCols: []string{"region", "id"},
+					ColMeta: []flux.ColMeta{
+						{Label: "region", Type: flux.TString},
+						{Label: "id", Type: flux.TInt},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{"east", int64(2), 2.0},
+						{"east", int64(2), 3.0},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestMultiResultEncoder_RoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	n, err := ipc.NewMultiResultEncoder().Encode(&buf, flux.NewSliceResultIterator(results()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(buf.Len()) {
+		t.Errorf("unexpected byte count: want %d, got %d", buf.Len(), n)
+	}
+
+	got, err := ipc.NewMultiResultDecoder(ipc.ResultDecoderConfig{}).Decode(ioutil.NopCloser(&buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+	if err := executetest.EqualResultIterators(flux.NewSliceResultIterator(results()), got); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMultiResultEncoder_Error(t *testing.T) {
+	rs := results()[:1]
+	rs = append(rs, &executetest.Result{Nm: "failed", Err: errors.New("query failed")})
+	var buf bytes.Buffer
+	if _, err := ipc.NewMultiResultEncoder().Encode(&buf, flux.NewSliceResultIterator(rs)); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ipc.NewMultiResultDecoder(ipc.ResultDecoderConfig{}).Decode(ioutil.NopCloser(&buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Release()
+	want := &executetest.Result{Nm: "_result", Tbls: results()[0].(*executetest.Result).Tbls}
+	if !got.More() {
+		t.Fatalf("expected a result, got error %v", got.Err())
+	}
+	if err := executetest.EqualResult(want, got.Next()); err != nil {
+		t.Error(err)
+	}
+	if got.More() {
+		t.Fatal("unexpected result")
+	}
+	if err := got.Err(); err == nil || err.Error() != "query failed" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestResultDecoder(t *testing.T) {
+	var buf bytes.Buffer
+	if _, err := ipc.NewResultEncoder().Encode(&buf, results()[1]); err != nil {
+		t.Fatal(err)
+	}
+	res, err := ipc.NewResultDecoder(ipc.ResultDecoderConfig{}).Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := executetest.EqualResult(results()[1], res); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := ipc.NewResultDecoder(ipc.ResultDecoderConfig{}).Decode(bytes.NewReader([]byte{16, 0, 0, 0, 1, 2})); err == nil {
+		t.Error("expected error decoding a truncated stream")
+	}
+}
+
+// TestResultEncoder_ArrowReader reads an encoded table with the Arrow reader.
+func TestResultEncoder_ArrowReader(t *testing.T) {
+	result := &executetest.Result{
+		Nm: "_result",
+		Tbls: []*executetest.Table{{
+			KeyCols: []string{"id"},
+			ColMeta: []flux.ColMeta{
+				{Label: "id", Type: flux.TInt},
+				{Label: "_value", Type: flux.TFloat},
+				{Label: "ok", Type: flux.TBool},
+				{Label: "host", Type: flux.TString},
+				{Label: "_time", Type: flux.TTime},
+			},
+			Data: [][]interface{}{
+				{int64(1), 1.5, true, "a", execute.Time(10)},
+				{int64(1), nil, false, nil, execute.Time(20)},
+			},
+		}},
+	}
+	var buf bytes.Buffer
+	if _, err := ipc.NewResultEncoder().Encode(&buf, result); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := arrowipc.NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+	md := r.Schema().Metadata()
+	if got, want := md.Values()[md.FindKey(ipc.GroupKeyMetadataKey)], `["id"]`; got != want {
+		t.Errorf("unexpected group key metadata: want %s, got %s", want, got)
+	}
+	if !r.Next() {
+		t.Fatal("expected a record batch")
+	}
+	rec := r.Record()
+	if rec.NumRows() != 2 {
+		t.Fatalf("expected 2 rows, got %d", rec.NumRows())
+	}
+	values := rec.Column(1).(*array.Float64)
+	if got := []interface{}{values.Value(0), values.IsNull(1)}; !cmp.Equal(got, []interface{}{1.5, true}) {
+		t.Errorf("unexpected values: %v", got)
+	}
+	if ok := rec.Column(2).(*array.Boolean); !ok.Value(0) || ok.Value(1) {
+		t.Error("unexpected booleans")
+	}
+	if hosts := rec.Column(3).(*array.String); hosts.Value(0) != "a" || !hosts.IsNull(1) {
+		t.Error("unexpected strings")
+	}
+	if times := rec.Column(4).(*array.Timestamp); times.Value(0) != 10 || times.Value(1) != 20 {
+		t.Error("unexpected times")
+	}
+	if r.Next() {
+		t.Error("unexpected record batch")
+	}
+}
@@ -1239,6 +1239,26 @@ func DurationFrom(l *DurationLiteral, _ time.Time) (time.Duration, error) {
 	return d, nil
 }
 
+// CalendarDurationFrom splits a DurationLiteral into its calendar months and the
+// exact duration of all of its other units.
+func CalendarDurationFrom(l *DurationLiteral) (months int64, d time.Duration, err error) {
+	for _, v := range l.Values {
+		switch v.Unit {
+		case "y":
+			months += 12 * v.Magnitude
+		case "mo":
+			months += v.Magnitude
+		default:
+			tempD, err := toDuration(v)
+			if err != nil {
+				return 0, 0, err
+			}
+			d += tempD
+		}
+	}
+	return months, d, nil
+}
+
 // TODO: we need a "duration from" that takes a time and a durationliteral, and gives an exact time.Duration instead of an approximation
 
 // DateTimeLiteral represents an instant in time with nanosecond precision using
@@ -5,8 +5,11 @@ import (
 
 	"github.com/influxdata/flux"
 	_ "github.com/influxdata/flux/builtin"
+	fexecute "github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/lang"
 	"github.com/influxdata/flux/memory"
 	"github.com/influxdata/flux/repl"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
 	"github.com/spf13/cobra"
 )
 
@@ -32,11 +35,29 @@ func (querier) Query(ctx context.Context, c flux.Compiler) (flux.ResultIterator,
 	if err != nil {
 		return nil, err
 	}
+	if p, ok := program.(lang.DependenciesAwareProgram); ok {
+		p.SetExecutorDependencies(dependencies())
+	}
 
 	alloc := &memory.Allocator{}
+	if memoryLimit > 0 {
+		alloc.Limit = &memoryLimit
+	}
 	qry, err := program.Start(ctx, alloc)
 	if err != nil {
 		return nil, err
 	}
 	return flux.NewResultIteratorFromQuery(qry), nil
 }
+
+// dependencies returns the execute dependencies configured by the command line flags.
+func dependencies() map[string]interface{} {
+	deps := make(map[string]interface{})
+	if storageDir != "" {
+		influxdb.InjectStorage(deps, influxdb.NewFileStorage(storageDir))
+	}
+	if spillDir != "" {
+		fexecute.InjectSpillConfig(deps, fexecute.SpillConfig{Dir: spillDir})
+	}
+	return deps
+}
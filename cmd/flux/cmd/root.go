@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
 	"github.com/spf13/cobra"
 )
 
@@ -14,6 +15,21 @@ var rootCmd = &cobra.Command{
 	Long:  `More to come later.`,
 }
 
+var (
+	storageDir  string
+	memoryLimit int64
+	spillDir    string
+)
+
+func init() {
+	// The command line tool reads and writes the local storage with influxdb.from and influxdb.to.
+	influxdb.RegisterStorage()
+
+	rootCmd.PersistentFlags().StringVar(&storageDir, "storage-dir", "", "directory of the local storage used by influxdb.from and influxdb.to")
+	rootCmd.PersistentFlags().Int64Var(&memoryLimit, "memory-limit", 0, "maximum number of bytes a query may allocate, no limit when 0")
+	rootCmd.PersistentFlags().StringVar(&spillDir, "spill-dir", "", "directory in which sort, group, pivot and join spill their tables when a query approaches the memory limit")
+}
+
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
@@ -567,8 +567,10 @@ type function struct {
 
 func (f *function) Type() semantic.Type {
 	// TODO(nathanielc): Update values.Value interface to use PolyTypes
-	t, _ := f.t.MonoType()
-	return t
+	if t, ok := f.t.MonoType(); ok {
+		return t
+	}
+	return semantic.Invalid
 }
 func (f *function) PolyType() semantic.PolyType {
 	return f.t
@@ -690,6 +692,15 @@ func (a Arguments) GetRequiredDuration(name string) (Duration, error) {
 	return d, nil
 }
 
+// GetCalendarDuration returns a duration argument with its calendar months.
+func (a Arguments) GetCalendarDuration(name string) (values.CalendarDuration, bool, error) {
+	v, ok := a.Get(name)
+	if !ok {
+		return values.CalendarDuration{}, false, nil
+	}
+	return values.CalendarDurationOf(v), true, nil
+}
+
 func ToQueryTime(value values.Value) (Time, error) {
 	switch value.Type().Nature() {
 	case semantic.Time:
@@ -7,6 +7,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/flux/ast"
 	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/semantic"
 	"github.com/influxdata/flux/values"
 )
 
@@ -95,3 +96,24 @@ func TestValidatePackageBuiltins(t *testing.T) {
 		})
 	}
 }
+
+func TestFunctionValue_PolymorphicType(t *testing.T) {
+	fn := FunctionValue("identity", nil, semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			"v": semantic.Tvar(1),
+		},
+		Required: semantic.LabelSet{"v"},
+		Return:   semantic.Tvar(1),
+	})
+	if got := fn.Type(); got != semantic.Invalid {
+		t.Fatalf("unexpected type of polymorphic function: %v", got)
+	}
+
+	// Importing a package computes the type of its values.
+	pkg := interpreter.NewPackageWithValues("test", values.NewObjectWithValues(map[string]values.Value{
+		"identity": fn,
+	}))
+	if got := pkg.Type().Nature(); got != semantic.Object {
+		t.Errorf("unexpected package type nature: %v", got)
+	}
+}
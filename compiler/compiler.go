@@ -242,8 +242,11 @@ func compile(n semantic.Node, typeSol semantic.TypeSolution, builtIns Scope, fun
 		}, nil
 	case *semantic.DurationLiteral:
 		return &durationEvaluator{
-			t:        monoType(typeSol.TypeOf(n)),
-			duration: values.Duration(n.Value),
+			t: monoType(typeSol.TypeOf(n)),
+			duration: values.CalendarDuration{
+				Months:      n.Months,
+				Nanoseconds: values.Duration(n.Value),
+			},
 		}, nil
 	case *semantic.UnaryExpression:
 		node, err := compile(n.Argument, typeSol, builtIns, funcExprs)
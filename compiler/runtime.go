@@ -323,7 +323,7 @@ func (e *unaryEvaluator) Eval(scope Scope) (values.Value, error) {
 		case semantic.Bool:
 			return values.NewBool(!v.Bool()), nil
 		case semantic.Duration:
-			return values.NewDuration(-v.Duration()), nil
+			return values.NewCalendarDuration(values.CalendarDurationOf(v).Neg()), nil
 		default:
 			panic(values.UnexpectedKind(e.t.Nature(), v.Type().Nature()))
 		}
@@ -415,7 +415,7 @@ func (e *timeEvaluator) Eval(scope Scope) (values.Value, error) {
 
 type durationEvaluator struct {
 	t        semantic.Type
-	duration values.Duration
+	duration values.CalendarDuration
 }
 
 func (e *durationEvaluator) Type() semantic.Type {
@@ -423,7 +423,7 @@ func (e *durationEvaluator) Type() semantic.Type {
 }
 
 func (e *durationEvaluator) Eval(scope Scope) (values.Value, error) {
-	return values.NewDuration(e.duration), nil
+	return values.NewCalendarDuration(e.duration), nil
 }
 
 type identifierEvaluator struct {
@@ -205,6 +205,9 @@ func (d *dataset) Finish(err error) {
 			err = d.triggerTable(bk)
 			d.cache.ExpireTable(bk)
 		})
+	} else {
+		// Release the buffered tables, some of them may hold temporary files.
+		d.cache.ForEach(d.cache.ExpireTable)
 	}
 	for _, t := range d.ts {
 		t.Finish(d.id, err)
@@ -0,0 +1,508 @@
+package execute
+
+import (
+	"bufio"
+	"encoding/gob"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/memory"
+)
+
+// SpillDependency is the key used to provide a SpillConfig
+// to the transformations through Dependencies.
+const SpillDependency = "execute/spill"
+
+// DefaultSpillThreshold is the fraction of the allocator limit
+// at which buffered tables are spilled when no threshold is configured.
+const DefaultSpillThreshold = 0.8
+
+// minSpillFraction is the fraction of the allocator limit that the rows of a cache
+// must use before they are spilled, and by which the allocated memory must grow
+// before the cache checks again, unless the headroom above the threshold is smaller.
+const minSpillFraction = 0.1
+
+// spillBatchSize is the number of rows that are written to and read from
+// a spill file at a time.
+const spillBatchSize = 1024
+
+// SpillConfig enables the caches of blocking transformations
+// to write their buffered tables to temporary files when the allocator
+// of a query approaches its limit.
+// Spilling only happens for queries whose allocator has a limit.
+type SpillConfig struct {
+	// Dir is the directory in which the temporary files are created.
+	// The default directory for temporary files is used when it is empty.
+	Dir string
+
+	// Threshold is the fraction of the allocator limit at which
+	// the buffered tables are spilled.
+	// DefaultSpillThreshold is used when it is zero.
+	Threshold float64
+}
+
+// GetSpillConfig returns the SpillConfig that was provided in the dependencies, if any.
+func GetSpillConfig(deps Dependencies) (SpillConfig, bool) {
+	v, ok := deps[SpillDependency]
+	if !ok || v == nil {
+		return SpillConfig{}, false
+	}
+	c, ok := v.(SpillConfig)
+	return c, ok
+}
+
+// InjectSpillConfig adds the SpillConfig to the dependencies.
+func InjectSpillConfig(deps Dependencies, c SpillConfig) {
+	deps[SpillDependency] = c
+}
+
+// NewSpillableTableBuilderCache creates a table builder cache that spills
+// its tables to disk when a SpillConfig has been provided in the dependencies
+// and the allocator has a limit. Otherwise it is the same as NewTableBuilderCache.
+//
+// Tables are spilled between calls to TableBuilder, so a transformation must
+// only append to a builder and never modify the rows that it has already appended.
+func NewSpillableTableBuilderCache(a Administration) *tableBuilderCache {
+	c := NewTableBuilderCache(a.Allocator())
+	if config, ok := GetSpillConfig(a.Dependencies()); ok {
+		c.EnableSpill(config)
+	}
+	return c
+}
+
+// EnableSpill makes the cache spill its tables to disk as described by the config.
+func (d *tableBuilderCache) EnableSpill(config SpillConfig) {
+	if d.alloc == nil || d.alloc.Limit == nil {
+		return
+	}
+	if config.Threshold <= 0 {
+		config.Threshold = DefaultSpillThreshold
+	}
+	d.spill = &spiller{
+		config: config,
+		alloc:  d.alloc,
+	}
+}
+
+// spiller writes the rows of table builders to temporary files.
+type spiller struct {
+	config SpillConfig
+	alloc  *memory.Allocator
+
+	// err is the first error that occurred while spilling.
+	// It is reported when a table is read from the cache.
+	err error
+
+	// next is the allocated memory at which shouldSpill checks the cache again
+	// while the memory stays under pressure.
+	next int64
+}
+
+// underPressure reports whether the allocated memory would reach the threshold
+// if another n bytes were allocated.
+func (s *spiller) underPressure(n int64) bool {
+	return float64(s.alloc.Allocated()+n) >= s.config.Threshold*float64(*s.alloc.Limit)
+}
+
+// step is the number of bytes the cache must hold before it is spilled
+// and by which the memory must grow between two checks.
+func (s *spiller) step() int64 {
+	fraction := minSpillFraction
+	if headroom := (1 - s.config.Threshold) / 2; headroom < fraction {
+		fraction = headroom
+	}
+	return int64(fraction * float64(*s.alloc.Limit))
+}
+
+// shouldSpill reports whether the builders of the cache should be spilled.
+// The memory may be under pressure because of allocations outside of the cache,
+// so the builders are only spilled when they hold at least step bytes themselves,
+// and once checked the memory must grow by step bytes before they are checked again.
+// Otherwise every row appended under pressure would be spilled to a file of its own.
+func (s *spiller) shouldSpill(tables *GroupLookup) bool {
+	if !s.underPressure(0) {
+		s.next = 0
+		return false
+	}
+	allocated := s.alloc.Allocated()
+	if allocated < s.next {
+		return false
+	}
+	step := s.step()
+	s.next = allocated + step
+	var size int64
+	tables.Range(func(key flux.GroupKey, value interface{}) {
+		size += builderAllocated(value.(tableState).builder)
+	})
+	if size < step {
+		return false
+	}
+	// Spilling frees the memory of the builders, which is where the next check starts from.
+	s.next = allocated - size + step
+	return true
+}
+
+// builderSize returns the number of bytes that are used by the rows of the builder.
+func builderSize(b TableBuilder) int64 {
+	return builderBytes(b, false)
+}
+
+// builderAllocated returns the number of bytes that are allocated for the rows of the builder,
+// which includes the capacity of the columns that has not been used yet.
+func builderAllocated(b TableBuilder) int64 {
+	return builderBytes(b, true)
+}
+
+func builderBytes(b TableBuilder, capacity bool) int64 {
+	builder, ok := b.(*ColListTableBuilder)
+	if !ok {
+		return 0
+	}
+	n := func(l, c int) int {
+		if capacity {
+			return c
+		}
+		return l
+	}
+	var size int
+	for _, c := range builder.cols {
+		switch c := c.(type) {
+		case *boolColumnBuilder:
+			size += n(len(c.data), cap(c.data)) * boolSize
+		case *intColumnBuilder:
+			size += n(len(c.data), cap(c.data)) * int64Size
+		case *uintColumnBuilder:
+			size += n(len(c.data), cap(c.data)) * uint64Size
+		case *floatColumnBuilder:
+			size += n(len(c.data), cap(c.data)) * float64Size
+		case *stringColumnBuilder:
+			size += n(len(c.data), cap(c.data)) * stringSize
+		case *timeColumnBuilder:
+			size += n(len(c.data), cap(c.data)) * timeSize
+		}
+	}
+	return int64(size)
+}
+
+// spillState holds the rows of a table that have been spilled.
+type spillState struct {
+	runs  []*spillRun
+	nrows int
+}
+
+// release gives up the reference to every run.
+func (s *spillState) release() {
+	for _, r := range s.runs {
+		r.release()
+	}
+	s.runs = nil
+	s.nrows = 0
+}
+
+// spillRun is a temporary file with rows of a table in the order they were appended.
+// The file is removed when the last reference to it is released.
+type spillRun struct {
+	path  string
+	nrows int
+	refs  int32
+}
+
+func (r *spillRun) retain() {
+	atomic.AddInt32(&r.refs, 1)
+}
+
+func (r *spillRun) release() {
+	if atomic.AddInt32(&r.refs, -1) == 0 {
+		os.Remove(r.path)
+	}
+}
+
+// spillAll writes the rows of every builder in the cache to disk and frees their memory.
+func (s *spiller) spillAll(tables *GroupLookup) {
+	tables.Range(func(key flux.GroupKey, value interface{}) {
+		s.spillTable(value.(tableState))
+	})
+}
+
+// spillTable writes the rows of the builder to disk and frees their memory.
+func (s *spiller) spillTable(b tableState) {
+	if s.err != nil {
+		return
+	}
+	builder, ok := b.builder.(*ColListTableBuilder)
+	if !ok || builder.NRows() == 0 {
+		return
+	}
+	run, err := s.write(builder)
+	if err != nil {
+		s.err = err
+		return
+	}
+	b.spill.runs = append(b.spill.runs, run)
+	b.spill.nrows += run.nrows
+	releaseColumns(builder)
+}
+
+func (s *spiller) write(b *ColListTableBuilder) (*spillRun, error) {
+	f, err := ioutil.TempFile(s.config.Dir, "flux-spill-")
+	if err != nil {
+		return nil, errors.Wrap(err, codes.Internal, "failed to create spill file")
+	}
+	run := &spillRun{
+		path:  f.Name(),
+		nrows: b.NRows(),
+		refs:  1,
+	}
+	w := bufio.NewWriter(f)
+	enc := gob.NewEncoder(w)
+	for start := 0; start < b.NRows(); start += spillBatchSize {
+		stop := start + spillBatchSize
+		if stop > b.NRows() {
+			stop = b.NRows()
+		}
+		if err = enc.Encode(newSpillChunk(b, start, stop)); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		run.release()
+		return nil, errors.Wrap(err, codes.Internal, "failed to spill table")
+	}
+	return run, nil
+}
+
+// releaseColumns clears the data of the builder and
+// gives the memory of its columns back to the allocator.
+func releaseColumns(b *ColListTableBuilder) {
+	b.ClearData()
+	for _, c := range b.cols {
+		switch c := c.(type) {
+		case *boolColumnBuilder:
+			c.data, c.nils = nil, make(map[int]bool)
+		case *intColumnBuilder:
+			c.data, c.nils = nil, make(map[int]bool)
+		case *uintColumnBuilder:
+			c.data, c.nils = nil, make(map[int]bool)
+		case *floatColumnBuilder:
+			c.data, c.nils = nil, make(map[int]bool)
+		case *stringColumnBuilder:
+			c.data, c.nils = nil, make(map[int]bool)
+		case *timeColumnBuilder:
+			c.data, c.nils = nil, make(map[int]bool)
+		}
+	}
+}
+
+// spillChunk is a batch of rows as it is stored in a spill file.
+// Columns are identified by their label, since a builder may gain
+// columns after some of its rows have been spilled.
+type spillChunk struct {
+	NRows   int
+	Labels  []string
+	Columns []spillColumn
+}
+
+// spillColumn holds the values of one column.
+// Only the slice that matches the column type is set, times are stored as Ints.
+type spillColumn struct {
+	Nulls   []bool
+	Bools   []bool
+	Ints    []int64
+	UInts   []uint64
+	Floats  []float64
+	Strings []string
+}
+
+func newSpillChunk(b *ColListTableBuilder, start, stop int) *spillChunk {
+	chunk := &spillChunk{
+		NRows:   stop - start,
+		Labels:  make([]string, len(b.cols)),
+		Columns: make([]spillColumn, len(b.cols)),
+	}
+	for j, c := range b.cols {
+		chunk.Labels[j] = c.Meta().Label
+		col := &chunk.Columns[j]
+		col.Nulls = make([]bool, stop-start)
+		for i := start; i < stop; i++ {
+			col.Nulls[i-start] = c.IsNil(i)
+		}
+		switch c := c.(type) {
+		case *boolColumnBuilder:
+			col.Bools = c.data[start:stop]
+		case *intColumnBuilder:
+			col.Ints = c.data[start:stop]
+		case *uintColumnBuilder:
+			col.UInts = c.data[start:stop]
+		case *floatColumnBuilder:
+			col.Floats = c.data[start:stop]
+		case *stringColumnBuilder:
+			col.Strings = c.data[start:stop]
+		case *timeColumnBuilder:
+			col.Ints = make([]int64, stop-start)
+			for i, t := range c.data[start:stop] {
+				col.Ints[i] = int64(t)
+			}
+		}
+	}
+	return chunk
+}
+
+// appendTo appends the rows of the chunk to the builder.
+// Columns of the builder that are not in the chunk are filled with nulls.
+func (c *spillChunk) appendTo(b *ColListTableBuilder) error {
+	for j, meta := range b.Cols() {
+		idx := -1
+		for k, label := range c.Labels {
+			if label == meta.Label {
+				idx = k
+				break
+			}
+		}
+		for i := 0; i < c.NRows; i++ {
+			if idx < 0 || c.Columns[idx].Nulls[i] {
+				if err := b.AppendNil(j); err != nil {
+					return err
+				}
+				continue
+			}
+			col := &c.Columns[idx]
+			var err error
+			switch meta.Type {
+			case flux.TBool:
+				err = b.AppendBool(j, col.Bools[i])
+			case flux.TInt:
+				err = b.AppendInt(j, col.Ints[i])
+			case flux.TUInt:
+				err = b.AppendUInt(j, col.UInts[i])
+			case flux.TFloat:
+				err = b.AppendFloat(j, col.Floats[i])
+			case flux.TString:
+				err = b.AppendString(j, col.Strings[i])
+			case flux.TTime:
+				err = b.AppendTime(j, Time(col.Ints[i]))
+			default:
+				err = errors.Newf(codes.Internal, "unsupported column type %v", meta.Type)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// spilledTable is a table whose rows are partly stored in spill files.
+// The spilled rows are read back one batch at a time, followed by the
+// rows that are still held in memory.
+type spilledTable struct {
+	mem   flux.Table
+	runs  []*spillRun
+	nrows int
+	alloc *memory.Allocator
+
+	used int32
+	once sync.Once
+}
+
+func newSpilledTable(mem flux.Table, state *spillState, alloc *memory.Allocator) *spilledTable {
+	runs := make([]*spillRun, len(state.runs))
+	for i, r := range state.runs {
+		r.retain()
+		runs[i] = r
+	}
+	return &spilledTable{
+		mem:   mem,
+		runs:  runs,
+		nrows: state.nrows,
+		alloc: alloc,
+	}
+}
+
+func (t *spilledTable) Key() flux.GroupKey {
+	return t.mem.Key()
+}
+
+func (t *spilledTable) Cols() []flux.ColMeta {
+	return t.mem.Cols()
+}
+
+func (t *spilledTable) Empty() bool {
+	return t.nrows == 0 && t.mem.Empty()
+}
+
+func (t *spilledTable) Do(f func(flux.ColReader) error) error {
+	if !atomic.CompareAndSwapInt32(&t.used, 0, 1) {
+		return errors.New(codes.Internal, "table already read")
+	}
+	defer t.release()
+	defer t.mem.Done()
+
+	for _, r := range t.runs {
+		if err := t.readRun(r, f); err != nil {
+			return err
+		}
+	}
+	return t.mem.Do(f)
+}
+
+func (t *spilledTable) readRun(r *spillRun, fn func(flux.ColReader) error) error {
+	f, err := os.Open(r.path)
+	if err != nil {
+		return errors.Wrap(err, codes.Internal, "failed to open spill file")
+	}
+	defer f.Close()
+
+	dec := gob.NewDecoder(bufio.NewReader(f))
+	for {
+		var chunk spillChunk
+		if err := dec.Decode(&chunk); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return errors.Wrap(err, codes.Internal, "failed to read spill file")
+		}
+
+		b := NewColListTableBuilder(t.Key(), t.alloc)
+		if err := AddTableCols(t, b); err != nil {
+			return err
+		}
+		if err := chunk.appendTo(b); err != nil {
+			return err
+		}
+		tbl, err := b.Table()
+		releaseColumns(b)
+		if err != nil {
+			return err
+		}
+		if err := tbl.Do(fn); err != nil {
+			return err
+		}
+	}
+}
+
+func (t *spilledTable) Done() {
+	if atomic.CompareAndSwapInt32(&t.used, 0, 1) {
+		t.release()
+		t.mem.Done()
+	}
+}
+
+// release gives up the references to the spill files.
+func (t *spilledTable) release() {
+	t.once.Do(func() {
+		for _, r := range t.runs {
+			r.release()
+		}
+	})
+}
@@ -0,0 +1,221 @@
+package execute_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/values"
+)
+
+func TestTableBuilderCache_Spill(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flux-spill-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	limit := int64(4096)
+	alloc := &memory.Allocator{Limit: &limit}
+	c := execute.NewTableBuilderCache(alloc)
+	c.SetTriggerSpec(plan.DefaultTriggerSpec)
+	c.EnableSpill(execute.SpillConfig{Dir: dir, Threshold: 0.25})
+
+	key := execute.NewGroupKey(nil, nil)
+	want := &executetest.Table{
+		ColMeta: []flux.ColMeta{
+			{Label: "_time", Type: flux.TTime},
+			{Label: "_value", Type: flux.TFloat},
+			{Label: "host", Type: flux.TString},
+		},
+	}
+
+	// Append the rows one at a time, like group does,
+	// and add a column once some rows have been spilled.
+	for i := 0; i < 2000; i++ {
+		b, created := c.TableBuilder(key)
+		if created {
+			if _, err := b.AddCol(flux.ColMeta{Label: "_time", Type: flux.TTime}); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := b.AddCol(flux.ColMeta{Label: "_value", Type: flux.TFloat}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if i == 1000 {
+			if files, _ := ioutil.ReadDir(dir); len(files) == 0 {
+				t.Fatal("expected rows to be spilled")
+			}
+			if _, err := b.AddCol(flux.ColMeta{Label: "host", Type: flux.TString}); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		row := []interface{}{execute.Time(i), float64(i), nil}
+		if err := b.AppendTime(0, execute.Time(i)); err != nil {
+			t.Fatal(err)
+		}
+		if i%10 == 0 {
+			row[1] = nil
+			err = b.AppendNil(1)
+		} else {
+			err = b.AppendFloat(1, float64(i))
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i >= 1000 {
+			row[2] = "a"
+			if err := b.AppendString(2, "a"); err != nil {
+				t.Fatal(err)
+			}
+		}
+		want.Data = append(want.Data, row)
+	}
+
+	if max := alloc.MaxAllocated(); max > limit/2 {
+		t.Errorf("expected rows to be spilled before using half of the limit, allocated %d", max)
+	}
+
+	var count int
+	c.ForEachWithContext(func(key flux.GroupKey, trigger execute.Trigger, tc execute.TableContext) {
+		count = tc.Count
+	})
+	if count != 2000 {
+		t.Errorf("unexpected row count: want 2000, got %d", count)
+	}
+
+	tbl, err := c.Table(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.ExpireTable(key)
+	got, err := executetest.ConvertTable(tbl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want.Normalize()
+	got.Normalize()
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected table -want/+got\n%s", cmp.Diff(want, got))
+	}
+
+	if files, _ := ioutil.ReadDir(dir); len(files) != 0 {
+		t.Errorf("expected the spill files to be removed, found %d", len(files))
+	}
+}
+
+func TestTableBuilderCache_SpillDone(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flux-spill-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	limit := int64(1024)
+	alloc := &memory.Allocator{Limit: &limit}
+	c := execute.NewTableBuilderCache(alloc)
+	c.SetTriggerSpec(plan.DefaultTriggerSpec)
+	c.EnableSpill(execute.SpillConfig{Dir: dir, Threshold: 0.01})
+
+	key := execute.NewGroupKey(nil, nil)
+	for i := 0; i < 10; i++ {
+		b, created := c.TableBuilder(key)
+		if created {
+			if _, err := b.AddCol(flux.ColMeta{Label: "_value", Type: flux.TInt}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := b.AppendInt(0, int64(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tbl, err := c.Table(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) == 0 {
+		t.Fatal("expected rows to be spilled")
+	}
+
+	// The files remain until both the cache and the table are done with them.
+	c.ExpireTable(key)
+	if files, _ := ioutil.ReadDir(dir); len(files) == 0 {
+		t.Fatal("expected the spill files to remain until the table is done")
+	}
+	tbl.Done()
+	if files, _ := ioutil.ReadDir(dir); len(files) != 0 {
+		t.Errorf("expected the spill files to be removed, found %d", len(files))
+	}
+	if got := alloc.Allocated(); got != 0 {
+		t.Errorf("expected all memory to be freed, %d bytes are allocated", got)
+	}
+}
+
+func TestTableBuilderCache_SpillMemoryHeldElsewhere(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flux-spill-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	limit := int64(1 << 20)
+	alloc := &memory.Allocator{Limit: &limit}
+	c := execute.NewTableBuilderCache(alloc)
+	c.SetTriggerSpec(plan.DefaultTriggerSpec)
+	c.EnableSpill(execute.SpillConfig{Dir: dir, Threshold: 0.5})
+
+	// Another transformation holds more memory than the threshold,
+	// so the memory is under pressure for every row that is appended.
+	if err := alloc.Allocate(600 << 10); err != nil {
+		t.Fatal(err)
+	}
+	defer alloc.Free(600 << 10)
+
+	const groups = 10
+	for i := 0; i < 10000; i++ {
+		key := execute.NewGroupKey(
+			[]flux.ColMeta{{Label: "host", Type: flux.TString}},
+			[]values.Value{values.NewString(fmt.Sprintf("host%d", i%groups))},
+		)
+		b, created := c.TableBuilder(key)
+		if created {
+			if _, err := b.AddCol(flux.ColMeta{Label: "_time", Type: flux.TTime}); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := b.AddCol(flux.ColMeta{Label: "_value", Type: flux.TFloat}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := b.AppendTime(0, execute.Time(i)); err != nil {
+			t.Fatal(err)
+		}
+		if err := b.AppendFloat(1, float64(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files, _ := ioutil.ReadDir(dir)
+	if len(files) == 0 {
+		t.Fatal("expected rows to be spilled")
+	}
+	if len(files) > 2*groups {
+		t.Errorf("expected the rows to be spilled in a few runs per table, found %d files", len(files))
+	}
+
+	var count int
+	c.ForEachWithContext(func(key flux.GroupKey, trigger execute.Trigger, tc execute.TableContext) {
+		count += tc.Count
+	})
+	if count != 10000 {
+		t.Errorf("unexpected row count: want 10000, got %d", count)
+	}
+}
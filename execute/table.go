@@ -795,7 +795,7 @@ func (b *ColListTableBuilder) AppendBools(j int, vs *array.Boolean) error {
 			return err
 		}
 		if vs.IsNull(i) {
-			if err := b.SetNil(b.nrows, j); err != nil {
+			if err := b.SetNil(b.nrows-1, j); err != nil {
 				return err
 			}
 		}
@@ -1341,6 +1341,73 @@ func (b *ColListTableBuilder) Sort(cols []string, desc bool) {
 	sort.Sort(s)
 }
 
+// SortBy sorts the rows of the builder by the given columns, each column in the direction
+// at the same index of desc. Rows that are equal in every column keep their order.
+// Null values sort before all other values in both directions, unless nullsLast is set.
+func (b *ColListTableBuilder) SortBy(cols []string, desc []bool, nullsLast bool) {
+	sort.Stable(newRowSorter(b, cols, desc, nullsLast))
+}
+
+// rowSorter orders the rows of a builder by a list of columns, each in its own direction.
+type rowSorter struct {
+	cols      []int
+	desc      []bool
+	nullsLast bool
+	b         *ColListTableBuilder
+
+	// seq breaks ties between equal rows when it is set,
+	// its values move with the rows when they are swapped.
+	seq []int
+}
+
+func newRowSorter(b *ColListTableBuilder, cols []string, desc []bool, nullsLast bool) *rowSorter {
+	s := &rowSorter{
+		cols:      make([]int, 0, len(cols)),
+		desc:      make([]bool, 0, len(cols)),
+		nullsLast: nullsLast,
+		b:         b,
+	}
+	for i, label := range cols {
+		if j := ColIdx(label, b.colMeta); j >= 0 {
+			s.cols = append(s.cols, j)
+			s.desc = append(s.desc, desc[i])
+		}
+	}
+	return s
+}
+
+func (s *rowSorter) Len() int {
+	return s.b.nrows
+}
+
+func (s *rowSorter) Less(x, y int) bool {
+	for k, j := range s.cols {
+		c := s.b.cols[j]
+		if c.Equal(x, y) {
+			continue
+		}
+		// Equal is true when both values are null,
+		// so at most one of them is null here.
+		if xnil, ynil := c.IsNil(x), c.IsNil(y); xnil || ynil {
+			return xnil != s.nullsLast
+		}
+		return c.Less(x, y) != s.desc[k]
+	}
+	if s.seq != nil {
+		return s.seq[x] < s.seq[y]
+	}
+	return false
+}
+
+func (s *rowSorter) Swap(x, y int) {
+	for _, col := range s.b.cols {
+		col.Swap(x, y)
+	}
+	if s.seq != nil {
+		s.seq[x], s.seq[y] = s.seq[y], s.seq[x]
+	}
+}
+
 // ColListTable implements Table using list of columns.
 // All data for the table is stored in RAM.
 // As a result At* methods are provided directly on the table for easy access.
@@ -2055,6 +2122,9 @@ type tableBuilderCache struct {
 	tables *GroupLookup
 	alloc  *memory.Allocator
 
+	// spill is set when the tables are spilled to disk under memory pressure.
+	spill *spiller
+
 	triggerSpec plan.TriggerSpec
 }
 
@@ -2068,6 +2138,7 @@ func NewTableBuilderCache(a *memory.Allocator) *tableBuilderCache {
 type tableState struct {
 	builder TableBuilder
 	trigger Trigger
+	spill   *spillState
 }
 
 func (d *tableBuilderCache) SetTriggerSpec(ts plan.TriggerSpec) {
@@ -2079,7 +2150,21 @@ func (d *tableBuilderCache) Table(key flux.GroupKey) (flux.Table, error) {
 	if !ok {
 		return nil, fmt.Errorf("table not found with key %v", key)
 	}
-	return b.builder.Table()
+	if d.spill != nil {
+		// Copying the builder into a table needs as much memory again,
+		// so the rows are read back from disk instead when they do not fit.
+		if len(b.spill.runs) > 0 || d.spill.underPressure(builderSize(b.builder)) {
+			d.spill.spillTable(b)
+		}
+		if d.spill.err != nil {
+			return nil, d.spill.err
+		}
+	}
+	tbl, err := b.builder.Table()
+	if err != nil || b.spill == nil || len(b.spill.runs) == 0 {
+		return tbl, err
+	}
+	return newSpilledTable(tbl, b.spill, d.alloc), nil
 }
 
 func (d *tableBuilderCache) lookupState(key flux.GroupKey) (tableState, bool) {
@@ -2092,7 +2177,13 @@ func (d *tableBuilderCache) lookupState(key flux.GroupKey) (tableState, bool) {
 
 // TableBuilder will return the builder for the specified table.
 // If no builder exists, one will be created.
+// When spilling is enabled and the memory is under pressure,
+// the rows of every builder are spilled before the builder is returned,
+// provided that the builders hold enough of the memory, see shouldSpill.
 func (d *tableBuilderCache) TableBuilder(key flux.GroupKey) (TableBuilder, bool) {
+	if d.spill != nil && d.spill.shouldSpill(d.tables) {
+		d.spill.spillAll(d.tables)
+	}
 	b, ok := d.lookupState(key)
 	if !ok {
 		builder := NewColListTableBuilder(key, d.alloc)
@@ -2101,6 +2192,9 @@ func (d *tableBuilderCache) TableBuilder(key flux.GroupKey) (TableBuilder, bool)
 			builder: builder,
 			trigger: t,
 		}
+		if d.spill != nil {
+			b.spill = new(spillState)
+		}
 		d.tables.Set(key, b)
 	}
 	return b.builder, !ok
@@ -2116,13 +2210,20 @@ func (d *tableBuilderCache) DiscardTable(key flux.GroupKey) {
 	b, ok := d.lookupState(key)
 	if ok {
 		b.builder.ClearData()
+		if b.spill != nil {
+			b.spill.release()
+		}
 	}
 }
 
 func (d *tableBuilderCache) ExpireTable(key flux.GroupKey) {
-	b, ok := d.tables.Delete(key)
+	v, ok := d.tables.Delete(key)
 	if ok {
-		b.(tableState).builder.ClearData()
+		b := v.(tableState)
+		b.builder.ClearData()
+		if b.spill != nil {
+			b.spill.release()
+		}
 	}
 }
 
@@ -2135,9 +2236,13 @@ func (d *tableBuilderCache) ForEach(f func(flux.GroupKey)) {
 func (d *tableBuilderCache) ForEachWithContext(f func(flux.GroupKey, Trigger, TableContext)) {
 	d.tables.Range(func(key flux.GroupKey, value interface{}) {
 		b := value.(tableState)
+		count := b.builder.NRows()
+		if b.spill != nil {
+			count += b.spill.nrows
+		}
 		f(key, b.trigger, TableContext{
 			Key:   key,
-			Count: b.builder.NRows(),
+			Count: count,
 		})
 	})
 }
@@ -323,6 +323,52 @@ func TestColListTable_SetNil(t *testing.T) {
 	}
 }
 
+func TestColListTable_AppendBools(t *testing.T) {
+	key := execute.NewGroupKey(nil, nil)
+	tb := execute.NewColListTableBuilder(key, &memory.Allocator{})
+
+	// Add a column for the value.
+	idx, _ := tb.AddCol(flux.ColMeta{
+		Label: execute.DefaultValueColLabel,
+		Type:  flux.TBool,
+	})
+
+	// Append an array with a nil value in the middle.
+	b := arrow.NewBoolBuilder(nil)
+	b.Append(true)
+	b.AppendNull()
+	b.Append(false)
+	vs := b.NewBooleanArray()
+	b.Release()
+	if err := tb.AppendBools(idx, vs); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Build the table and then verify the arrow table.
+	tbl, err := tb.Table()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		vs := cr.Bools(idx)
+		if got, want := vs.Len(), 3; got != want {
+			t.Errorf("unexpected length -want/+got\n\t- %d\n\t+ %d", want, got)
+			return nil
+		}
+
+		if vs.IsNull(0) || vs.IsNull(2) {
+			t.Error("first and last values should not be null")
+		}
+		if !vs.IsNull(1) {
+			t.Error("second value should be null")
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
 func TestCopyTable(t *testing.T) {
 	alloc := &memory.Allocator{}
 
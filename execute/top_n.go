@@ -0,0 +1,158 @@
+package execute
+
+import (
+	"container/heap"
+	"sort"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+// TopN appends rows to a builder but only keeps the rows that would be
+// in the range [offset, offset+n) if all of the rows were sorted with SortBy.
+// It uses a bounded heap, so the builder never holds more than offset+n+1 rows.
+type TopN struct {
+	b      *ColListTableBuilder
+	offset int
+	n      int
+
+	// sorter compares the rows of the builder.
+	// Its seq holds the position in which every kept row was appended,
+	// so that equal rows are ordered the same way as SortBy does.
+	sorter *rowSorter
+	// rows is a heap of the kept row indexes with the last row in the sort order at the top.
+	rows topNHeap
+	// next is the position of the next appended row.
+	next int
+}
+
+// NewTopN creates a TopN for a builder that already has all of its columns.
+func NewTopN(builder TableBuilder, offset, n int, cols []string, desc []bool, nullsLast bool) (*TopN, error) {
+	b, ok := builder.(*ColListTableBuilder)
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "top n requires a column list table builder, got %T", builder)
+	}
+	if b.NRows() > 0 {
+		return nil, errors.New(codes.Internal, "top n requires an empty table builder")
+	}
+	t := &TopN{
+		b:      b,
+		offset: offset,
+		n:      n,
+		sorter: newRowSorter(b, cols, desc, nullsLast),
+	}
+	t.sorter.seq = make([]int, 0, offset+n+1)
+	t.rows.sorter = t.sorter
+	return t, nil
+}
+
+// AppendRecord appends the row i of the column reader,
+// the builder and the column reader must have the same columns.
+func (t *TopN) AppendRecord(i int, cr flux.ColReader) error {
+	if err := AppendRecord(i, cr, t.b); err != nil {
+		return err
+	}
+	row := t.b.NRows() - 1
+	t.sorter.seq = append(t.sorter.seq, t.next)
+	t.next++
+
+	if row < t.offset+t.n {
+		heap.Push(&t.rows, row)
+		return nil
+	}
+
+	// The builder holds one row more than it keeps. That row replaces
+	// the last kept row if it comes before it, then the extra row is removed.
+	if last := t.rows.idx[0]; t.sorter.Less(row, last) {
+		t.sorter.Swap(row, last)
+		heap.Fix(&t.rows, 0)
+	}
+	t.b.sliceRows(0, row)
+	t.sorter.seq = t.sorter.seq[:row]
+	return nil
+}
+
+// Sort sorts the kept rows and removes the first offset rows.
+func (t *TopN) Sort() {
+	// The seq of every row is unique, so there are no equal rows.
+	sort.Sort(t.sorter)
+	start := t.offset
+	if start > t.b.NRows() {
+		start = t.b.NRows()
+	}
+	t.b.sliceRows(start, t.b.NRows())
+	t.rows.idx = t.rows.idx[:0]
+	t.sorter.seq = t.sorter.seq[:0]
+}
+
+// topNHeap is a max heap of row indexes.
+type topNHeap struct {
+	idx    []int
+	sorter *rowSorter
+}
+
+func (h *topNHeap) Len() int {
+	return len(h.idx)
+}
+
+func (h *topNHeap) Less(i, j int) bool {
+	return h.sorter.Less(h.idx[j], h.idx[i])
+}
+
+func (h *topNHeap) Swap(i, j int) {
+	h.idx[i], h.idx[j] = h.idx[j], h.idx[i]
+}
+
+func (h *topNHeap) Push(x interface{}) {
+	h.idx = append(h.idx, x.(int))
+}
+
+func (h *topNHeap) Pop() interface{} {
+	x := h.idx[len(h.idx)-1]
+	h.idx = h.idx[:len(h.idx)-1]
+	return x
+}
+
+// sliceRows keeps only the rows in the range [start, stop).
+// Unlike SliceColumns, the rows are moved to the start of the columns
+// so the memory stays accounted for, and the null values move with them.
+func (b *ColListTableBuilder) sliceRows(start, stop int) {
+	for _, c := range b.cols {
+		var base *columnBuilderBase
+		switch c := c.(type) {
+		case *boolColumnBuilder:
+			c.data = c.data[:copy(c.data, c.data[start:stop])]
+			base = &c.columnBuilderBase
+		case *intColumnBuilder:
+			c.data = c.data[:copy(c.data, c.data[start:stop])]
+			base = &c.columnBuilderBase
+		case *uintColumnBuilder:
+			c.data = c.data[:copy(c.data, c.data[start:stop])]
+			base = &c.columnBuilderBase
+		case *floatColumnBuilder:
+			c.data = c.data[:copy(c.data, c.data[start:stop])]
+			base = &c.columnBuilderBase
+		case *stringColumnBuilder:
+			c.data = c.data[:copy(c.data, c.data[start:stop])]
+			base = &c.columnBuilderBase
+		case *timeColumnBuilder:
+			c.data = c.data[:copy(c.data, c.data[start:stop])]
+			base = &c.columnBuilderBase
+		}
+		if start == 0 {
+			for i := stop; i < b.nrows; i++ {
+				delete(base.nils, i)
+			}
+			continue
+		}
+		nils := make(map[int]bool, len(base.nils))
+		for i := range base.nils {
+			if i >= start && i < stop {
+				nils[i-start] = true
+			}
+		}
+		base.nils = nils
+	}
+	b.nrows = stop - start
+}
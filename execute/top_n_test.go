@@ -0,0 +1,96 @@
+package execute_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+)
+
+// TestTopN checks that TopN keeps the same rows as sorting every row with SortBy and then slicing them.
+func TestTopN(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	input := &executetest.Table{
+		ColMeta: []flux.ColMeta{
+			{Label: "_time", Type: flux.TTime},
+			{Label: "host", Type: flux.TString},
+			{Label: "_value", Type: flux.TInt},
+		},
+	}
+	hosts := []interface{}{"a", "b", "c", nil}
+	for i := 0; i < 500; i++ {
+		var v interface{} = r.Int63n(20)
+		if r.Intn(10) == 0 {
+			v = nil
+		}
+		input.Data = append(input.Data, []interface{}{execute.Time(i), hosts[r.Intn(len(hosts))], v})
+	}
+
+	// A table can only be read once.
+	table := func() *executetest.Table {
+		tbl := *input
+		return &tbl
+	}
+
+	cols := []string{"host", "_value"}
+	for _, tc := range []struct {
+		name      string
+		desc      []bool
+		nullsLast bool
+		offset    int
+		n         int
+	}{
+		{name: "ascending", desc: []bool{false, false}, n: 10},
+		{name: "mixed", desc: []bool{false, true}, offset: 5, n: 30},
+		{name: "nulls last", desc: []bool{true, false}, nullsLast: true, offset: 100, n: 1},
+		{name: "more than the input", desc: []bool{true, true}, offset: 490, n: 20},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			want := execute.NewColListTableBuilder(execute.NewGroupKey(nil, nil), executetest.UnlimitedAllocator)
+			if err := execute.AddTableCols(input, want); err != nil {
+				t.Fatal(err)
+			}
+			if err := execute.AppendTable(table(), want); err != nil {
+				t.Fatal(err)
+			}
+			want.SortBy(cols, tc.desc, tc.nullsLast)
+			stop := tc.offset + tc.n
+			if stop > want.NRows() {
+				stop = want.NRows()
+			}
+
+			got := execute.NewColListTableBuilder(execute.NewGroupKey(nil, nil), executetest.UnlimitedAllocator)
+			if err := execute.AddTableCols(input, got); err != nil {
+				t.Fatal(err)
+			}
+			top, err := execute.NewTopN(got, tc.offset, tc.n, cols, tc.desc, tc.nullsLast)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := table().Do(func(cr flux.ColReader) error {
+				for i := 0; i < cr.Len(); i++ {
+					if err := top.AppendRecord(i, cr); err != nil {
+						return err
+					}
+				}
+				return nil
+			}); err != nil {
+				t.Fatal(err)
+			}
+			top.Sort()
+
+			if got, want := got.NRows(), stop-tc.offset; got != want {
+				t.Fatalf("unexpected number of rows: want %d, got %d", want, got)
+			}
+			for i := 0; i < got.NRows(); i++ {
+				if w, g := want.GetRow(tc.offset+i), got.GetRow(i); !w.Equal(g) {
+					t.Errorf("unexpected row %d -want/+got\n%s", i, cmp.Diff(w, g))
+				}
+			}
+		})
+	}
+}
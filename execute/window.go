@@ -1,9 +1,26 @@
 package execute
 
+import (
+	"time"
+
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/values"
+)
+
 type Window struct {
 	Every  Duration
 	Period Duration
 	Offset Duration
+
+	// EveryMonths, PeriodMonths and OffsetMonths are the calendar months
+	// of every, period and offset, in addition to their fixed durations.
+	EveryMonths  int64
+	PeriodMonths int64
+	OffsetMonths int64
+	// Location is the time zone whose wall clock the window bounds are aligned to.
+	// A nil location is UTC.
+	Location *time.Location
 }
 
 // NewWindow creates a window with the given parameters,
@@ -23,10 +40,50 @@ func NewWindow(every, period, offset Duration) Window {
 	}
 }
 
+// NewCalendarWindow creates a window whose durations may have calendar months,
+// and whose bounds are aligned to the wall clock of a location.
+// Every must be either a number of months or a fixed duration, but not both.
+// A nil location is UTC. Without months or a location, the window is the same as NewWindow creates.
+func NewCalendarWindow(every, period, offset values.CalendarDuration, loc *time.Location) (Window, error) {
+	if every.Months != 0 && every.Nanoseconds != 0 {
+		return Window{}, errors.Newf(codes.Invalid, "window every cannot mix months and smaller units, got %v", every)
+	}
+	if loc == time.UTC {
+		loc = nil
+	}
+	if every.IsFixed() && period.IsFixed() && offset.IsFixed() && loc == nil {
+		return NewWindow(every.Nanoseconds, period.Nanoseconds, offset.Nanoseconds), nil
+	}
+	if every.Months < 0 || every.Nanoseconds < 0 || every.IsZero() {
+		return Window{}, errors.Newf(codes.Invalid, "window every must be positive, got %v", every)
+	}
+	if period.Approximate() <= 0 {
+		return Window{}, errors.Newf(codes.Invalid, "window period must be positive, got %v", period)
+	}
+	return Window{
+		Every:        every.Nanoseconds,
+		Period:       period.Nanoseconds,
+		Offset:       offset.Nanoseconds,
+		EveryMonths:  every.Months,
+		PeriodMonths: period.Months,
+		OffsetMonths: offset.Months,
+		Location:     loc,
+	}, nil
+}
+
+// isCalendar reports whether the bounds of the window depend on the calendar.
+func (w Window) isCalendar() bool {
+	return w.EveryMonths != 0 || w.PeriodMonths != 0 || w.OffsetMonths != 0 || w.Location != nil
+}
+
 // GetEarliestBounds returns the bounds for the earliest window bounds
 // that contains the given time t.  For underlapping windows that
 // do not contain time t, the window directly after time t will be returned.
 func (w Window) GetEarliestBounds(t Time) Bounds {
+	if w.isCalendar() {
+		return w.calendarBounds(w.calendarIndex(t))
+	}
+
 	// translate to not-offset coordinate
 	t = t.Add(-w.Offset)
 
@@ -48,6 +105,16 @@ func (w Window) GetOverlappingBounds(b Bounds) []Bounds {
 	if b.IsEmpty() {
 		return []Bounds{}
 	}
+	if w.isCalendar() {
+		bs := []Bounds{}
+		for i := w.calendarIndex(b.Start); ; i++ {
+			bi := w.calendarBounds(i)
+			if bi.Start >= b.Stop {
+				return bs
+			}
+			bs = append(bs, bi)
+		}
+	}
 
 	c := (b.Duration() / w.Every) + (w.Period / w.Every)
 	bs := make([]Bounds, 0, c)
@@ -61,3 +128,80 @@ func (w Window) GetOverlappingBounds(b Bounds) []Bounds {
 
 	return bs
 }
+
+// The bounds of calendar windows are computed on the wall clock of the location.
+// A wall clock time is stored as the UTC time with the same date and clock reading,
+// so that the calendar arithmetic on it can be done in UTC.
+// The window with index i stops at the wall clock time i*every from the epoch, plus the offset.
+
+// calendarBounds returns the bounds of the window with index i.
+func (w Window) calendarBounds(i int64) Bounds {
+	stop := w.wallStop(i)
+	start := stop.AddCalendar(values.CalendarDuration{Months: -w.PeriodMonths, Nanoseconds: -w.Period}, nil)
+	return Bounds{
+		Start: w.fromWall(start),
+		Stop:  w.fromWall(stop),
+	}
+}
+
+// wallStop returns the wall clock time at which the window with index i stops.
+func (w Window) wallStop(i int64) Time {
+	var stop Time
+	if w.EveryMonths != 0 {
+		stop = values.ConvertTime(time.Date(1970, time.Month(1+i*w.EveryMonths), 1, 0, 0, 0, 0, time.UTC))
+	} else {
+		stop = Time(i * int64(w.Every))
+	}
+	return stop.AddCalendar(values.CalendarDuration{Months: w.OffsetMonths, Nanoseconds: w.Offset}, nil)
+}
+
+// calendarIndex returns the index of the first window that stops after t.
+func (w Window) calendarIndex(t Time) int64 {
+	// Estimate the index from the wall clock time without the offset,
+	// then move it to the first window that stops after t.
+	wall := w.toWall(t).AddCalendar(values.CalendarDuration{Months: -w.OffsetMonths, Nanoseconds: -w.Offset}, nil)
+	var i int64
+	if w.EveryMonths != 0 {
+		year, month, _ := wall.Time().Date()
+		i = floorDiv(int64(year-1970)*12+int64(month-1), w.EveryMonths) + 1
+	} else {
+		i = floorDiv(int64(wall), int64(w.Every)) + 1
+	}
+	for w.fromWall(w.wallStop(i)) <= t {
+		i++
+	}
+	for w.fromWall(w.wallStop(i-1)) > t {
+		i--
+	}
+	return i
+}
+
+// toWall returns the wall clock time of t in the location of the window.
+func (w Window) toWall(t Time) Time {
+	if w.Location == nil {
+		return t
+	}
+	_, offset := time.Unix(0, int64(t)).In(w.Location).Zone()
+	return t.Add(Duration(offset) * Duration(time.Second))
+}
+
+// fromWall returns the time at which the wall clock of the location of the window shows wall.
+// A wall clock time that is skipped or repeated by a daylight saving time change
+// is resolved the same way as by time.Date.
+func (w Window) fromWall(wall Time) Time {
+	if w.Location == nil {
+		return wall
+	}
+	tm := wall.Time()
+	year, month, day := tm.Date()
+	return values.ConvertTime(time.Date(year, month, day, tm.Hour(), tm.Minute(), tm.Second(), tm.Nanosecond(), w.Location))
+}
+
+// floorDiv divides a by b and rounds the quotient toward negative infinity.
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/values"
 )
 
 func TestNewWindow(t *testing.T) {
@@ -276,3 +277,143 @@ func TestWindow_GetOverlappingBounds(t *testing.T) {
 		})
 	}
 }
+
+func TestNewCalendarWindow(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		every  values.CalendarDuration
+		period values.CalendarDuration
+		want   string
+	}{
+		{
+			name:   "mixed every",
+			every:  values.CalendarDuration{Months: 1, Nanoseconds: values.Duration(time.Hour)},
+			period: values.CalendarDuration{Months: 1},
+			want:   "window every cannot mix months and smaller units, got 1mo1h0m0s",
+		},
+		{
+			name:   "negative every",
+			every:  values.CalendarDuration{Months: -1},
+			period: values.CalendarDuration{Months: 1},
+			want:   "window every must be positive, got -1mo",
+		},
+		{
+			name:   "negative period",
+			every:  values.CalendarDuration{Months: 1},
+			period: values.CalendarDuration{Months: -1},
+			want:   "window period must be positive, got -1mo",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := execute.NewCalendarWindow(tc.every, tc.period, values.CalendarDuration{}, nil)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if got := err.Error(); got != tc.want {
+				t.Errorf("unexpected error: want %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestWindow_GetOverlappingBounds_Calendar(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	parse := func(s string) execute.Time {
+		tm, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return values.ConvertTime(tm)
+	}
+	months := func(n int64) values.CalendarDuration {
+		return values.CalendarDuration{Months: n}
+	}
+
+	testcases := []struct {
+		name   string
+		every  values.CalendarDuration
+		period values.CalendarDuration
+		offset values.CalendarDuration
+		loc    *time.Location
+		b      execute.Bounds
+		want   []execute.Bounds
+	}{
+		{
+			name:   "months",
+			every:  months(1),
+			period: months(1),
+			b:      execute.Bounds{Start: parse("2019-01-15T00:00:00Z"), Stop: parse("2019-03-01T00:00:01Z")},
+			want: []execute.Bounds{
+				{Start: parse("2019-01-01T00:00:00Z"), Stop: parse("2019-02-01T00:00:00Z")},
+				{Start: parse("2019-02-01T00:00:00Z"), Stop: parse("2019-03-01T00:00:00Z")},
+				{Start: parse("2019-03-01T00:00:00Z"), Stop: parse("2019-04-01T00:00:00Z")},
+			},
+		},
+		{
+			name:   "quarters with offset",
+			every:  months(3),
+			period: months(3),
+			offset: months(1),
+			b:      execute.Bounds{Start: parse("2019-01-01T00:00:00Z"), Stop: parse("2019-03-01T00:00:00Z")},
+			want: []execute.Bounds{
+				{Start: parse("2018-11-01T00:00:00Z"), Stop: parse("2019-02-01T00:00:00Z")},
+				{Start: parse("2019-02-01T00:00:00Z"), Stop: parse("2019-05-01T00:00:00Z")},
+			},
+		},
+		{
+			name:   "years before the epoch",
+			every:  months(12),
+			period: months(12),
+			b:      execute.Bounds{Start: parse("1968-06-01T00:00:00Z"), Stop: parse("1969-06-01T00:00:00Z")},
+			want: []execute.Bounds{
+				{Start: parse("1968-01-01T00:00:00Z"), Stop: parse("1969-01-01T00:00:00Z")},
+				{Start: parse("1969-01-01T00:00:00Z"), Stop: parse("1970-01-01T00:00:00Z")},
+			},
+		},
+		{
+			name:   "days across daylight saving time",
+			every:  values.FixedDuration(values.Duration(24 * time.Hour)),
+			period: values.FixedDuration(values.Duration(24 * time.Hour)),
+			loc:    newYork,
+			b:      execute.Bounds{Start: parse("2019-03-10T03:00:00Z"), Stop: parse("2019-03-11T05:00:00Z")},
+			want: []execute.Bounds{
+				{Start: parse("2019-03-09T05:00:00Z"), Stop: parse("2019-03-10T05:00:00Z")},
+				{Start: parse("2019-03-10T05:00:00Z"), Stop: parse("2019-03-11T04:00:00Z")},
+				{Start: parse("2019-03-11T04:00:00Z"), Stop: parse("2019-03-12T04:00:00Z")},
+			},
+		},
+		{
+			name:   "months in a location",
+			every:  months(1),
+			period: months(1),
+			offset: values.FixedDuration(values.Duration(6 * time.Hour)),
+			loc:    newYork,
+			b:      execute.Bounds{Start: parse("2019-11-01T00:00:00Z"), Stop: parse("2019-11-02T00:00:00Z")},
+			want: []execute.Bounds{
+				{Start: parse("2019-10-01T10:00:00Z"), Stop: parse("2019-11-01T10:00:00Z")},
+				{Start: parse("2019-11-01T10:00:00Z"), Stop: parse("2019-12-01T11:00:00Z")},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			w, err := execute.NewCalendarWindow(tc.every, tc.period, tc.offset, tc.loc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := w.GetOverlappingBounds(tc.b)
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("got unexpected bounds; -want/+got:\n%v\n", cmp.Diff(tc.want, got))
+			}
+			if got, want := w.GetEarliestBounds(tc.b.Start), tc.want[0]; !cmp.Equal(want, got) {
+				t.Errorf("got unexpected earliest bounds; -want/+got:\n%v\n", cmp.Diff(want, got))
+			}
+		})
+	}
+}
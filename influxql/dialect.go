@@ -0,0 +1,42 @@
+package influxql
+
+import (
+	"net/http"
+
+	"github.com/influxdata/flux"
+)
+
+const DialectType = "influxql"
+
+// AddDialectMappings adds the InfluxQL dialect mappings.
+func AddDialectMappings(mappings flux.DialectMappings) error {
+	return mappings.Add(DialectType, func() flux.Dialect {
+		return &Dialect{}
+	})
+}
+
+// Dialect describes the output format of queries as the JSON response of the InfluxDB 1.x /query endpoint.
+type Dialect struct {
+	// ChunkSize, if positive, is the maximum number of rows of a chunk of a chunked response.
+	ChunkSize int
+	// Epoch is the precision of integer times. Times are RFC3339 strings if it is empty.
+	Epoch string
+}
+
+func (d Dialect) SetHeaders(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	if d.ChunkSize > 0 {
+		w.Header().Set("Transfer-Encoding", "chunked")
+	}
+}
+
+func (d Dialect) Encoder() flux.MultiResultEncoder {
+	return &MultiResultEncoder{
+		ChunkSize: d.ChunkSize,
+		Epoch:     d.Epoch,
+	}
+}
+
+func (d Dialect) DialectType() flux.DialectType {
+	return DialectType
+}
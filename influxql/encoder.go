@@ -0,0 +1,471 @@
+package influxql
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/iocounter"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	"github.com/pkg/errors"
+)
+
+const (
+	measurementColLabel = "_measurement"
+	fieldColLabel       = "_field"
+	// timeColumn is the name of the time column of every series.
+	timeColumn = "time"
+)
+
+// MultiResultEncoder encodes results as the JSON response of the InfluxDB 1.x /query endpoint.
+// Every result is the result of a statement, whose statement ID is the name of the result
+// if it is an integer and its position otherwise.
+//
+// The rows of the tables of a result are collected into series by measurement and tags:
+// the name of a series is the value of the `_measurement` column, and the tags are
+// the string columns of the group key, except for `_start`, `_stop`, `_measurement` and `_field`.
+// If a table has a `_field` and a `_value` column, its rows are pivoted so that every field
+// is a column of the series and the values of the fields at the same time are in the same row.
+// Otherwise, every column that is not part of the group key is a field.
+// The first column of a series is the time of the rows, which is the `_time` column,
+// or the `_start` column for aggregates that have no `_time`, or else the epoch,
+// and the rows of a series are sorted by time.
+//
+// The error of a result is the error of its statement and ends the response,
+// while the error of the results is the error of the response.
+type MultiResultEncoder struct {
+	// ChunkSize, if positive, writes the response in chunks the way the /query endpoint does
+	// with chunked=true: every chunk is a response on its own line with at most ChunkSize rows
+	// of a series, and partial marks the series and results that continue in the next chunk.
+	// The series of a table are written as soon as the table is read, so the rows of different
+	// tables are not collected into the same series.
+	ChunkSize int
+	// Epoch, if set, writes times as integers in the precision of the epoch query parameter
+	// of the /query endpoint: h, m, s, ms, u or ns. Times are RFC3339 strings otherwise.
+	Epoch string
+}
+
+// NewMultiResultEncoder creates a new InfluxQL multi result encoder that writes
+// the response as a single JSON object.
+func NewMultiResultEncoder() *MultiResultEncoder {
+	return &MultiResultEncoder{}
+}
+
+type influxqlEncoderError struct {
+	msg string
+}
+
+func (e *influxqlEncoderError) Error() string {
+	return e.msg
+}
+
+func (e *influxqlEncoderError) IsEncoderError() bool {
+	return true
+}
+
+func wrapEncodingError(err error) error {
+	return errors.Wrap(&influxqlEncoderError{msg: err.Error()}, "influxql encoder error")
+}
+
+type flusher interface {
+	Flush()
+}
+
+func (e *MultiResultEncoder) Encode(w io.Writer, results flux.ResultIterator) (int64, error) {
+	wc := &iocounter.Writer{Writer: w}
+	precision, err := epochPrecision(e.Epoch)
+	if err != nil {
+		return 0, wrapEncodingError(err)
+	}
+	enc := json.NewEncoder(wc)
+	write := func(resp *Response) error {
+		if err := enc.Encode(resp); err != nil {
+			return wrapEncodingError(err)
+		}
+		if f, ok := w.(flusher); ok {
+			f.Flush()
+		}
+		return nil
+	}
+
+	// The response is only built when it is not chunked.
+	var resp Response
+	for id := 0; results.More(); id++ {
+		res := results.Next()
+		statementID := id
+		if n, err := strconv.Atoi(res.Name()); err == nil {
+			statementID = n
+		}
+		if e.ChunkSize > 0 {
+			if err := e.writeChunks(write, statementID, res, precision); err != nil {
+				if flux.IsEncoderError(err) {
+					return wc.Count(), err
+				}
+				// The error of a statement ends the response.
+				err := write(&Response{Results: []Result{{StatementID: statementID, Err: err.Error()}}})
+				return wc.Count(), err
+			}
+			continue
+		}
+		series, err := encodeResult(res, precision)
+		if err != nil {
+			if flux.IsEncoderError(err) {
+				return wc.Count(), err
+			}
+			// The error of a statement ends the response.
+			resp.Results = append(resp.Results, Result{StatementID: statementID, Err: err.Error()})
+			err := write(&resp)
+			return wc.Count(), err
+		}
+		resp.Results = append(resp.Results, Result{StatementID: statementID, Series: series})
+	}
+
+	if err := results.Err(); err != nil {
+		if e.ChunkSize > 0 {
+			err := write(&Response{Err: err.Error()})
+			return wc.Count(), err
+		}
+		resp.Err = err.Error()
+	}
+	if e.ChunkSize > 0 {
+		return wc.Count(), nil
+	}
+	err = write(&resp)
+	return wc.Count(), err
+}
+
+// writeChunks writes the series of the tables of a result as responses with at most ChunkSize rows each.
+// The last chunk is held back until the next one is known, so that partial marks every chunk
+// of the result but the last one. A result without series is a single response.
+func (e *MultiResultEncoder) writeChunks(write func(*Response) error, statementID int, res flux.Result, precision time.Duration) error {
+	var pending *Series
+	writeChunk := func(s *Series, more bool) error {
+		result := Result{
+			StatementID: statementID,
+			Series:      []*Series{s},
+			Partial:     s.Partial || more,
+		}
+		return write(&Response{Results: []Result{result}})
+	}
+	err := res.Tables().Do(func(tbl flux.Table) error {
+		set := newSeriesSet()
+		if err := set.add(tbl); err != nil {
+			return err
+		}
+		for _, s := range set.finish(precision) {
+			rows := s.Values
+			for {
+				n := len(rows)
+				if n > e.ChunkSize {
+					n = e.ChunkSize
+				}
+				chunk := *s
+				chunk.Values = rows[:n]
+				rows = rows[n:]
+				chunk.Partial = len(rows) > 0
+				if pending != nil {
+					if err := writeChunk(pending, true); err != nil {
+						return err
+					}
+				}
+				pending = &chunk
+				if len(rows) == 0 {
+					break
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		// The error of the statement follows the chunks that were read before it.
+		if pending != nil && !flux.IsEncoderError(err) {
+			if err := writeChunk(pending, true); err != nil {
+				return err
+			}
+		}
+		return err
+	}
+	if pending == nil {
+		return write(&Response{Results: []Result{{StatementID: statementID}}})
+	}
+	return writeChunk(pending, false)
+}
+
+// epochPrecision returns the duration of the unit of integer times, or 0 for RFC3339 times.
+func epochPrecision(epoch string) (time.Duration, error) {
+	switch epoch {
+	case "":
+		return 0, nil
+	case "h":
+		return time.Hour, nil
+	case "m":
+		return time.Minute, nil
+	case "s":
+		return time.Second, nil
+	case "ms":
+		return time.Millisecond, nil
+	case "u", "µ":
+		return time.Microsecond, nil
+	case "ns", "n":
+		return time.Nanosecond, nil
+	}
+	return 0, errors.Errorf("invalid epoch %q", epoch)
+}
+
+// encodeResult collects the rows of the tables of a result into series.
+func encodeResult(res flux.Result, precision time.Duration) ([]*Series, error) {
+	set := newSeriesSet()
+	if err := res.Tables().Do(set.add); err != nil {
+		return nil, err
+	}
+	return set.finish(precision), nil
+}
+
+// seriesSet holds the series of a result in the order they first appear.
+type seriesSet struct {
+	series []*seriesRows
+	index  map[string]*seriesRows
+}
+
+func newSeriesSet() *seriesSet {
+	return &seriesSet{index: make(map[string]*seriesRows)}
+}
+
+// finish returns the series of the set.
+func (s *seriesSet) finish(precision time.Duration) []*Series {
+	series := make([]*Series, len(s.series))
+	for i, sr := range s.series {
+		series[i] = sr.finish(precision)
+	}
+	return series
+}
+
+// seriesRows holds the rows of a series, with a value for every field.
+type seriesRows struct {
+	name   string
+	tags   map[string]string
+	fields []string
+	// fieldIdx is the position of every field in fields.
+	fieldIdx map[string]int
+	rows     []*row
+	// times holds the rows by time, so that pivoted fields share rows.
+	times map[int64][]*row
+}
+
+type row struct {
+	time   int64
+	values []interface{}
+}
+
+// lookup returns the series with the name and tags, which are not modified.
+func (s *seriesSet) lookup(name string, tags map[string]string) *seriesRows {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	b.WriteString(strconv.Quote(name))
+	for _, k := range keys {
+		b.WriteString("," + strconv.Quote(k) + "=" + strconv.Quote(tags[k]))
+	}
+	key := b.String()
+	if sr, ok := s.index[key]; ok {
+		return sr
+	}
+	sr := &seriesRows{
+		name:     name,
+		tags:     tags,
+		fieldIdx: make(map[string]int),
+		times:    make(map[int64][]*row),
+	}
+	s.index[key] = sr
+	s.series = append(s.series, sr)
+	return sr
+}
+
+// add adds the rows of a table to the series.
+func (s *seriesSet) add(tbl flux.Table) error {
+	cols := tbl.Cols()
+	key := tbl.Key()
+	timeIdx := execute.ColIdx(execute.DefaultTimeColLabel, cols)
+	if timeIdx < 0 {
+		timeIdx = execute.ColIdx(execute.DefaultStartColLabel, cols)
+	}
+	if timeIdx >= 0 && cols[timeIdx].Type != flux.TTime {
+		return wrapEncodingError(errors.Errorf("column %s is not of type time", cols[timeIdx].Label))
+	}
+	measurementIdx := execute.ColIdx(measurementColLabel, cols)
+	if measurementIdx >= 0 && cols[measurementIdx].Type != flux.TString {
+		return wrapEncodingError(errors.Errorf("column %s is not of type string", measurementColLabel))
+	}
+	fieldIdx := execute.ColIdx(fieldColLabel, cols)
+	valueIdx := execute.ColIdx(execute.DefaultValueColLabel, cols)
+	pivot := fieldIdx >= 0 && valueIdx >= 0
+	if pivot && cols[fieldIdx].Type != flux.TString {
+		return wrapEncodingError(errors.Errorf("column %s is not of type string", fieldColLabel))
+	}
+
+	var tags map[string]string
+	var fieldCols []int
+	for j, c := range cols {
+		if c.Type == flux.TInvalid {
+			return wrapEncodingError(errors.Errorf("column %s has an invalid type", c.Label))
+		}
+		switch c.Label {
+		case execute.DefaultTimeColLabel, execute.DefaultStartColLabel, execute.DefaultStopColLabel, measurementColLabel:
+			continue
+		}
+		if k := execute.ColIdx(c.Label, key.Cols()); k >= 0 {
+			if c.Type == flux.TString && c.Label != fieldColLabel && !key.IsNull(k) {
+				if tags == nil {
+					tags = make(map[string]string)
+				}
+				tags[c.Label] = key.ValueString(k)
+			}
+		} else if !pivot {
+			fieldCols = append(fieldCols, j)
+		}
+	}
+
+	return tbl.Do(func(cr flux.ColReader) error {
+		var sr *seriesRows
+		name := ""
+		for i := 0; i < cr.Len(); i++ {
+			n := ""
+			if measurementIdx >= 0 && cr.Strings(measurementIdx).IsValid(i) {
+				n = cr.Strings(measurementIdx).ValueString(i)
+			}
+			if sr == nil || n != name {
+				name = n
+				sr = s.lookup(name, tags)
+				if !pivot {
+					for _, j := range fieldCols {
+						sr.field(cols[j].Label)
+					}
+				}
+			}
+			var t int64
+			if timeIdx >= 0 && cr.Times(timeIdx).IsValid(i) {
+				t = cr.Times(timeIdx).Value(i)
+			}
+
+			if pivot {
+				fields := cr.Strings(fieldIdx)
+				v := fieldValue(execute.ValueForRow(cr, i, valueIdx))
+				if !fields.IsValid(i) || v == nil {
+					continue
+				}
+				sr.set(t, sr.field(fields.ValueString(i)), v)
+				continue
+			}
+			var r *row
+			for _, j := range fieldCols {
+				v := fieldValue(execute.ValueForRow(cr, i, j))
+				if v == nil {
+					continue
+				}
+				if r == nil {
+					r = sr.newRow(t)
+				}
+				r.set(sr.field(cols[j].Label), v)
+			}
+		}
+		return nil
+	})
+}
+
+// field returns the position of a field, adding it if it is new.
+func (sr *seriesRows) field(label string) int {
+	if j, ok := sr.fieldIdx[label]; ok {
+		return j
+	}
+	sr.fieldIdx[label] = len(sr.fields)
+	sr.fields = append(sr.fields, label)
+	return len(sr.fields) - 1
+}
+
+func (sr *seriesRows) newRow(t int64) *row {
+	r := &row{time: t}
+	sr.rows = append(sr.rows, r)
+	sr.times[t] = append(sr.times[t], r)
+	return r
+}
+
+// set sets the value of a field in the first row at time t that does not have one.
+func (sr *seriesRows) set(t int64, j int, v interface{}) {
+	for _, r := range sr.times[t] {
+		if j >= len(r.values) || r.values[j] == nil {
+			r.set(j, v)
+			return
+		}
+	}
+	sr.newRow(t).set(j, v)
+}
+
+func (r *row) set(j int, v interface{}) {
+	for len(r.values) <= j {
+		r.values = append(r.values, nil)
+	}
+	r.values[j] = v
+}
+
+// finish returns the series with its rows sorted by time.
+func (sr *seriesRows) finish(precision time.Duration) *Series {
+	sort.SliceStable(sr.rows, func(i, j int) bool {
+		return sr.rows[i].time < sr.rows[j].time
+	})
+	series := &Series{
+		Name:    sr.name,
+		Tags:    sr.tags,
+		Columns: append([]string{timeColumn}, sr.fields...),
+		Values:  make([][]interface{}, len(sr.rows)),
+	}
+	for i, r := range sr.rows {
+		vs := make([]interface{}, len(series.Columns))
+		vs[0] = timeValue(values.Time(r.time), precision)
+		copy(vs[1:], r.values)
+		series.Values[i] = vs
+	}
+	return series
+}
+
+func timeValue(t values.Time, precision time.Duration) interface{} {
+	if precision == 0 {
+		return t.Time().UTC().Format(time.RFC3339Nano)
+	}
+	return int64(t) / int64(precision)
+}
+
+// fieldValue returns the JSON value of a field, or nil if it is null.
+// The floats NaN, +Inf and -Inf cannot be represented in JSON and are null too.
+func fieldValue(v values.Value) interface{} {
+	if v.IsNull() {
+		return nil
+	}
+	switch v.Type() {
+	case semantic.Bool:
+		return v.Bool()
+	case semantic.Int:
+		return v.Int()
+	case semantic.UInt:
+		return v.UInt()
+	case semantic.Float:
+		f := v.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil
+		}
+		return f
+	case semantic.Time:
+		return v.Time().Time().UTC().Format(time.RFC3339Nano)
+	default:
+		return v.Str()
+	}
+}
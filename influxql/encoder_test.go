@@ -0,0 +1,244 @@
+package influxql_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/influxql"
+)
+
+func TestMultiResultEncoder(t *testing.T) {
+	cpu := func() *executetest.Table {
+		return &executetest.Table{
+			KeyCols: []string{"_start", "_stop", "_measurement", "host", "_field"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_start", Type: flux.TTime},
+				{Label: "_stop", Type: flux.TTime},
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+				{Label: "_measurement", Type: flux.TString},
+				{Label: "host", Type: flux.TString},
+				{Label: "_field", Type: flux.TString},
+			},
+			Data: [][]interface{}{
+				{execute.Time(0), execute.Time(100e9), execute.Time(20e9), 2.5, "cpu", "a", "usage_user"},
+				{execute.Time(0), execute.Time(100e9), execute.Time(10e9), 1.5, "cpu", "a", "usage_user"},
+			},
+		}
+	}
+	system := func() *executetest.Table {
+		return &executetest.Table{
+			KeyCols: []string{"_start", "_stop", "_measurement", "host", "_field"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_start", Type: flux.TTime},
+				{Label: "_stop", Type: flux.TTime},
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+				{Label: "_measurement", Type: flux.TString},
+				{Label: "host", Type: flux.TString},
+				{Label: "_field", Type: flux.TString},
+			},
+			Data: [][]interface{}{
+				{execute.Time(0), execute.Time(100e9), execute.Time(10e9), 0.5, "cpu", "a", "usage_system"},
+				{execute.Time(0), execute.Time(100e9), execute.Time(30e9), nil, "cpu", "a", "usage_system"},
+			},
+		}
+	}
+	mem := func() *executetest.Table {
+		return &executetest.Table{
+			KeyCols: []string{"_measurement"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_measurement", Type: flux.TString},
+				{Label: "free", Type: flux.TInt},
+				{Label: "ok", Type: flux.TBool},
+			},
+			Data: [][]interface{}{
+				{execute.Time(10e9), "mem", int64(3), true},
+				{execute.Time(20e9), "mem", nil, nil},
+			},
+		}
+	}
+	count := func() *executetest.Table {
+		return &executetest.Table{
+			KeyCols: []string{"_start", "_stop"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_start", Type: flux.TTime},
+				{Label: "_stop", Type: flux.TTime},
+				{Label: "count", Type: flux.TUInt},
+			},
+			Data: [][]interface{}{
+				{execute.Time(60e9), execute.Time(120e9), uint64(7)},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		encoder *influxql.MultiResultEncoder
+		results []flux.Result
+		err     error
+		want    string
+	}{
+		{
+			name:    "pivoted fields",
+			encoder: influxql.NewMultiResultEncoder(),
+			results: []flux.Result{
+				&executetest.Result{Nm: "0", Tbls: []*executetest.Table{cpu(), system()}},
+				&executetest.Result{Nm: "_result", Tbls: []*executetest.Table{mem(), count()}},
+			},
+			want: `{"results":[{"statement_id":0,"series":[{"name":"cpu","tags":{"host":"a"},"columns":["time","usage_user","usage_system"],"values":[["1970-01-01T00:00:10Z",1.5,0.5],["1970-01-01T00:00:20Z",2.5,null]]}]},{"statement_id":1,"series":[{"name":"mem","columns":["time","free","ok"],"values":[["1970-01-01T00:00:10Z",3,true]]},{"columns":["time","count"],"values":[["1970-01-01T00:01:00Z",7]]}]}]}
+`,
+		},
+		{
+			name:    "epoch",
+			encoder: &influxql.MultiResultEncoder{Epoch: "ms"},
+			results: []flux.Result{
+				&executetest.Result{Nm: "0", Tbls: []*executetest.Table{count()}},
+			},
+			want: `{"results":[{"statement_id":0,"series":[{"columns":["time","count"],"values":[[60000,7]]}]}]}
+`,
+		},
+		{
+			name:    "chunked",
+			encoder: &influxql.MultiResultEncoder{ChunkSize: 1},
+			results: []flux.Result{
+				&executetest.Result{Nm: "0", Tbls: []*executetest.Table{cpu(), mem()}},
+				&executetest.Result{Nm: "1"},
+			},
+			want: `{"results":[{"statement_id":0,"series":[{"name":"cpu","tags":{"host":"a"},"columns":["time","usage_user"],"values":[["1970-01-01T00:00:10Z",1.5]],"partial":true}],"partial":true}]}
+{"results":[{"statement_id":0,"series":[{"name":"cpu","tags":{"host":"a"},"columns":["time","usage_user"],"values":[["1970-01-01T00:00:20Z",2.5]]}],"partial":true}]}
+{"results":[{"statement_id":0,"series":[{"name":"mem","columns":["time","free","ok"],"values":[["1970-01-01T00:00:10Z",3,true]]}]}]}
+{"results":[{"statement_id":1}]}
+`,
+		},
+		{
+			name:    "chunked tables",
+			encoder: &influxql.MultiResultEncoder{ChunkSize: 10},
+			results: []flux.Result{
+				&executetest.Result{Nm: "0", Tbls: []*executetest.Table{cpu(), system()}},
+			},
+			want: `{"results":[{"statement_id":0,"series":[{"name":"cpu","tags":{"host":"a"},"columns":["time","usage_user"],"values":[["1970-01-01T00:00:10Z",1.5],["1970-01-01T00:00:20Z",2.5]]}],"partial":true}]}
+{"results":[{"statement_id":0,"series":[{"name":"cpu","tags":{"host":"a"},"columns":["time","usage_system"],"values":[["1970-01-01T00:00:10Z",0.5]]}]}]}
+`,
+		},
+		{
+			name:    "chunked statement error",
+			encoder: &influxql.MultiResultEncoder{ChunkSize: 10},
+			results: []flux.Result{
+				&executetest.Result{Nm: "0", Tbls: []*executetest.Table{count(), {
+					ColMeta: []flux.ColMeta{{Label: "_value", Type: flux.TInt}},
+					Err:     errors.New("table failed"),
+				}}},
+			},
+			want: `{"results":[{"statement_id":0,"series":[{"columns":["time","count"],"values":[["1970-01-01T00:01:00Z",7]]}],"partial":true}]}
+{"results":[{"statement_id":0,"error":"table failed"}]}
+`,
+		},
+		{
+			name:    "statement error",
+			encoder: influxql.NewMultiResultEncoder(),
+			results: []flux.Result{
+				&executetest.Result{Nm: "0", Tbls: []*executetest.Table{count()}},
+				&executetest.Result{Nm: "1", Tbls: []*executetest.Table{{
+					ColMeta: []flux.ColMeta{{Label: "_value", Type: flux.TInt}},
+					Err:     errors.New("table failed"),
+				}}},
+				&executetest.Result{Nm: "2", Tbls: []*executetest.Table{count()}},
+			},
+			want: `{"results":[{"statement_id":0,"series":[{"columns":["time","count"],"values":[["1970-01-01T00:01:00Z",7]]}]},{"statement_id":1,"error":"table failed"}]}
+`,
+		},
+		{
+			name:    "response error",
+			encoder: &influxql.MultiResultEncoder{ChunkSize: 10},
+			results: []flux.Result{
+				&executetest.Result{Nm: "0", Tbls: []*executetest.Table{count()}},
+			},
+			err: errors.New("query failed"),
+			want: `{"results":[{"statement_id":0,"series":[{"columns":["time","count"],"values":[["1970-01-01T00:01:00Z",7]]}]}]}
+{"error":"query failed"}
+`,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			results := &errResultIterator{
+				ResultIterator: flux.NewSliceResultIterator(tc.results),
+				err:            tc.err,
+			}
+			n, err := tc.encoder.Encode(&buf, results)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := buf.String(); got != tc.want {
+				t.Errorf("unexpected response -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+			if n != int64(buf.Len()) {
+				t.Errorf("unexpected byte count: want %d, got %d", buf.Len(), n)
+			}
+		})
+	}
+}
+
+func TestMultiResultEncoder_RoundTrip(t *testing.T) {
+	table := func() *executetest.Table {
+		return &executetest.Table{
+			KeyCols: []string{"_measurement", "host", "_field"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_measurement", Type: flux.TString},
+				{Label: "host", Type: flux.TString},
+				{Label: "_field", Type: flux.TString},
+				{Label: "_value", Type: flux.TFloat},
+			},
+			Data: [][]interface{}{
+				{execute.Time(0), "cpu", "server01", "usage_user", 0.5},
+				{execute.Time(1), "cpu", "server01", "usage_user", 1.5},
+			},
+		}
+	}
+	results := flux.NewSliceResultIterator([]flux.Result{
+		&executetest.Result{Nm: "0", Tbls: []*executetest.Table{table()}},
+	})
+	var buf bytes.Buffer
+	if _, err := influxql.NewMultiResultEncoder().Encode(&buf, results); err != nil {
+		t.Fatal(err)
+	}
+
+	ri, err := influxql.NewResultDecoder(executetest.UnlimitedAllocator).Decode(ioutil.NopCloser(&buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []*executetest.Result
+	for ri.More() {
+		res := executetest.ConvertResult(ri.Next())
+		res.Normalize()
+		got = append(got, res)
+	}
+	if err := ri.Err(); err != nil {
+		t.Fatal(err)
+	}
+	want := []*executetest.Result{{Nm: "0", Tbls: []*executetest.Table{table()}}}
+	want[0].Normalize()
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected results -want/+got\n%s", cmp.Diff(want, got))
+	}
+}
+
+type errResultIterator struct {
+	flux.ResultIterator
+	err error
+}
+
+func (ri *errResultIterator) Err() error {
+	return ri.err
+}
@@ -0,0 +1,100 @@
+// Package sketch implements probabilistic data structures that summarize
+// a stream of values in a bounded amount of memory.
+package sketch
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+const (
+	// MinPrecision and MaxPrecision bound the precision of a HyperLogLog.
+	MinPrecision = 4
+	MaxPrecision = 18
+	// DefaultPrecision uses 16384 registers, for a standard error of about 0.8%.
+	DefaultPrecision = 14
+)
+
+// HyperLogLog estimates the number of distinct hashes that are added to it.
+// It uses 2^precision registers of one byte, and has a standard error of
+// about 1.04/sqrt(2^precision).
+type HyperLogLog struct {
+	p         uint8
+	registers []uint8
+}
+
+// NewHyperLogLog creates an empty HyperLogLog with the given precision.
+func NewHyperLogLog(precision int) (*HyperLogLog, error) {
+	if precision < MinPrecision || precision > MaxPrecision {
+		return nil, fmt.Errorf("precision must be between %d and %d, got %d", MinPrecision, MaxPrecision, precision)
+	}
+	return &HyperLogLog{
+		p:         uint8(precision),
+		registers: make([]uint8, 1<<uint(precision)),
+	}, nil
+}
+
+// Precision returns the precision of the HyperLogLog.
+func (h *HyperLogLog) Precision() int {
+	return int(h.p)
+}
+
+// Add adds the hash of a value.
+func (h *HyperLogLog) Add(hash uint64) {
+	idx := hash >> (64 - h.p)
+	// The remaining bits, with a sentinel bit so that the rank is at most 64-p+1.
+	w := hash<<h.p | 1<<(h.p-1)
+	rank := uint8(bits.LeadingZeros64(w)) + 1
+	if rank > h.registers[idx] {
+		h.registers[idx] = rank
+	}
+}
+
+// Merge adds all hashes of another HyperLogLog of the same precision.
+func (h *HyperLogLog) Merge(o *HyperLogLog) error {
+	if h.p != o.p {
+		return errors.New("cannot merge HyperLogLogs of different precisions")
+	}
+	for i, r := range o.registers {
+		if r > h.registers[i] {
+			h.registers[i] = r
+		}
+	}
+	return nil
+}
+
+// Count returns the estimated number of distinct hashes.
+func (h *HyperLogLog) Count() uint64 {
+	m := float64(len(h.registers))
+	var (
+		sum   float64
+		zeros int
+	)
+	for _, r := range h.registers {
+		sum += 1 / float64(uint64(1)<<r)
+		if r == 0 {
+			zeros++
+		}
+	}
+	estimate := alpha(len(h.registers)) * m * m / sum
+	// Linear counting is more accurate for small cardinalities.
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(estimate + 0.5)
+}
+
+func alpha(m int) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	default:
+		return 0.7213 / (1 + 1.079/float64(m))
+	}
+}
@@ -0,0 +1,113 @@
+package sketch_test
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/cespare/xxhash"
+	"github.com/influxdata/flux/internal/sketch"
+)
+
+func hash(i int) uint64 {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(i))
+	return xxhash.Sum64(buf[:])
+}
+
+func TestHyperLogLog_Count(t *testing.T) {
+	for _, n := range []int{0, 1, 100, 10000, 1000000} {
+		h, err := sketch.NewHyperLogLog(sketch.DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < n; i++ {
+			// Every value is added twice.
+			h.Add(hash(i))
+			h.Add(hash(i))
+		}
+		got := float64(h.Count())
+		if diff := math.Abs(got - float64(n)); diff > 0.03*float64(n) {
+			t.Errorf("unexpected count of %d distinct values: %v", n, got)
+		}
+	}
+}
+
+func TestHyperLogLog_Merge(t *testing.T) {
+	a, _ := sketch.NewHyperLogLog(sketch.DefaultPrecision)
+	b, _ := sketch.NewHyperLogLog(sketch.DefaultPrecision)
+	for i := 0; i < 20000; i++ {
+		a.Add(hash(i))
+		b.Add(hash(i + 10000))
+	}
+	if err := a.Merge(b); err != nil {
+		t.Fatal(err)
+	}
+	if got := float64(a.Count()); math.Abs(got-30000) > 900 {
+		t.Errorf("unexpected count after merge: %v", got)
+	}
+
+	c, _ := sketch.NewHyperLogLog(sketch.MinPrecision)
+	if err := a.Merge(c); err == nil {
+		t.Error("expected an error merging different precisions")
+	}
+}
+
+func TestNewHyperLogLog_Precision(t *testing.T) {
+	for _, p := range []int{sketch.MinPrecision - 1, sketch.MaxPrecision + 1} {
+		if _, err := sketch.NewHyperLogLog(p); err == nil {
+			t.Errorf("expected an error for precision %d", p)
+		}
+	}
+}
+
+func lessInt(a, b interface{}) bool {
+	return a.(int) < b.(int)
+}
+
+func TestSpaceSaving_Top(t *testing.T) {
+	s := sketch.NewSpaceSaving(20)
+	// Value i is added i times for the frequent values, and the
+	// infrequent values are added once, interleaved with the others.
+	next := 1000
+	for i := 1; i <= 5; i++ {
+		for j := 0; j < i*100; j++ {
+			s.Add(i)
+			s.Add(next)
+			next++
+		}
+	}
+	top := s.Top(3, lessInt)
+	if len(top) != 3 {
+		t.Fatalf("unexpected number of counters: %d", len(top))
+	}
+	for i, want := range []int{5, 4, 3} {
+		if top[i].Item != want {
+			t.Errorf("unexpected item at %d: got %v want %v", i, top[i].Item, want)
+		}
+		if top[i].Count-top[i].Error > int64(want*100) || top[i].Count < int64(want*100) {
+			t.Errorf("count of %d is out of bounds: %d with error %d", want, top[i].Count, top[i].Error)
+		}
+	}
+}
+
+func TestSpaceSaving_Exact(t *testing.T) {
+	s := sketch.NewSpaceSaving(10)
+	for _, v := range []int{3, 1, 2, 1, 3, 1} {
+		s.Add(v)
+	}
+	top := s.Top(5, lessInt)
+	want := []sketch.Counter{
+		{Item: 1, Count: 3},
+		{Item: 3, Count: 2},
+		{Item: 2, Count: 1},
+	}
+	if len(top) != len(want) {
+		t.Fatalf("unexpected number of counters: %d", len(top))
+	}
+	for i := range want {
+		if top[i].Item != want[i].Item || top[i].Count != want[i].Count || top[i].Error != 0 {
+			t.Errorf("unexpected counter at %d: got %+v want %+v", i, top[i], want[i])
+		}
+	}
+}
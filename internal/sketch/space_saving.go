@@ -0,0 +1,118 @@
+package sketch
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// SpaceSaving estimates the most frequent items of a stream with the Space-Saving algorithm.
+// It keeps at most capacity counters. When a new item arrives and all counters are in use,
+// the item replaces the item with the smallest count and inherits its count.
+// The count of an item is thus an upper bound of its frequency, and is off by at most its error.
+// Every item whose frequency is more than n/capacity, where n is the number of added items,
+// is guaranteed to be kept.
+type SpaceSaving struct {
+	capacity int
+	counters map[interface{}]*Counter
+	// heap orders the counters by count, the smallest count first.
+	heap counterHeap
+}
+
+// Counter is the estimated count of an item.
+type Counter struct {
+	Item interface{}
+	// Count is an upper bound of the number of times the item was added.
+	Count int64
+	// Error is the amount by which Count may overestimate the frequency.
+	Error int64
+
+	index int
+}
+
+// NewSpaceSaving creates an empty SpaceSaving with the given number of counters.
+// The items must be comparable.
+func NewSpaceSaving(capacity int) *SpaceSaving {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &SpaceSaving{
+		capacity: capacity,
+		counters: make(map[interface{}]*Counter),
+	}
+}
+
+// Add adds an item.
+func (s *SpaceSaving) Add(item interface{}) {
+	s.add(item, 1, 0)
+}
+
+func (s *SpaceSaving) add(item interface{}, count, errCount int64) {
+	if c, ok := s.counters[item]; ok {
+		c.Count += count
+		c.Error += errCount
+		heap.Fix(&s.heap, c.index)
+		return
+	}
+	if len(s.counters) < s.capacity {
+		c := &Counter{Item: item, Count: count, Error: errCount}
+		s.counters[item] = c
+		heap.Push(&s.heap, c)
+		return
+	}
+	min := s.heap[0]
+	delete(s.counters, min.Item)
+	min.Item = item
+	min.Error = min.Count + errCount
+	min.Count += count
+	s.counters[item] = min
+	heap.Fix(&s.heap, 0)
+}
+
+// Merge adds the counters of another SpaceSaving.
+func (s *SpaceSaving) Merge(o *SpaceSaving) {
+	for _, c := range o.heap {
+		s.add(c.Item, c.Count, c.Error)
+	}
+}
+
+// Top returns the counters of at most k items with the largest counts, the largest count first.
+// Items with the same count are ordered by the less function.
+func (s *SpaceSaving) Top(k int, less func(a, b interface{}) bool) []Counter {
+	top := make([]Counter, 0, len(s.heap))
+	for _, c := range s.heap {
+		top = append(top, *c)
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].Count != top[j].Count {
+			return top[i].Count > top[j].Count
+		}
+		return less(top[i].Item, top[j].Item)
+	})
+	if len(top) > k {
+		top = top[:k]
+	}
+	return top
+}
+
+type counterHeap []*Counter
+
+func (h counterHeap) Len() int           { return len(h) }
+func (h counterHeap) Less(i, j int) bool { return h[i].Count < h[j].Count }
+func (h counterHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *counterHeap) Push(x interface{}) {
+	c := x.(*Counter)
+	c.index = len(*h)
+	*h = append(*h, c)
+}
+
+func (h *counterHeap) Pop() interface{} {
+	old := *h
+	c := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return c
+}
@@ -0,0 +1,89 @@
+package sketch
+
+import (
+	"math"
+	"sort"
+)
+
+// Centroid is the mean of a cluster of values of a TDigest, and the number of values in it.
+type Centroid struct {
+	Mean   float64
+	Weight float64
+}
+
+func (c *Centroid) add(o Centroid) {
+	c.Weight += o.Weight
+	c.Mean += o.Weight * (o.Mean - c.Mean) / c.Weight
+}
+
+// TDigest summarizes a distribution of values as a list of centroids that is small,
+// but still allows quantiles to be estimated accurately. Unlike github.com/influxdata/tdigest,
+// it gives access to its centroids so that the summary can be stored and merged later.
+// It clusters the centroids with the same scale function as that package,
+// so the centroids can be added to it to estimate quantiles.
+type TDigest struct {
+	compression float64
+	merged      []Centroid
+	unmerged    []Centroid
+	weight      float64
+}
+
+// NewTDigest creates an empty TDigest. The compression bounds the number of centroids,
+// a larger compression keeps more centroids and gives more accurate quantiles.
+func NewTDigest(compression float64) *TDigest {
+	return &TDigest{compression: compression}
+}
+
+// Add adds a value, or a centroid, with the given weight.
+func (t *TDigest) Add(mean, weight float64) {
+	if math.IsNaN(mean) || weight <= 0 {
+		return
+	}
+	t.unmerged = append(t.unmerged, Centroid{Mean: mean, Weight: weight})
+	t.weight += weight
+	if float64(len(t.unmerged)) > 8*math.Ceil(t.compression) {
+		t.compress()
+	}
+}
+
+// Centroids returns the centroids of the digest in ascending order of their means.
+// The returned slice must not be modified.
+func (t *TDigest) Centroids() []Centroid {
+	t.compress()
+	return t.merged
+}
+
+func (t *TDigest) compress() {
+	if len(t.unmerged) == 0 {
+		return
+	}
+	all := append(t.unmerged, t.merged...)
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Mean < all[j].Mean
+	})
+
+	merged := make([]Centroid, 1, len(t.merged)+1)
+	merged[0] = all[0]
+	soFar := all[0].Weight
+	limit := t.weight * t.integratedQ(1)
+	for _, c := range all[1:] {
+		if soFar+c.Weight <= limit {
+			merged[len(merged)-1].add(c)
+		} else {
+			k := t.integratedLocation(soFar / t.weight)
+			limit = t.weight * t.integratedQ(k+1)
+			merged = append(merged, c)
+		}
+		soFar += c.Weight
+	}
+	t.merged = merged
+	t.unmerged = t.unmerged[:0]
+}
+
+func (t *TDigest) integratedQ(k float64) float64 {
+	return (math.Sin(math.Min(k, t.compression)*math.Pi/t.compression-math.Pi/2) + 1) / 2
+}
+
+func (t *TDigest) integratedLocation(q float64) float64 {
+	return t.compression * (math.Asin(2*q-1) + math.Pi/2) / math.Pi
+}
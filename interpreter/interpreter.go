@@ -290,7 +290,7 @@ func (itrp *Interpreter) doExpression(expr semantic.Expression, scope Scope) (va
 			case semantic.Float:
 				return values.NewFloat(-v.Float()), nil
 			case semantic.Duration:
-				return values.NewDuration(-v.Duration()), nil
+				return values.NewCalendarDuration(values.CalendarDurationOf(v).Neg()), nil
 			default:
 				return nil, fmt.Errorf("operand to unary expression is not a number value, got %v", v.Type())
 			}
@@ -448,7 +448,10 @@ func (itrp *Interpreter) doLiteral(lit semantic.Literal) (values.Value, error) {
 	case *semantic.DateTimeLiteral:
 		return values.NewTime(values.Time(l.Value.UnixNano())), nil
 	case *semantic.DurationLiteral:
-		return values.NewDuration(values.Duration(l.Value)), nil
+		return values.NewCalendarDuration(values.CalendarDuration{
+			Months:      l.Months,
+			Nanoseconds: values.Duration(l.Value),
+		}), nil
 	case *semantic.FloatLiteral:
 		return values.NewFloat(l.Value), nil
 	case *semantic.IntegerLiteral:
@@ -970,8 +973,10 @@ func resolveValue(v values.Value) (semantic.Node, error) {
 			Value: v.Regexp(),
 		}, nil
 	case semantic.Duration:
+		d := values.CalendarDurationOf(v)
 		return &semantic.DurationLiteral{
-			Value: v.Duration().Duration(),
+			Months: d.Months,
+			Value:  d.Nanoseconds.Duration(),
 		}, nil
 	case semantic.Function:
 		resolver, ok := v.Function().(Resolver)
@@ -0,0 +1,32 @@
+package json
+
+import (
+	"net/http"
+
+	"github.com/influxdata/flux"
+)
+
+const DialectType = "json"
+
+// AddDialectMappings adds the JSON dialect mappings.
+func AddDialectMappings(mappings flux.DialectMappings) error {
+	return mappings.Add(DialectType, func() flux.Dialect {
+		return &Dialect{}
+	})
+}
+
+// Dialect describes the output format of queries as JSON documents, one per table and line.
+type Dialect struct{}
+
+func (d Dialect) SetHeaders(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Transfer-Encoding", "chunked")
+}
+
+func (d Dialect) Encoder() flux.MultiResultEncoder {
+	return NewMultiResultEncoder()
+}
+
+func (d Dialect) DialectType() flux.DialectType {
+	return DialectType
+}
@@ -0,0 +1,213 @@
+package json
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/iocounter"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	"github.com/pkg/errors"
+)
+
+// ResultEncoder encodes the tables of a result as JSON documents, one per line:
+//
+//	{"result":"_result","table":0,"groupKey":{"host":"a"},"columns":[{"label":"host","type":"string"},...],"rows":[["a",...],...]}
+//
+// The rows of a table are written as its buffers are read, so tables are streamed
+// without being held in memory. Times are RFC3339 strings with nanoseconds, and the
+// float values NaN, +Inf and -Inf, which JSON numbers cannot represent, are strings.
+type ResultEncoder struct{}
+
+// NewResultEncoder creates a new JSON result encoder.
+func NewResultEncoder() *ResultEncoder {
+	return &ResultEncoder{}
+}
+
+type jsonEncoderError struct {
+	msg string
+}
+
+func (e *jsonEncoderError) Error() string {
+	return e.msg
+}
+
+func (e *jsonEncoderError) IsEncoderError() bool {
+	return true
+}
+
+func wrapEncodingError(err error) error {
+	return errors.Wrap(&jsonEncoderError{msg: err.Error()}, "json encoder error")
+}
+
+func (e *ResultEncoder) Encode(w io.Writer, result flux.Result) (int64, error) {
+	wc := &countWriter{Writer: iocounter.Writer{Writer: w}}
+	// The tables are numbered within the result.
+	table := 0
+	err := result.Tables().Do(func(tbl flux.Table) error {
+		if err := encodeTable(wc, result.Name(), table, tbl); err != nil {
+			return err
+		}
+		// Flush the writer after each table so that clients receive whole documents.
+		wc.Flush()
+		table++
+		return nil
+	})
+	return wc.Count(), err
+}
+
+type flusher interface {
+	Flush()
+}
+
+// countWriter counts the bytes written to a writer and forwards Flush to it,
+// so that counting the bytes does not hide that the writer can be flushed.
+type countWriter struct {
+	iocounter.Writer
+}
+
+func (w *countWriter) Flush() {
+	if f, ok := w.Writer.Writer.(flusher); ok {
+		f.Flush()
+	}
+}
+
+func encodeTable(w io.Writer, name string, table int, tbl flux.Table) error {
+	cols := tbl.Cols()
+	for _, c := range cols {
+		if c.Type == flux.TInvalid {
+			return wrapEncodingError(errors.Errorf("column %s has an invalid type", c.Label))
+		}
+	}
+
+	buf := append([]byte(`{"result":`), quote(name)...)
+	buf = append(buf, `,"table":`...)
+	buf = strconv.AppendInt(buf, int64(table), 10)
+	buf = append(buf, `,"groupKey":{`...)
+	key := tbl.Key()
+	for j, c := range key.Cols() {
+		if j > 0 {
+			buf = append(buf, ',')
+		}
+		buf = append(append(buf, quote(c.Label)...), ':')
+		buf = appendValue(buf, key.Value(j))
+	}
+	buf = append(buf, `},"columns":[`...)
+	for j, c := range cols {
+		if j > 0 {
+			buf = append(buf, ',')
+		}
+		buf = append(buf, `{"label":`...)
+		buf = append(buf, quote(c.Label)...)
+		buf = append(buf, `,"type":`...)
+		buf = append(buf, quote(c.Type.String())...)
+		buf = append(buf, '}')
+	}
+	buf = append(buf, `],"rows":[`...)
+
+	first := true
+	err := tbl.Do(func(cr flux.ColReader) error {
+		for i := 0; i < cr.Len(); i++ {
+			if !first {
+				buf = append(buf, ',')
+			}
+			first = false
+			buf = append(buf, '[')
+			for j := range cols {
+				if j > 0 {
+					buf = append(buf, ',')
+				}
+				buf = appendValue(buf, execute.ValueForRow(cr, i, j))
+			}
+			buf = append(buf, ']')
+		}
+		// Write the rows of every buffer so that the table is streamed.
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+		buf = buf[:0]
+		return nil
+	})
+	// The document is ended even if reading the table fails, so that the error can follow it.
+	if _, writeErr := w.Write(append(buf, "]}\n"...)); err == nil {
+		err = writeErr
+	}
+	return err
+}
+
+// appendValue appends the JSON encoding of a value.
+func appendValue(buf []byte, v values.Value) []byte {
+	if v.IsNull() {
+		return append(buf, "null"...)
+	}
+	switch v.Type() {
+	case semantic.Bool:
+		return strconv.AppendBool(buf, v.Bool())
+	case semantic.Int:
+		return strconv.AppendInt(buf, v.Int(), 10)
+	case semantic.UInt:
+		return strconv.AppendUint(buf, v.UInt(), 10)
+	case semantic.Float:
+		f := v.Float()
+		switch {
+		case math.IsNaN(f):
+			return append(buf, `"NaN"`...)
+		case math.IsInf(f, 1):
+			return append(buf, `"+Inf"`...)
+		case math.IsInf(f, -1):
+			return append(buf, `"-Inf"`...)
+		}
+		return strconv.AppendFloat(buf, f, 'g', -1, 64)
+	case semantic.Time:
+		return append(buf, quote(v.Time().Time().UTC().Format(time.RFC3339Nano))...)
+	default:
+		return append(buf, quote(v.Str())...)
+	}
+}
+
+func quote(s string) []byte {
+	b, _ := json.Marshal(s)
+	return b
+}
+
+// MultiResultEncoder encodes the tables of every result as JSON documents, one per line.
+// An error of the results is encoded as a last document with the error message:
+//
+//	{"error":"..."}
+type MultiResultEncoder struct {
+	encoder *ResultEncoder
+}
+
+// NewMultiResultEncoder creates a new JSON multi result encoder.
+func NewMultiResultEncoder() *MultiResultEncoder {
+	return &MultiResultEncoder{encoder: NewResultEncoder()}
+}
+
+func (e *MultiResultEncoder) Encode(w io.Writer, results flux.ResultIterator) (int64, error) {
+	wc := &countWriter{Writer: iocounter.Writer{Writer: w}}
+	for results.More() {
+		if _, err := e.encoder.Encode(wc, results.Next()); err != nil {
+			if flux.IsEncoderError(err) {
+				return wc.Count(), err
+			}
+			err := encodeError(wc, err)
+			return wc.Count(), err
+		}
+	}
+	if err := results.Err(); err != nil {
+		err := encodeError(wc, err)
+		return wc.Count(), err
+	}
+	return wc.Count(), nil
+}
+
+func encodeError(w io.Writer, err error) error {
+	buf := append([]byte(`{"error":`), quote(err.Error())...)
+	_, err = w.Write(append(buf, "}\n"...))
+	return err
+}
@@ -0,0 +1,124 @@
+package json_test
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/json"
+)
+
+func TestMultiResultEncoder(t *testing.T) {
+	results := flux.NewSliceResultIterator([]flux.Result{
+		&executetest.Result{
+			Nm: "_result",
+			Tbls: []*executetest.Table{
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "ok", Type: flux.TBool},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1500000000000000000), "a", 1.5, true},
+						{execute.Time(1500000000000000001), "a", math.NaN(), nil},
+					},
+				},
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "host", Type: flux.TString},
+						{Label: "count", Type: flux.TInt},
+						{Label: "size", Type: flux.TUInt},
+					},
+					Data: [][]interface{}{
+						{"b\"c", int64(-3), uint64(4)},
+					},
+				},
+			}},
+		&executetest.Result{
+			Nm: "failed",
+			Tbls: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{{Label: "_value", Type: flux.TInt}},
+				Err:     errors.New("table failed"),
+			}},
+		},
+	})
+	var buf bytes.Buffer
+	n, err := json.NewMultiResultEncoder().Encode(&buf, results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"result":"_result","table":0,"groupKey":{"host":"a"},"columns":[{"label":"_time","type":"time"},{"label":"host","type":"string"},{"label":"_value","type":"float"},{"label":"ok","type":"bool"}],"rows":[["2017-07-14T02:40:00Z","a",1.5,true],["2017-07-14T02:40:00.000000001Z","a","NaN",null]]}
+{"result":"_result","table":1,"groupKey":{"host":"b\"c"},"columns":[{"label":"host","type":"string"},{"label":"count","type":"int"},{"label":"size","type":"uint"}],"rows":[["b\"c",-3,4]]}
+{"result":"failed","table":0,"groupKey":{},"columns":[{"label":"_value","type":"int"}],"rows":[]}
+{"error":"table failed"}
+`
+	if got := buf.String(); got != want {
+		t.Errorf("unexpected output -want/+got\n%s", cmp.Diff(want, got))
+	}
+	if n != int64(buf.Len()) {
+		t.Errorf("unexpected byte count: want %d, got %d", buf.Len(), n)
+	}
+}
+
+func TestMultiResultEncoder_Error(t *testing.T) {
+	results := flux.NewSliceResultIterator(nil)
+	results.Release()
+	var buf bytes.Buffer
+	if _, err := json.NewMultiResultEncoder().Encode(&buf, &errResultIterator{
+		ResultIterator: results,
+		err:            errors.New("query failed"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "{\"error\":\"query failed\"}\n"; got != want {
+		t.Errorf("unexpected output: want %q, got %q", want, got)
+	}
+}
+
+func TestMultiResultEncoder_Flush(t *testing.T) {
+	tbl := func() *executetest.Table {
+		return &executetest.Table{
+			ColMeta: []flux.ColMeta{{Label: "_value", Type: flux.TInt}},
+			Data:    [][]interface{}{{int64(1)}},
+		}
+	}
+	results := flux.NewSliceResultIterator([]flux.Result{
+		&executetest.Result{Nm: "a", Tbls: []*executetest.Table{tbl(), tbl()}},
+		&executetest.Result{Nm: "b", Tbls: []*executetest.Table{tbl()}},
+	})
+	w := &flushWriter{}
+	if _, err := json.NewMultiResultEncoder().Encode(w, results); err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{1, 2, 3}; !cmp.Equal(want, w.flushed) {
+		t.Errorf("unexpected documents at each flush: want %v, got %v", want, w.flushed)
+	}
+}
+
+// flushWriter records the number of documents written at each flush.
+type flushWriter struct {
+	bytes.Buffer
+	flushed []int
+}
+
+func (w *flushWriter) Flush() {
+	w.flushed = append(w.flushed, bytes.Count(w.Bytes(), []byte("\n")))
+}
+
+type errResultIterator struct {
+	flux.ResultIterator
+	err error
+}
+
+func (ri *errResultIterator) Err() error {
+	return ri.err
+}
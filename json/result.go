@@ -0,0 +1,303 @@
+// Package json decodes JSON documents into flux tables.
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/values"
+)
+
+// ResultDecoderConfig is the configuration for a result decoder.
+type ResultDecoderConfig struct {
+	// Path is the dot separated list of object keys that leads from
+	// the root of the document to the array of records.
+	// An empty path means that the document itself is the array.
+	Path string
+	// Lines decodes newline delimited JSON, where every non-empty line
+	// is a record, instead of an array of records. It excludes Path.
+	Lines bool
+	// Allocator is the memory allocator used for the table.
+	// If nil, a new unlimited allocator is used.
+	Allocator *memory.Allocator
+}
+
+// ResultDecoder decodes a JSON document into a flux.Result with a single table.
+// The array found at the configured path must contain objects and every object becomes a row.
+// The columns are the keys of the objects in the order they first appear.
+//
+// Column types are inferred from the values of each column:
+// numbers become integers if they are all integral and floats otherwise,
+// strings become times if they are all RFC3339 timestamps,
+// and nested objects and arrays are kept as JSON encoded strings.
+// A column whose values have different JSON types is a string column.
+// Missing keys and JSON nulls are null values.
+//
+// With Lines the document is a sequence of objects, one per line, such as the
+// output of a log shipper or the records streamed to socket.from.
+type ResultDecoder struct {
+	config  ResultDecoderConfig
+	records []record
+	columns []string
+	// seen holds the keys that are columns.
+	seen map[string]bool
+}
+
+// NewResultDecoder creates a new result decoder from config.
+func NewResultDecoder(config ResultDecoderConfig) *ResultDecoder {
+	return &ResultDecoder{config: config}
+}
+
+// record holds the values of a JSON object by key.
+type record map[string]interface{}
+
+// rawJSON is a nested object or array that is kept as JSON text.
+type rawJSON string
+
+func (rd *ResultDecoder) Decode(r io.Reader) (flux.Result, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	rd.records = rd.records[:0]
+	rd.columns = rd.columns[:0]
+	rd.seen = make(map[string]bool)
+	if rd.config.Lines {
+		if rd.config.Path != "" {
+			return nil, errors.New(codes.Invalid, "a path cannot be used with JSON lines")
+		}
+		for i, line := range bytes.Split(data, []byte("\n")) {
+			if len(bytes.TrimSpace(line)) == 0 {
+				continue
+			}
+			if err := rd.addRecord(line); err != nil {
+				return nil, errors.Newf(codes.Invalid, "line %d: %v", i+1, err)
+			}
+		}
+		return rd, nil
+	}
+
+	raw := json.RawMessage(data)
+	if rd.config.Path != "" {
+		for _, key := range strings.Split(rd.config.Path, ".") {
+			var obj map[string]json.RawMessage
+			if err := json.Unmarshal(raw, &obj); err != nil {
+				return nil, errors.Newf(codes.Invalid, "path %q: %s is not an object", rd.config.Path, key)
+			}
+			v, ok := obj[key]
+			if !ok {
+				return nil, errors.Newf(codes.Invalid, "path %q: key %s not found", rd.config.Path, key)
+			}
+			raw = v
+		}
+	}
+
+	var elements []json.RawMessage
+	if err := json.Unmarshal(raw, &elements); err != nil {
+		return nil, errors.Newf(codes.Invalid, "expected an array of records: %v", err)
+	}
+	for i, e := range elements {
+		if err := rd.addRecord(e); err != nil {
+			return nil, errors.Newf(codes.Invalid, "record %d: %v", i, err)
+		}
+	}
+	return rd, nil
+}
+
+// addRecord decodes a record and adds the keys that have not been seen as columns.
+func (rd *ResultDecoder) addRecord(data json.RawMessage) error {
+	rec, keys, err := decodeRecord(data)
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if !rd.seen[k] {
+			rd.seen[k] = true
+			rd.columns = append(rd.columns, k)
+		}
+	}
+	rd.records = append(rd.records, rec)
+	return nil
+}
+
+// decodeRecord decodes a JSON object and returns its keys in document order.
+func decodeRecord(data json.RawMessage) (record, []string, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, nil, err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != '{' {
+		return nil, nil, fmt.Errorf("expected an object, got %s", data)
+	}
+	rec := make(record)
+	var keys []string
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		key := tok.(string)
+		var v json.RawMessage
+		if err := dec.Decode(&v); err != nil {
+			return nil, nil, err
+		}
+		if _, ok := rec[key]; !ok {
+			keys = append(keys, key)
+		}
+		rec[key], err = decodeValue(v)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return rec, keys, nil
+}
+
+// decodeValue returns nil, bool, json.Number, string or rawJSON.
+func decodeValue(data json.RawMessage) (interface{}, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && (data[0] == '{' || data[0] == '[') {
+		return rawJSON(data), nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// columnType infers the type of the column from the values of all records.
+func (rd *ResultDecoder) columnType(label string) flux.ColType {
+	typ := flux.TInvalid
+	for _, rec := range rd.records {
+		v := rec[label]
+		var t flux.ColType
+		switch v := v.(type) {
+		case nil:
+			continue
+		case bool:
+			t = flux.TBool
+		case json.Number:
+			t = flux.TInt
+			if _, err := v.Int64(); err != nil {
+				t = flux.TFloat
+			}
+		case string:
+			t = flux.TTime
+			if _, err := time.Parse(time.RFC3339Nano, v); err != nil {
+				t = flux.TString
+			}
+		default:
+			t = flux.TString
+		}
+		switch {
+		case typ == flux.TInvalid || typ == t:
+			typ = t
+		case (typ == flux.TInt && t == flux.TFloat) || (typ == flux.TFloat && t == flux.TInt):
+			typ = flux.TFloat
+		case (typ == flux.TTime && t == flux.TString) || (typ == flux.TString && t == flux.TTime):
+			typ = flux.TString
+		default:
+			// Mixed JSON types are kept as text.
+			return flux.TString
+		}
+	}
+	if typ == flux.TInvalid {
+		return flux.TString
+	}
+	return typ
+}
+
+func (rd *ResultDecoder) Do(f func(flux.Table) error) error {
+	alloc := rd.config.Allocator
+	if alloc == nil {
+		alloc = &memory.Allocator{}
+	}
+	key := execute.NewGroupKey(nil, nil)
+	builder := execute.NewColListTableBuilder(key, alloc)
+	types := make([]flux.ColType, len(rd.columns))
+	for j, label := range rd.columns {
+		types[j] = rd.columnType(label)
+		if _, err := builder.AddCol(flux.ColMeta{Label: label, Type: types[j]}); err != nil {
+			return err
+		}
+	}
+	for _, rec := range rd.records {
+		for j, label := range rd.columns {
+			v, err := toValue(rec[label], types[j])
+			if err != nil {
+				return errors.Newf(codes.Invalid, "column %s: %v", label, err)
+			}
+			if err := builder.AppendValue(j, v); err != nil {
+				return err
+			}
+		}
+	}
+	tbl, err := builder.Table()
+	if err != nil {
+		return err
+	}
+	return f(tbl)
+}
+
+func toValue(v interface{}, typ flux.ColType) (values.Value, error) {
+	if v == nil {
+		return values.NewNull(flux.SemanticType(typ)), nil
+	}
+	switch typ {
+	case flux.TBool:
+		return values.NewBool(v.(bool)), nil
+	case flux.TInt:
+		n, err := v.(json.Number).Int64()
+		if err != nil {
+			return nil, err
+		}
+		return values.NewInt(n), nil
+	case flux.TFloat:
+		f, err := v.(json.Number).Float64()
+		if err != nil {
+			return nil, err
+		}
+		return values.NewFloat(f), nil
+	case flux.TTime:
+		t, err := time.Parse(time.RFC3339Nano, v.(string))
+		if err != nil {
+			return nil, err
+		}
+		return values.NewTime(values.ConvertTime(t)), nil
+	default:
+		switch v := v.(type) {
+		case string:
+			return values.NewString(v), nil
+		case rawJSON:
+			return values.NewString(string(v)), nil
+		case json.Number:
+			return values.NewString(v.String()), nil
+		case bool:
+			return values.NewString(strconv.FormatBool(v)), nil
+		}
+	}
+	return nil, fmt.Errorf("cannot convert %T to %v", v, typ)
+}
+
+func (*ResultDecoder) Name() string {
+	return "_result"
+}
+
+func (rd *ResultDecoder) Tables() flux.TableIterator {
+	return rd
+}
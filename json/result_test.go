@@ -0,0 +1,151 @@
+package json_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/json"
+	"github.com/influxdata/flux/values"
+)
+
+func TestResultDecoder(t *testing.T) {
+	t1 := values.ConvertTime(time.Date(2019, 6, 3, 13, 59, 1, 0, time.UTC))
+	t2 := values.ConvertTime(time.Date(2019, 6, 3, 14, 0, 0, 0, time.UTC))
+	testCases := []struct {
+		name    string
+		path    string
+		lines   bool
+		input   string
+		want    *executetest.Table
+		wantErr bool
+	}{
+		{
+			name: "array of records",
+			input: `[
+	{"time": "2019-06-03T13:59:01Z", "host": "a", "count": 1, "load": 0.5, "up": true},
+	{"time": "2019-06-03T14:00:00Z", "host": "b", "count": 2, "load": 1, "up": false}
+]`,
+			want: &executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "time", Type: flux.TTime},
+					{Label: "host", Type: flux.TString},
+					{Label: "count", Type: flux.TInt},
+					{Label: "load", Type: flux.TFloat},
+					{Label: "up", Type: flux.TBool},
+				},
+				Data: [][]interface{}{
+					{t1, "a", int64(1), 0.5, true},
+					{t2, "b", int64(2), 1.0, false},
+				},
+			},
+		},
+		{
+			name: "nested path with missing keys and nulls",
+			path: "data.items",
+			input: `{"data": {"items": [
+	{"id": "x", "tags": ["a", "b"]},
+	{"id": null, "extra": {"k": 1}, "mixed": 1},
+	{"mixed": "one"}
+]}}`,
+			want: &executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "id", Type: flux.TString},
+					{Label: "tags", Type: flux.TString},
+					{Label: "extra", Type: flux.TString},
+					{Label: "mixed", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{"x", `["a", "b"]`, nil, nil},
+					{nil, nil, `{"k": 1}`, "1"},
+					{nil, nil, nil, "one"},
+				},
+			},
+		},
+		{
+			name:  "empty array",
+			input: `[]`,
+			want:  &executetest.Table{},
+		},
+		{
+			name:    "missing path",
+			path:    "data",
+			input:   `{"items": []}`,
+			wantErr: true,
+		},
+		{
+			name:    "not an array",
+			input:   `{"items": []}`,
+			wantErr: true,
+		},
+		{
+			name:    "not a record",
+			input:   `[1, 2]`,
+			wantErr: true,
+		},
+		{
+			name:  "json lines",
+			lines: true,
+			input: `{"time": "2019-06-03T13:59:01Z", "host": "a", "count": 1}
+
+{"time": "2019-06-03T14:00:00Z", "host": "b", "load": 1.5}
+`,
+			want: &executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "time", Type: flux.TTime},
+					{Label: "host", Type: flux.TString},
+					{Label: "count", Type: flux.TInt},
+					{Label: "load", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{t1, "a", int64(1), nil},
+					{t2, "b", nil, 1.5},
+				},
+			},
+		},
+		{
+			name:    "json lines with an invalid line",
+			lines:   true,
+			input:   "{\"a\": 1}\n[1]\n",
+			wantErr: true,
+		},
+		{
+			name:    "json lines with a path",
+			path:    "data",
+			lines:   true,
+			input:   `{"data": 1}`,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			dec := json.NewResultDecoder(json.ResultDecoderConfig{Path: tc.path, Lines: tc.lines})
+			res, err := dec.Decode(strings.NewReader(tc.input))
+			if err != nil {
+				if !tc.wantErr {
+					t.Fatal(err)
+				}
+				return
+			} else if tc.wantErr {
+				t.Fatal("expected error")
+			}
+			got := executetest.ConvertResult(res)
+			if got.Err != nil {
+				t.Fatal(got.Err)
+			}
+			got.Normalize()
+			want := &executetest.Result{
+				Nm:   "_result",
+				Tbls: []*executetest.Table{tc.want},
+			}
+			want.Normalize()
+			if !cmp.Equal(want, got) {
+				t.Errorf("unexpected result -want/+got\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
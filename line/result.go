@@ -18,6 +18,7 @@ import (
 // The `_time` column contains the timestamps for when each `_value` has been read.
 // Strings in `_value` are obtained from the io.Reader passed to the Decode function.
 // ResultDecoder outputs one table once the reader reaches EOF.
+// To decode InfluxDB line protocol, use the lineprotocol package instead.
 type ResultDecoder struct {
 	reader *bufio.Reader
 	config *ResultDecoderConfig
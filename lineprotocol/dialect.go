@@ -0,0 +1,32 @@
+package lineprotocol
+
+import (
+	"net/http"
+
+	"github.com/influxdata/flux"
+)
+
+const DialectType = "lp"
+
+// AddDialectMappings adds the line protocol dialect mappings.
+func AddDialectMappings(mappings flux.DialectMappings) error {
+	return mappings.Add(DialectType, func() flux.Dialect {
+		return &Dialect{}
+	})
+}
+
+// Dialect describes the output format of queries in line protocol.
+type Dialect struct{}
+
+func (d Dialect) SetHeaders(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Transfer-Encoding", "chunked")
+}
+
+func (d Dialect) Encoder() flux.MultiResultEncoder {
+	return NewMultiResultEncoder()
+}
+
+func (d Dialect) DialectType() flux.DialectType {
+	return DialectType
+}
@@ -0,0 +1,292 @@
+// Package lineprotocol decodes and encodes the InfluxDB line protocol.
+//
+// A line of line protocol is a point made of a measurement, an optional set of tags,
+// a set of fields and an optional timestamp in nanoseconds:
+//
+//	cpu,host=a usage_user=1.5,usage_system=0.5 1556813561098000000
+//
+// Decoded points produce a table for every field of every series, like influxdb.from does,
+// and the rows of tables are encoded back into points.
+package lineprotocol
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/values"
+	"github.com/pkg/errors"
+)
+
+// The labels of the columns of decoded tables.
+const (
+	MeasurementColLabel = "_measurement"
+	FieldColLabel       = "_field"
+)
+
+// Point is a single line of line protocol.
+// Field values are float64, int64, uint64, string or bool.
+type Point struct {
+	Measurement string
+	Tags        map[string]string
+	Fields      map[string]interface{}
+	Time        values.Time
+}
+
+// ParsePoints parses the points of a line protocol payload.
+// Empty lines and comments are skipped, and points without a timestamp get the time now.
+func ParsePoints(payload []byte, now values.Time) ([]Point, error) {
+	return readPoints(bytes.NewReader(payload), now)
+}
+
+func readPoints(r io.Reader, now values.Time) ([]Point, error) {
+	var points []Point
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<26)
+	for n := 1; scanner.Scan(); n++ {
+		l := strings.TrimSpace(scanner.Text())
+		if len(l) == 0 || l[0] == '#' {
+			continue
+		}
+		p, err := parsePoint(l, now)
+		if err != nil {
+			return nil, errors.Wrapf(err, "line %d", n)
+		}
+		points = append(points, p)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return points, nil
+}
+
+func parsePoint(l string, now values.Time) (Point, error) {
+	sections := splitUnescaped(l, ' ', true)
+	if len(sections) < 2 || len(sections) > 3 {
+		return Point{}, fmt.Errorf("invalid line protocol %q", l)
+	}
+
+	series := splitUnescaped(sections[0], ',', false)
+	p := Point{
+		Measurement: unescape(series[0]),
+		Tags:        make(map[string]string, len(series)-1),
+		Fields:      make(map[string]interface{}),
+		Time:        now,
+	}
+	if len(p.Measurement) == 0 {
+		return Point{}, errors.New("missing measurement")
+	}
+	for _, tag := range series[1:] {
+		kv := splitUnescaped(tag, '=', false)
+		if len(kv) != 2 {
+			return Point{}, fmt.Errorf("invalid tag %q", tag)
+		}
+		p.Tags[unescape(kv[0])] = unescape(kv[1])
+	}
+
+	for _, field := range splitUnescaped(sections[1], ',', true) {
+		kv := splitUnescaped(field, '=', true)
+		if len(kv) != 2 {
+			return Point{}, fmt.Errorf("invalid field %q", field)
+		}
+		v, err := parseFieldValue(kv[1])
+		if err != nil {
+			return Point{}, errors.Wrapf(err, "field %s", kv[0])
+		}
+		p.Fields[unescape(kv[0])] = v
+	}
+
+	if len(sections) == 3 {
+		ns, err := strconv.ParseInt(sections[2], 10, 64)
+		if err != nil {
+			return Point{}, fmt.Errorf("invalid timestamp %q", sections[2])
+		}
+		p.Time = values.Time(ns)
+	}
+	return p, nil
+}
+
+// parseFieldValue parses a string, integer, unsigned, boolean or float field value.
+func parseFieldValue(s string) (interface{}, error) {
+	if len(s) == 0 {
+		return nil, errors.New("missing value")
+	}
+	switch {
+	case s[0] == '"':
+		if len(s) < 2 || s[len(s)-1] != '"' {
+			return nil, fmt.Errorf("unterminated string %s", s)
+		}
+		return unescape(s[1 : len(s)-1]), nil
+	case s[len(s)-1] == 'i':
+		return strconv.ParseInt(s[:len(s)-1], 10, 64)
+	case s[len(s)-1] == 'u':
+		return strconv.ParseUint(s[:len(s)-1], 10, 64)
+	}
+	switch s {
+	case "t", "T", "true", "True", "TRUE":
+		return true, nil
+	case "f", "F", "false", "False", "FALSE":
+		return false, nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// splitUnescaped splits s at every sep that is not escaped with a backslash.
+// When quoted is set, separators between double quotes are kept as well.
+func splitUnescaped(s string, sep byte, quoted bool) []string {
+	var (
+		parts   []string
+		start   int
+		inQuote bool
+	)
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\':
+			i++
+		case quoted && s[i] == '"':
+			inQuote = !inQuote
+		case s[i] == sep && !inQuote:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+func unescape(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// seriesTable collects the rows of one field of one series.
+type seriesTable struct {
+	builder *execute.ColListTableBuilder
+	typ     flux.ColType
+}
+
+// SeriesTables produces a table for every field of every series of the points,
+// in the order the series first appear.
+// The group key is made of `_measurement`, the sorted tags and `_field`,
+// and the columns are `_time`, `_value` and the group key columns.
+// A field must have the same type in every point of a series.
+func SeriesTables(points []Point, alloc *memory.Allocator, f func(flux.Table) error) error {
+	tables := make(map[string]*seriesTable)
+	var order []string
+	for _, p := range points {
+		tagKeys := make([]string, 0, len(p.Tags))
+		for k := range p.Tags {
+			tagKeys = append(tagKeys, k)
+		}
+		sort.Strings(tagKeys)
+		fieldKeys := make([]string, 0, len(p.Fields))
+		for k := range p.Fields {
+			fieldKeys = append(fieldKeys, k)
+		}
+		sort.Strings(fieldKeys)
+
+		// The parts of the series identifier are separated by a null byte,
+		// which cannot be part of a measurement, tag or field.
+		var series strings.Builder
+		series.WriteString(p.Measurement)
+		for _, k := range tagKeys {
+			series.WriteString("\x00" + k + "\x00" + p.Tags[k])
+		}
+		for _, field := range fieldKeys {
+			v := p.Fields[field]
+			id := series.String() + "\x00\x00" + field
+
+			typ := fieldType(v)
+			st, ok := tables[id]
+			if !ok {
+				var err error
+				st, err = newSeriesTable(p, tagKeys, field, typ, alloc)
+				if err != nil {
+					return err
+				}
+				tables[id] = st
+				order = append(order, id)
+			} else if st.typ != typ {
+				return fmt.Errorf("field %s of measurement %s has conflicting types %v and %v", field, p.Measurement, st.typ, typ)
+			}
+			if err := st.builder.AppendTime(0, p.Time); err != nil {
+				return err
+			}
+			if err := st.builder.AppendValue(1, values.New(v)); err != nil {
+				return err
+			}
+			for j := 2; j < len(st.builder.Cols()); j++ {
+				if err := st.builder.AppendValue(j, st.builder.Key().Value(j-2)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	for _, id := range order {
+		tbl, err := tables[id].builder.Table()
+		if err != nil {
+			return err
+		}
+		if err := f(tbl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newSeriesTable(p Point, tagKeys []string, field string, typ flux.ColType, alloc *memory.Allocator) (*seriesTable, error) {
+	keyCols := make([]flux.ColMeta, 0, len(tagKeys)+2)
+	keyVals := make([]values.Value, 0, len(tagKeys)+2)
+	keyCols = append(keyCols, flux.ColMeta{Label: MeasurementColLabel, Type: flux.TString})
+	keyVals = append(keyVals, values.NewString(p.Measurement))
+	for _, k := range tagKeys {
+		keyCols = append(keyCols, flux.ColMeta{Label: k, Type: flux.TString})
+		keyVals = append(keyVals, values.NewString(p.Tags[k]))
+	}
+	keyCols = append(keyCols, flux.ColMeta{Label: FieldColLabel, Type: flux.TString})
+	keyVals = append(keyVals, values.NewString(field))
+
+	builder := execute.NewColListTableBuilder(execute.NewGroupKey(keyCols, keyVals), alloc)
+	if _, err := builder.AddCol(flux.ColMeta{Label: execute.DefaultTimeColLabel, Type: flux.TTime}); err != nil {
+		return nil, err
+	}
+	if _, err := builder.AddCol(flux.ColMeta{Label: execute.DefaultValueColLabel, Type: typ}); err != nil {
+		return nil, err
+	}
+	for _, c := range keyCols {
+		if _, err := builder.AddCol(c); err != nil {
+			return nil, err
+		}
+	}
+	return &seriesTable{builder: builder, typ: typ}, nil
+}
+
+func fieldType(v interface{}) flux.ColType {
+	switch v.(type) {
+	case float64:
+		return flux.TFloat
+	case int64:
+		return flux.TInt
+	case uint64:
+		return flux.TUInt
+	case bool:
+		return flux.TBool
+	default:
+		return flux.TString
+	}
+}
@@ -0,0 +1,383 @@
+package lineprotocol
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/iocounter"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/values"
+	protocol "github.com/influxdata/line-protocol"
+	"github.com/pkg/errors"
+)
+
+// TimeProvider gives the current time.
+type TimeProvider interface {
+	CurrentTime() values.Time
+}
+
+// ResultDecoderConfig is the configuration for a result decoder.
+type ResultDecoderConfig struct {
+	// TimeProvider gives the time of the points without a timestamp.
+	// If nil, the system time is used.
+	TimeProvider TimeProvider
+	// Allocator is the memory allocator used for the tables.
+	// If nil, a new unlimited allocator is used.
+	Allocator *memory.Allocator
+}
+
+// ResultDecoder decodes line protocol into a flux.Result.
+// Every field of every series becomes a table, see SeriesTables.
+type ResultDecoder struct {
+	config ResultDecoderConfig
+	points []Point
+}
+
+// NewResultDecoder creates a new result decoder from config.
+func NewResultDecoder(config ResultDecoderConfig) *ResultDecoder {
+	return &ResultDecoder{config: config}
+}
+
+func (rd *ResultDecoder) Decode(r io.Reader) (flux.Result, error) {
+	now := values.ConvertTime(time.Now())
+	if rd.config.TimeProvider != nil {
+		now = rd.config.TimeProvider.CurrentTime()
+	}
+	points, err := readPoints(r, now)
+	if err != nil {
+		return nil, err
+	}
+	rd.points = points
+	return rd, nil
+}
+
+func (rd *ResultDecoder) Name() string {
+	return "_result"
+}
+
+func (rd *ResultDecoder) Tables() flux.TableIterator {
+	return rd
+}
+
+func (rd *ResultDecoder) Do(f func(flux.Table) error) error {
+	alloc := rd.config.Allocator
+	if alloc == nil {
+		alloc = &memory.Allocator{}
+	}
+	return SeriesTables(rd.points, alloc, f)
+}
+
+// EncoderConfig selects the columns of a table that make up the point of every row.
+type EncoderConfig struct {
+	// TimeColumn is the time column of the timestamps.
+	TimeColumn string
+	// Measurement is the measurement of every point.
+	// If empty, the measurement is read from MeasurementColumn.
+	Measurement string
+	// MeasurementColumn is the string column of the measurements.
+	MeasurementColumn string
+	// TagColumns are the string columns that are written as tags.
+	TagColumns []string
+	// FieldColumns are the columns that are written as fields.
+	FieldColumns []string
+	// FieldKeyColumn, if set, is the string column of the key of the field.
+	// It requires a single field column, whose label is then not used.
+	FieldKeyColumn string
+}
+
+// seriesEncoderConfig is the configuration that encodes the tables produced by SeriesTables,
+// or any table that has a `_measurement` column.
+// The string columns of the group key are the tags, except for `_start` and `_stop`.
+// If the table has a `_field` and a `_value` column, they are the key and the value of the field,
+// otherwise the columns that are not in the group key are fields.
+func seriesEncoderConfig(tbl flux.Table) EncoderConfig {
+	config := EncoderConfig{
+		TimeColumn:        execute.DefaultTimeColLabel,
+		MeasurementColumn: MeasurementColLabel,
+	}
+	cols := tbl.Cols()
+	hasField := execute.ColIdx(FieldColLabel, cols) >= 0 && execute.ColIdx(execute.DefaultValueColLabel, cols) >= 0
+	if hasField {
+		config.FieldKeyColumn = FieldColLabel
+		config.FieldColumns = []string{execute.DefaultValueColLabel}
+	}
+	for _, c := range cols {
+		switch c.Label {
+		case config.TimeColumn, config.MeasurementColumn, execute.DefaultStartColLabel, execute.DefaultStopColLabel:
+			continue
+		}
+		if tbl.Key().HasCol(c.Label) {
+			if c.Type == flux.TString && c.Label != config.FieldKeyColumn {
+				config.TagColumns = append(config.TagColumns, c.Label)
+			}
+		} else if !hasField {
+			config.FieldColumns = append(config.FieldColumns, c.Label)
+		}
+	}
+	return config
+}
+
+// RowEncoder encodes the rows of tables that have the same columns as lines of line protocol.
+// Null tags and fields are left out, and rows without any field are skipped.
+// Fields are sorted by key, and time fields are written as integer nanoseconds.
+type RowEncoder struct {
+	cols        []flux.ColMeta
+	timeIdx     int
+	measurement string
+	nameIdx     int
+	fieldKeyIdx int
+	tagIdx      []int
+	fieldIdx    []int
+
+	buf bytes.Buffer
+	enc *protocol.Encoder
+	m   metric
+}
+
+// NewRowEncoder creates a new row encoder for tables with the columns cols.
+// Tag and field columns that are not in cols are ignored.
+func NewRowEncoder(cols []flux.ColMeta, config EncoderConfig) (*RowEncoder, error) {
+	e := &RowEncoder{
+		cols:        cols,
+		timeIdx:     execute.ColIdx(config.TimeColumn, cols),
+		measurement: config.Measurement,
+		nameIdx:     -1,
+		fieldKeyIdx: -1,
+	}
+	if e.timeIdx < 0 {
+		return nil, fmt.Errorf("time column %q does not exist", config.TimeColumn)
+	}
+	if cols[e.timeIdx].Type != flux.TTime {
+		return nil, fmt.Errorf("time column %q is not of type time", config.TimeColumn)
+	}
+	// The time and measurement columns have precedence over tags and fields.
+	used := map[int]bool{e.timeIdx: true}
+	if e.measurement == "" {
+		e.nameIdx = execute.ColIdx(config.MeasurementColumn, cols)
+		if e.nameIdx < 0 {
+			return nil, fmt.Errorf("measurement column %q does not exist", config.MeasurementColumn)
+		}
+		if cols[e.nameIdx].Type != flux.TString {
+			return nil, fmt.Errorf("measurement column %q is not of type string", config.MeasurementColumn)
+		}
+		used[e.nameIdx] = true
+	}
+	if config.FieldKeyColumn != "" {
+		if len(config.FieldColumns) != 1 {
+			return nil, errors.New("a field key column requires a single field column")
+		}
+		e.fieldKeyIdx = execute.ColIdx(config.FieldKeyColumn, cols)
+		if e.fieldKeyIdx < 0 {
+			return nil, fmt.Errorf("field key column %q does not exist", config.FieldKeyColumn)
+		}
+		if cols[e.fieldKeyIdx].Type != flux.TString {
+			return nil, fmt.Errorf("field key column %q is not of type string", config.FieldKeyColumn)
+		}
+		used[e.fieldKeyIdx] = true
+	}
+	for _, label := range config.TagColumns {
+		j := execute.ColIdx(label, cols)
+		if j < 0 || used[j] {
+			continue
+		}
+		if cols[j].Type != flux.TString {
+			return nil, fmt.Errorf("tag column %q is not of type string", label)
+		}
+		used[j] = true
+		e.tagIdx = append(e.tagIdx, j)
+	}
+	for _, label := range config.FieldColumns {
+		j := execute.ColIdx(label, cols)
+		if j < 0 || used[j] {
+			continue
+		}
+		switch cols[j].Type {
+		case flux.TFloat, flux.TInt, flux.TUInt, flux.TString, flux.TBool, flux.TTime:
+		default:
+			return nil, fmt.Errorf("field column %q has unsupported type %v", label, cols[j].Type)
+		}
+		used[j] = true
+		e.fieldIdx = append(e.fieldIdx, j)
+	}
+
+	e.enc = protocol.NewEncoder(&e.buf)
+	e.enc.FailOnFieldErr(true)
+	e.enc.SetFieldSortOrder(protocol.SortFields)
+	e.enc.SetFieldTypeSupport(protocol.UintSupport)
+	return e, nil
+}
+
+// AppendRow appends the line of row i of cr, including its trailing newline, to dst.
+// It returns dst unchanged if the row has no fields.
+func (e *RowEncoder) AppendRow(dst []byte, cr flux.ColReader, i int) ([]byte, error) {
+	m := &e.m
+	m.tags = m.tags[:0]
+	m.fields = m.fields[:0]
+
+	ts := cr.Times(e.timeIdx)
+	if ts.IsNull(i) {
+		return dst, errors.New("null time")
+	}
+	m.t = values.Time(ts.Value(i)).Time()
+	m.name = e.measurement
+	if e.nameIdx >= 0 {
+		vs := cr.Strings(e.nameIdx)
+		if vs.IsNull(i) {
+			return dst, errors.New("null measurement")
+		}
+		m.name = vs.ValueString(i)
+	}
+	for _, j := range e.tagIdx {
+		vs := cr.Strings(j)
+		if vs.IsValid(i) {
+			m.tags = append(m.tags, &protocol.Tag{Key: e.cols[j].Label, Value: vs.ValueString(i)})
+		}
+	}
+	for _, j := range e.fieldIdx {
+		key := e.cols[j].Label
+		if e.fieldKeyIdx >= 0 {
+			keys := cr.Strings(e.fieldKeyIdx)
+			if keys.IsNull(i) {
+				continue
+			}
+			key = keys.ValueString(i)
+		}
+		if v, ok := fieldValue(cr, i, j, e.cols[j].Type); ok {
+			m.fields = append(m.fields, &protocol.Field{Key: key, Value: v})
+		}
+	}
+	if len(m.fields) == 0 {
+		return dst, nil
+	}
+
+	e.buf.Reset()
+	if _, err := e.enc.Encode(m); err != nil {
+		return dst, err
+	}
+	return append(dst, e.buf.Bytes()...), nil
+}
+
+// fieldValue returns the value of a field, or false if it is null.
+func fieldValue(cr flux.ColReader, i, j int, typ flux.ColType) (interface{}, bool) {
+	switch typ {
+	case flux.TFloat:
+		vs := cr.Floats(j)
+		return vs.Value(i), vs.IsValid(i)
+	case flux.TInt:
+		vs := cr.Ints(j)
+		return vs.Value(i), vs.IsValid(i)
+	case flux.TUInt:
+		vs := cr.UInts(j)
+		return vs.Value(i), vs.IsValid(i)
+	case flux.TString:
+		vs := cr.Strings(j)
+		return vs.ValueString(i), vs.IsValid(i)
+	case flux.TBool:
+		vs := cr.Bools(j)
+		return vs.Value(i), vs.IsValid(i)
+	default:
+		vs := cr.Times(j)
+		return vs.Value(i), vs.IsValid(i)
+	}
+}
+
+// metric implements protocol.Metric for a row.
+type metric struct {
+	name   string
+	tags   []*protocol.Tag
+	fields []*protocol.Field
+	t      time.Time
+}
+
+func (m *metric) Name() string {
+	return m.name
+}
+
+func (m *metric) TagList() []*protocol.Tag {
+	return m.tags
+}
+
+func (m *metric) FieldList() []*protocol.Field {
+	return m.fields
+}
+
+func (m *metric) Time() time.Time {
+	return m.t
+}
+
+// ResultEncoder encodes a result as line protocol.
+// The tables must have a `_time` and a `_measurement` column, the string columns
+// of the group key are the tags, except for `_start` and `_stop`, and the
+// `_field` and `_value` columns are the fields, the way SeriesTables produces them.
+// Tables without a `_field` column have a field for every column that is not part of the group key.
+type ResultEncoder struct{}
+
+// NewResultEncoder creates a new line protocol result encoder.
+func NewResultEncoder() *ResultEncoder {
+	return &ResultEncoder{}
+}
+
+type lpEncoderError struct {
+	msg string
+}
+
+func (e *lpEncoderError) Error() string {
+	return e.msg
+}
+
+func (e *lpEncoderError) IsEncoderError() bool {
+	return true
+}
+
+func wrapEncodingError(err error) error {
+	return errors.Wrap(&lpEncoderError{msg: err.Error()}, "line protocol encoder error")
+}
+
+func (e *ResultEncoder) Encode(w io.Writer, result flux.Result) (int64, error) {
+	wc := &iocounter.Writer{Writer: w}
+	var line []byte
+	err := result.Tables().Do(func(tbl flux.Table) error {
+		enc, err := NewRowEncoder(tbl.Cols(), seriesEncoderConfig(tbl))
+		if err != nil {
+			return wrapEncodingError(err)
+		}
+		return tbl.Do(func(cr flux.ColReader) error {
+			for i := 0; i < cr.Len(); i++ {
+				line, err = enc.AppendRow(line[:0], cr, i)
+				if err != nil {
+					return wrapEncodingError(err)
+				}
+				if _, err := wc.Write(line); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+	return wc.Count(), err
+}
+
+// MultiResultEncoder encodes the tables of every result as line protocol.
+// Line protocol cannot represent errors, so errors of the results are returned.
+type MultiResultEncoder struct {
+	encoder *ResultEncoder
+}
+
+// NewMultiResultEncoder creates a new line protocol multi result encoder.
+func NewMultiResultEncoder() *MultiResultEncoder {
+	return &MultiResultEncoder{encoder: NewResultEncoder()}
+}
+
+func (e *MultiResultEncoder) Encode(w io.Writer, results flux.ResultIterator) (int64, error) {
+	wc := &iocounter.Writer{Writer: w}
+	for results.More() {
+		if _, err := e.encoder.Encode(wc, results.Next()); err != nil {
+			return wc.Count(), err
+		}
+	}
+	return wc.Count(), results.Err()
+}
@@ -0,0 +1,366 @@
+package lineprotocol_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/lineprotocol"
+	"github.com/influxdata/flux/values"
+)
+
+type fixedTimeProvider values.Time
+
+func (tp fixedTimeProvider) CurrentTime() values.Time {
+	return values.Time(tp)
+}
+
+func TestResultDecoder(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		want    []*executetest.Table
+		wantErr bool
+	}{
+		{
+			name: "series and fields",
+			input: `# comment
+cpu,host=a,region=west usage=1.5,count=3i 10
+cpu,region=west,host=a usage=2.5,count=4i 20
+
+cpu,host=b usage=0.5 10
+mem free=10u,ok=true,note="a \"b\", c"
+`,
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"_measurement", "host", "region", "_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TInt},
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+						{Label: "region", Type: flux.TString},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(10), int64(3), "cpu", "a", "west", "count"},
+						{execute.Time(20), int64(4), "cpu", "a", "west", "count"},
+					},
+				},
+				{
+					KeyCols: []string{"_measurement", "host", "region", "_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+						{Label: "region", Type: flux.TString},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(10), 1.5, "cpu", "a", "west", "usage"},
+						{execute.Time(20), 2.5, "cpu", "a", "west", "usage"},
+					},
+				},
+				{
+					KeyCols: []string{"_measurement", "host", "_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(10), 0.5, "cpu", "b", "usage"},
+					},
+				},
+				{
+					KeyCols: []string{"_measurement", "_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TUInt},
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(100), uint64(10), "mem", "free"},
+					},
+				},
+				{
+					KeyCols: []string{"_measurement", "_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TString},
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(100), `a "b", c`, "mem", "note"},
+					},
+				},
+				{
+					KeyCols: []string{"_measurement", "_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TBool},
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(100), true, "mem", "ok"},
+					},
+				},
+			},
+		},
+		{
+			name:  "escaped measurement and tags",
+			input: `my\ cpu,host\,name=a\=b value=1 5`,
+			want: []*executetest.Table{{
+				KeyCols: []string{"_measurement", "host,name", "_field"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "host,name", Type: flux.TString},
+					{Label: "_field", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(5), 1.0, "my cpu", "a=b", "value"},
+				},
+			}},
+		},
+		{
+			name:    "conflicting field types",
+			input:   "cpu value=1 1\ncpu value=1i 2\n",
+			wantErr: true,
+		},
+		{
+			name:    "missing fields",
+			input:   "cpu 1\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid timestamp",
+			input:   "cpu value=1 now\n",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			dec := lineprotocol.NewResultDecoder(lineprotocol.ResultDecoderConfig{
+				TimeProvider: fixedTimeProvider(100),
+			})
+			res, err := dec.Decode(strings.NewReader(tc.input))
+			if err == nil {
+				got := executetest.ConvertResult(res)
+				if err = got.Err; err == nil {
+					got.Normalize()
+					want := &executetest.Result{
+						Nm:   "_result",
+						Tbls: tc.want,
+					}
+					want.Normalize()
+					if !cmp.Equal(want, got) {
+						t.Errorf("unexpected result -want/+got\n%s", cmp.Diff(want, got))
+					}
+				}
+			}
+			if err != nil && !tc.wantErr {
+				t.Fatal(err)
+			} else if err == nil && tc.wantErr {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestRowEncoder(t *testing.T) {
+	testCases := []struct {
+		name    string
+		config  lineprotocol.EncoderConfig
+		table   *executetest.Table
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "measurement column and field columns",
+			config: lineprotocol.EncoderConfig{
+				TimeColumn:        "_time",
+				MeasurementColumn: "_measurement",
+				TagColumns:        []string{"host", "missing"},
+				FieldColumns:      []string{"used", "free", "ok", "since"},
+			},
+			table: &executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "host", Type: flux.TString},
+					{Label: "used", Type: flux.TFloat},
+					{Label: "free", Type: flux.TUInt},
+					{Label: "ok", Type: flux.TBool},
+					{Label: "since", Type: flux.TTime},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "mem", "a b", 1.5, uint64(3), true, execute.Time(7)},
+					{execute.Time(2), "mem", nil, nil, uint64(4), nil, nil},
+					{execute.Time(3), "mem", "a", nil, nil, nil, nil},
+				},
+			},
+			want: "mem,host=a\\ b free=3u,ok=true,since=7i,used=1.5 1\nmem free=4u 2\n",
+		},
+		{
+			name: "name and field key",
+			config: lineprotocol.EncoderConfig{
+				TimeColumn:     "_time",
+				Measurement:    "cpu",
+				TagColumns:     []string{"host"},
+				FieldColumns:   []string{"_value"},
+				FieldKeyColumn: "_field",
+			},
+			table: &executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "host", Type: flux.TString},
+					{Label: "_field", Type: flux.TString},
+					{Label: "_value", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "a", "note", `say "hi"`},
+				},
+			},
+			want: "cpu,host=a note=\"say \\\"hi\\\"\" 1\n",
+		},
+		{
+			name: "missing time column",
+			config: lineprotocol.EncoderConfig{
+				TimeColumn:   "time",
+				Measurement:  "cpu",
+				FieldColumns: []string{"_value"},
+			},
+			table: &executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "tag column that is not a string",
+			config: lineprotocol.EncoderConfig{
+				TimeColumn:   "_time",
+				Measurement:  "cpu",
+				TagColumns:   []string{"host"},
+				FieldColumns: []string{"_value"},
+			},
+			table: &executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "host", Type: flux.TInt},
+					{Label: "_value", Type: flux.TFloat},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			enc, err := lineprotocol.NewRowEncoder(tc.table.Cols(), tc.config)
+			if err != nil {
+				if !tc.wantErr {
+					t.Fatal(err)
+				}
+				return
+			} else if tc.wantErr {
+				t.Fatal("expected error")
+			}
+			var got []byte
+			if err := tc.table.Do(func(cr flux.ColReader) error {
+				for i := 0; i < cr.Len(); i++ {
+					if got, err = enc.AppendRow(got, cr, i); err != nil {
+						return err
+					}
+				}
+				return nil
+			}); err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("unexpected line protocol -want/+got\n%s", cmp.Diff(tc.want, string(got)))
+			}
+		})
+	}
+}
+
+func TestMultiResultEncoder(t *testing.T) {
+	results := flux.NewSliceResultIterator([]flux.Result{
+		executetest.NewResult([]*executetest.Table{
+			{
+				KeyCols: []string{"_start", "_stop", "_measurement", "host", "_field"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_stop", Type: flux.TTime},
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "host", Type: flux.TString},
+					{Label: "_field", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), execute.Time(100), execute.Time(10), 1.5, "cpu", "a", "usage"},
+					{execute.Time(0), execute.Time(100), execute.Time(20), 2.5, "cpu", "a", "usage"},
+				},
+			},
+		}),
+		executetest.NewResult([]*executetest.Table{
+			{
+				KeyCols: []string{"_measurement", "host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "host", Type: flux.TString},
+					{Label: "free", Type: flux.TInt},
+					{Label: "used", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(10), "mem", "a", int64(3), int64(5)},
+				},
+			},
+		}),
+	})
+	var buf bytes.Buffer
+	n, err := lineprotocol.NewMultiResultEncoder().Encode(&buf, results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "cpu,host=a usage=1.5 10\ncpu,host=a usage=2.5 20\nmem,host=a free=3i,used=5i 10\n"
+	if got := buf.String(); got != want {
+		t.Errorf("unexpected line protocol -want/+got\n%s", cmp.Diff(want, got))
+	}
+	if n != int64(buf.Len()) {
+		t.Errorf("unexpected byte count: want %d, got %d", buf.Len(), n)
+	}
+
+	// The encoded lines decode into the same series.
+	res, err := lineprotocol.NewResultDecoder(lineprotocol.ResultDecoderConfig{}).Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := executetest.ConvertResult(res)
+	if got.Err != nil {
+		t.Fatal(got.Err)
+	}
+	if len(got.Tbls) != 3 {
+		t.Fatalf("expected 3 tables, got %d", len(got.Tbls))
+	}
+	if want, got := []interface{}{execute.Time(20), 2.5, "cpu", "a", "usage"}, got.Tbls[0].Data[1]; !cmp.Equal(want, got) {
+		t.Errorf("unexpected row -want/+got\n%s", cmp.Diff(want, got))
+	}
+}
@@ -0,0 +1,275 @@
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+
+	"github.com/golang/snappy"
+)
+
+// The physical types of Parquet.
+const (
+	typeBoolean           = 0
+	typeInt32             = 1
+	typeInt64             = 2
+	typeInt96             = 3
+	typeFloat             = 4
+	typeDouble            = 5
+	typeByteArray         = 6
+	typeFixedLenByteArray = 7
+)
+
+// The converted types of Parquet, which older writers use instead of logical types.
+const (
+	convertedUTF8            = 0
+	convertedDate            = 6
+	convertedTimestampMillis = 9
+	convertedTimestampMicros = 10
+	convertedUint8           = 11
+	convertedUint16          = 12
+	convertedUint32          = 13
+	convertedUint64          = 14
+	convertedInt64           = 18
+)
+
+// The fields of the LogicalType union and of the TimeUnit union.
+const (
+	logicalString    = 1
+	logicalDate      = 6
+	logicalTimestamp = 8
+	logicalInteger   = 10
+
+	unitMillis = 1
+	unitMicros = 2
+	unitNanos  = 3
+)
+
+const (
+	repetitionRequired = 0
+	repetitionOptional = 1
+)
+
+// The encodings of pages.
+const (
+	encodingPlain           = 0
+	encodingPlainDictionary = 2
+	encodingRLE             = 3
+	encodingRLEDictionary   = 8
+)
+
+// The compression codecs of pages.
+const (
+	codecUncompressed = 0
+	codecSnappy       = 1
+	codecGzip         = 2
+)
+
+// The types of pages.
+const (
+	pageData       = 0
+	pageDictionary = 2
+	pageDataV2     = 3
+)
+
+// magic starts and ends every Parquet file.
+const magic = "PAR1"
+
+func decompress(codec int64, data []byte, size int) ([]byte, error) {
+	switch codec {
+	case codecUncompressed:
+		return data, nil
+	case codecSnappy:
+		return snappy.Decode(make([]byte, 0, size), data)
+	case codecGzip:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return ioutil.ReadAll(r)
+	default:
+		return nil, fmt.Errorf("unsupported compression codec %d", codec)
+	}
+}
+
+// appendRLERun appends a run of n repetitions of a value of the
+// RLE/bit-packed hybrid encoding with a bit width of 1.
+func appendRLERun(dst []byte, n int, v byte) []byte {
+	var b [binary.MaxVarintLen64]byte
+	l := binary.PutUvarint(b[:], uint64(n)<<1)
+	return append(append(dst, b[:l]...), v)
+}
+
+// appendLevels appends the definition levels of n values whose validity is given by valid,
+// with the RLE/bit-packed hybrid encoding and a bit width of 1.
+func appendLevels(dst []byte, n, nulls int, valid func(i int) bool) []byte {
+	switch nulls {
+	case 0:
+		return appendRLERun(dst, n, 1)
+	case n:
+		return appendRLERun(dst, n, 0)
+	}
+	groups := (n + 7) / 8
+	var b [binary.MaxVarintLen64]byte
+	l := binary.PutUvarint(b[:], uint64(groups)<<1|1)
+	dst = append(dst, b[:l]...)
+	start := len(dst)
+	dst = append(dst, make([]byte, groups)...)
+	for i := 0; i < n; i++ {
+		if valid(i) {
+			dst[start+i/8] |= 1 << uint(i%8)
+		}
+	}
+	return dst
+}
+
+var errTruncatedPage = errors.New("truncated page")
+
+// decodeHybrid decodes n values of the RLE/bit-packed hybrid encoding.
+func decodeHybrid(data []byte, bitWidth, n int) ([]int, error) {
+	if bitWidth < 0 || bitWidth > 32 {
+		return nil, fmt.Errorf("invalid bit width %d", bitWidth)
+	}
+	out := make([]int, 0, n)
+	for len(out) < n {
+		h, l := binary.Uvarint(data)
+		if l <= 0 {
+			return nil, errTruncatedPage
+		}
+		data = data[l:]
+		if h&1 == 0 {
+			// A run of the same value.
+			count := int(h >> 1)
+			width := (bitWidth + 7) / 8
+			if len(data) < width {
+				return nil, errTruncatedPage
+			}
+			v := 0
+			for i := 0; i < width; i++ {
+				v |= int(data[i]) << uint(8*i)
+			}
+			data = data[width:]
+			for i := 0; i < count && len(out) < n; i++ {
+				out = append(out, v)
+			}
+			continue
+		}
+		// Groups of 8 bit-packed values, least significant bit first.
+		count := int(h>>1) * 8
+		size := int(h>>1) * bitWidth
+		if len(data) < size {
+			return nil, errTruncatedPage
+		}
+		for i := 0; i < count && len(out) < n; i++ {
+			v := 0
+			for b := 0; b < bitWidth; b++ {
+				bit := i*bitWidth + b
+				if data[bit/8]&(1<<uint(bit%8)) != 0 {
+					v |= 1 << uint(b)
+				}
+			}
+			out = append(out, v)
+		}
+		data = data[size:]
+	}
+	return out, nil
+}
+
+// pageValues holds the decoded values of a page or of a dictionary.
+// Only the slice of the physical type of the column is set.
+type pageValues struct {
+	bools  []bool
+	ints   []int64
+	floats []float64
+	bytes  [][]byte
+}
+
+func (v *pageValues) len() int {
+	return len(v.bools) + len(v.ints) + len(v.floats) + len(v.bytes)
+}
+
+// plainWidth returns the size of the plain encoded values of a fixed width physical type
+// and zero for the other types.
+func plainWidth(physical int64) int {
+	switch physical {
+	case typeInt32, typeFloat:
+		return 4
+	case typeInt64, typeDouble:
+		return 8
+	case typeInt96:
+		return 12
+	default:
+		return 0
+	}
+}
+
+// decodePlain decodes n values of the plain encoding.
+// INT96 values are Julian dates with the nanoseconds of the day and are decoded as Unix nanoseconds.
+func decodePlain(physical int64, data []byte, n int) (*pageValues, error) {
+	v := new(pageValues)
+	if width := plainWidth(physical); width > 0 && len(data) < n*width {
+		return nil, errTruncatedPage
+	}
+	switch physical {
+	case typeBoolean:
+		if len(data) < (n+7)/8 {
+			return nil, errTruncatedPage
+		}
+		v.bools = make([]bool, n)
+		for i := range v.bools {
+			v.bools[i] = data[i/8]&(1<<uint(i%8)) != 0
+		}
+	case typeInt32:
+		v.ints = make([]int64, n)
+		for i := range v.ints {
+			v.ints[i] = int64(int32(binary.LittleEndian.Uint32(data[4*i:])))
+		}
+	case typeInt64:
+		v.ints = make([]int64, n)
+		for i := range v.ints {
+			v.ints[i] = int64(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	case typeInt96:
+		const (
+			unixJulianDay = 2440588
+			nsPerDay      = 24 * 60 * 60 * 1e9
+		)
+		v.ints = make([]int64, n)
+		for i := range v.ints {
+			ns := int64(binary.LittleEndian.Uint64(data[12*i:]))
+			day := int64(binary.LittleEndian.Uint32(data[12*i+8:]))
+			v.ints[i] = (day-unixJulianDay)*nsPerDay + ns
+		}
+	case typeFloat:
+		v.floats = make([]float64, n)
+		for i := range v.floats {
+			v.floats[i] = float64(math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:])))
+		}
+	case typeDouble:
+		v.floats = make([]float64, n)
+		for i := range v.floats {
+			v.floats[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	case typeByteArray:
+		v.bytes = make([][]byte, n)
+		for i := range v.bytes {
+			if len(data) < 4 {
+				return nil, errTruncatedPage
+			}
+			l := binary.LittleEndian.Uint32(data)
+			if uint64(len(data)-4) < uint64(l) {
+				return nil, errTruncatedPage
+			}
+			v.bytes[i] = data[4 : 4+l]
+			data = data[4+l:]
+		}
+	default:
+		return nil, fmt.Errorf("unsupported physical type %d", physical)
+	}
+	return v, nil
+}
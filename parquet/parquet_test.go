@@ -0,0 +1,174 @@
+package parquet_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/parquet"
+)
+
+func TestWriter_RoundTrip(t *testing.T) {
+	tables := []*executetest.Table{
+		{
+			KeyCols: []string{"host"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "host", Type: flux.TString},
+				{Label: "float", Type: flux.TFloat},
+				{Label: "int", Type: flux.TInt},
+				{Label: "uint", Type: flux.TUInt},
+				{Label: "bool", Type: flux.TBool},
+			},
+			Data: [][]interface{}{
+				{execute.Time(1556813561098000001), "a", 1.5, int64(-2), uint64(3), true},
+				{nil, "a", nil, nil, nil, nil},
+				{execute.Time(30), "a", -0.5, int64(7), uint64(1 << 63), false},
+				{execute.Time(40), "a", 2.0, int64(9), uint64(0), true},
+				{execute.Time(50), "a", 2.5, int64(1), uint64(1), true},
+				{execute.Time(60), "a", 3.0, int64(2), uint64(2), false},
+				{execute.Time(70), "a", 3.5, int64(3), uint64(3), true},
+				{execute.Time(80), "a", 4.0, int64(4), uint64(4), nil},
+				{execute.Time(90), "a", 4.5, int64(5), uint64(5), true},
+			},
+		},
+		{
+			KeyCols: []string{"host"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "host", Type: flux.TString},
+				{Label: "region", Type: flux.TString},
+			},
+			Data: [][]interface{}{
+				{execute.Time(100), "b", "west"},
+				{execute.Time(110), "b", nil},
+			},
+		},
+		{
+			KeyCols: []string{"host"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "host", Type: flux.TString},
+			},
+			KeyValues: []interface{}{"c"},
+		},
+	}
+	var buf bytes.Buffer
+	w := parquet.NewWriter(&buf)
+	for _, tbl := range tables {
+		if err := w.WriteTable(tbl); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := parquet.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cols := []flux.ColMeta{
+		{Label: "_time", Type: flux.TTime},
+		{Label: "host", Type: flux.TString},
+		{Label: "float", Type: flux.TFloat},
+		{Label: "int", Type: flux.TInt},
+		{Label: "uint", Type: flux.TUInt},
+		{Label: "bool", Type: flux.TBool},
+		{Label: "region", Type: flux.TString},
+	}
+	if !cmp.Equal(cols, r.Cols()) {
+		t.Fatalf("unexpected columns: -want/+got\n%s", cmp.Diff(cols, r.Cols()))
+	}
+	if got, want := r.NumRowGroups(), 2; got != want {
+		t.Fatalf("unexpected number of row groups: want %d, got %d", want, got)
+	}
+
+	want := []*executetest.Table{
+		{
+			ColMeta: []flux.ColMeta{cols[6], cols[2], cols[5]},
+			Data: [][]interface{}{
+				{nil, 1.5, true},
+				{nil, nil, nil},
+				{nil, -0.5, false},
+				{nil, 2.0, true},
+				{nil, 2.5, true},
+				{nil, 3.0, false},
+				{nil, 3.5, true},
+				{nil, 4.0, nil},
+				{nil, 4.5, true},
+			},
+		},
+		{
+			ColMeta: []flux.ColMeta{cols[6], cols[2], cols[5]},
+			Data: [][]interface{}{
+				{"west", nil, nil},
+				{nil, nil, nil},
+			},
+		},
+	}
+	for i := range want {
+		tbl, err := r.ReadRowGroup(i, []int{6, 2, 5}, &memory.Allocator{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := executetest.ConvertTable(tbl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !cmp.Equal(want[i].ColMeta, got.ColMeta) || !cmp.Equal(want[i].Data, got.Data) {
+			t.Errorf("unexpected row group %d: -want/+got\n%s", i, cmp.Diff(want[i].Data, got.Data))
+		}
+	}
+
+	tbl, err := r.ReadRowGroup(0, []int{0, 1, 3, 4}, &memory.Allocator{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := executetest.ConvertTable(tbl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, row := range got.Data {
+		wantRow := tables[0].Data[i]
+		for j, k := range []int{0, 1, 3, 4} {
+			if row[j] != wantRow[k] {
+				t.Errorf("unexpected value at row %d of column %s: want %v, got %v", i, cols[k].Label, wantRow[k], row[j])
+			}
+		}
+	}
+}
+
+func TestWriter_ConflictingTypes(t *testing.T) {
+	w := parquet.NewWriter(new(bytes.Buffer))
+	if err := w.WriteTable(&executetest.Table{
+		ColMeta: []flux.ColMeta{{Label: "_value", Type: flux.TFloat}},
+		Data:    [][]interface{}{{1.0}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	err := w.WriteTable(&executetest.Table{
+		ColMeta: []flux.ColMeta{{Label: "_value", Type: flux.TInt}},
+		Data:    [][]interface{}{{int64(1)}},
+	})
+	if err == nil {
+		t.Fatal("expected error writing a column with another type")
+	}
+}
+
+func TestNewReader_InvalidFile(t *testing.T) {
+	for _, data := range []string{
+		"",
+		"PAR1PAR1",
+		"PAR1\x00\x00\x00\x00\x00\x00\x00\x00PAR2",
+		"PAR1\x19\x0c\xff\xff\x01\x00\x00\x00PAR1",
+	} {
+		if _, err := parquet.NewReader(bytes.NewReader([]byte(data)), int64(len(data))); err == nil {
+			t.Errorf("expected error reading %q", data)
+		}
+	}
+}
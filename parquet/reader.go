@@ -0,0 +1,427 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/values"
+)
+
+// Reader reads the row groups of a Parquet file as flux tables.
+//
+// Only files with a flat schema are supported. The columns are read as:
+//
+//	BOOLEAN                                  bool
+//	INT32, INT64                             int, or uint when they are unsigned integers
+//	INT32 (DATE), INT64 (TIMESTAMP), INT96   time
+//	FLOAT, DOUBLE                            float
+//	BYTE_ARRAY                               string
+//
+// The columns of the other types, like FIXED_LEN_BYTE_ARRAY, have an invalid type
+// and cannot be read.
+type Reader struct {
+	r         io.ReaderAt
+	size      int64
+	cols      []column
+	rowGroups []thriftValues
+}
+
+// column is a column of the schema of a file.
+type column struct {
+	meta     flux.ColMeta
+	physical int64
+	required bool
+	// scale converts the values of time columns to nanoseconds.
+	scale int64
+}
+
+// NewReader reads the metadata of a Parquet file of the given size.
+func NewReader(r io.ReaderAt, size int64) (*Reader, error) {
+	if size < int64(2*len(magic)+4) {
+		return nil, errors.New("file is too small to be a parquet file")
+	}
+	var footer [8]byte
+	if _, err := r.ReadAt(footer[:], size-8); err != nil {
+		return nil, err
+	}
+	if string(footer[4:]) != magic {
+		return nil, errors.New("file is not a parquet file")
+	}
+	length := int64(binary.LittleEndian.Uint32(footer[:4]))
+	if length > size-int64(2*len(magic)+4) {
+		return nil, errors.New("invalid parquet metadata length")
+	}
+	buf := make([]byte, length)
+	if _, err := r.ReadAt(buf, size-8-length); err != nil {
+		return nil, err
+	}
+	metadata, _, err := decodeStruct(buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode parquet metadata: %v", err)
+	}
+
+	rd := &Reader{r: r, size: size}
+	schema := metadata.list(2)
+	if len(schema) == 0 {
+		return nil, errors.New("parquet metadata has no schema")
+	}
+	for _, e := range schema[1:] {
+		elem, _ := e.(thriftValues)
+		c, err := newColumn(elem)
+		if err != nil {
+			return nil, err
+		}
+		rd.cols = append(rd.cols, c)
+	}
+	for _, rg := range metadata.list(4) {
+		rg, _ := rg.(thriftValues)
+		if len(rg.list(1)) != len(rd.cols) {
+			return nil, errors.New("row group does not have a column chunk for every column")
+		}
+		rd.rowGroups = append(rd.rowGroups, rg)
+	}
+	return rd, nil
+}
+
+// newColumn reads the column of a schema element.
+func newColumn(elem thriftValues) (column, error) {
+	name := elem.string(4)
+	if _, ok := elem.int(5); ok {
+		return column{}, fmt.Errorf("column %s is nested, which is not supported", name)
+	}
+	repetition, _ := elem.int(3)
+	if repetition != repetitionRequired && repetition != repetitionOptional {
+		return column{}, fmt.Errorf("column %s is repeated, which is not supported", name)
+	}
+	physical, _ := elem.int(1)
+	c := column{
+		meta:     flux.ColMeta{Label: name, Type: flux.TInvalid},
+		physical: physical,
+		required: repetition == repetitionRequired,
+	}
+	converted, hasConverted := elem.int(6)
+	logical := elem.structField(10)
+	unsigned := logical.structField(logicalInteger) != nil && !logical.structField(logicalInteger).bool(2)
+	if hasConverted && converted >= convertedUint8 && converted <= convertedUint64 {
+		unsigned = true
+	}
+	switch physical {
+	case typeBoolean:
+		c.meta.Type = flux.TBool
+	case typeFloat, typeDouble:
+		c.meta.Type = flux.TFloat
+	case typeByteArray:
+		c.meta.Type = flux.TString
+	case typeInt96:
+		c.meta.Type, c.scale = flux.TTime, 1
+	case typeInt32:
+		switch {
+		case logical.structField(logicalDate) != nil || hasConverted && converted == convertedDate:
+			c.meta.Type, c.scale = flux.TTime, 24*60*60*1e9
+		case unsigned:
+			c.meta.Type = flux.TUInt
+		default:
+			c.meta.Type = flux.TInt
+		}
+	case typeInt64:
+		var unit int16
+		if ts := logical.structField(logicalTimestamp); ts != nil {
+			for id := range ts.structField(2) {
+				unit = id
+			}
+		} else if hasConverted && converted == convertedTimestampMillis {
+			unit = unitMillis
+		} else if hasConverted && converted == convertedTimestampMicros {
+			unit = unitMicros
+		}
+		switch {
+		case unit == unitMillis:
+			c.meta.Type, c.scale = flux.TTime, 1e6
+		case unit == unitMicros:
+			c.meta.Type, c.scale = flux.TTime, 1e3
+		case unit == unitNanos:
+			c.meta.Type, c.scale = flux.TTime, 1
+		case unsigned:
+			c.meta.Type = flux.TUInt
+		default:
+			c.meta.Type = flux.TInt
+		}
+	}
+	return c, nil
+}
+
+// Cols returns the columns of the file.
+// The columns that cannot be read have the invalid type.
+func (r *Reader) Cols() []flux.ColMeta {
+	cols := make([]flux.ColMeta, len(r.cols))
+	for j, c := range r.cols {
+		cols[j] = c.meta
+	}
+	return cols
+}
+
+// NumRowGroups returns the number of row groups of the file.
+func (r *Reader) NumRowGroups() int {
+	return len(r.rowGroups)
+}
+
+// ReadRowGroup reads the given columns of a row group as a table with an empty group key.
+func (r *Reader) ReadRowGroup(i int, cols []int, alloc *memory.Allocator) (flux.Table, error) {
+	rg := r.rowGroups[i]
+	numRows, _ := rg.int(3)
+	chunks := rg.list(1)
+	b := execute.NewColListTableBuilder(execute.NewGroupKey(nil, nil), alloc)
+	// The columns are added before any values since the builder
+	// fills new columns with nulls for the existing rows.
+	for _, j := range cols {
+		c := r.cols[j]
+		if c.meta.Type == flux.TInvalid {
+			return nil, fmt.Errorf("column %s has an unsupported type", c.meta.Label)
+		}
+		if _, err := b.AddCol(c.meta); err != nil {
+			return nil, err
+		}
+	}
+	for k, j := range cols {
+		c := r.cols[j]
+		chunk, _ := chunks[j].(thriftValues)
+		valid, vs, err := r.readColumnChunk(c, chunk.structField(3))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read column %s: %v", c.meta.Label, err)
+		}
+		if int64(len(valid)) != numRows {
+			return nil, fmt.Errorf("column %s has %d values, expected %d", c.meta.Label, len(valid), numRows)
+		}
+		if err := appendColumn(b, k, c, valid, vs); err != nil {
+			return nil, err
+		}
+	}
+	return b.Table()
+}
+
+// readColumnChunk reads the pages of a column chunk and returns the validity
+// of every value along with the values that are not null.
+func (r *Reader) readColumnChunk(c column, meta thriftValues) ([]bool, *pageValues, error) {
+	if meta == nil {
+		return nil, nil, errors.New("column chunk has no metadata")
+	}
+	codec, _ := meta.int(4)
+	numValues, _ := meta.int(5)
+	size, _ := meta.int(7)
+	start, _ := meta.int(9)
+	if dict, ok := meta.int(11); ok && dict > 0 && dict < start {
+		start = dict
+	}
+	if start < 0 || size < 0 || start+size > r.size {
+		return nil, nil, errors.New("column chunk is out of the bounds of the file")
+	}
+	buf := make([]byte, size)
+	if _, err := r.r.ReadAt(buf, start); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		dict  *pageValues
+		valid []bool
+		vs    = new(pageValues)
+	)
+	for int64(len(valid)) < numValues && len(buf) > 0 {
+		header, n, err := decodeStruct(buf)
+		if err != nil {
+			return nil, nil, err
+		}
+		compressedSize, _ := header.int(3)
+		if compressedSize < 0 || compressedSize > int64(len(buf)-n) {
+			return nil, nil, errTruncatedPage
+		}
+		page := buf[n : n+int(compressedSize)]
+		buf = buf[n+int(compressedSize):]
+		uncompressedSize, _ := header.int(2)
+
+		var (
+			h      thriftValues
+			levels []byte
+			// encodingField is the field of the page header with the encoding of the values.
+			encodingField int16
+		)
+		typ, _ := header.int(1)
+		switch typ {
+		case pageDictionary:
+			h = header.structField(7)
+			data, err := decompress(codec, page, int(uncompressedSize))
+			if err != nil {
+				return nil, nil, err
+			}
+			n, _ := h.int(1)
+			if dict, err = decodePlain(c.physical, data, int(n)); err != nil {
+				return nil, nil, err
+			}
+			continue
+		case pageData:
+			h = header.structField(5)
+			if page, err = decompress(codec, page, int(uncompressedSize)); err != nil {
+				return nil, nil, err
+			}
+			encodingField = 2
+		case pageDataV2:
+			h = header.structField(8)
+			defLength, _ := h.int(5)
+			repLength, _ := h.int(6)
+			if defLength < 0 || repLength < 0 || defLength+repLength > int64(len(page)) {
+				return nil, nil, errTruncatedPage
+			}
+			levels = page[repLength : repLength+defLength]
+			page = page[repLength+defLength:]
+			encodingField = 4
+			if compressed, ok := h[7].(bool); !ok || compressed {
+				if page, err = decompress(codec, page, int(uncompressedSize-defLength-repLength)); err != nil {
+					return nil, nil, err
+				}
+			}
+		default:
+			continue
+		}
+		if h == nil {
+			return nil, nil, errors.New("page has no header")
+		}
+
+		n64, _ := h.int(1)
+		n = int(n64)
+		if n < 0 || int64(len(valid)+n) > numValues {
+			return nil, nil, errors.New("invalid number of values in page")
+		}
+		pageValid := make([]bool, n)
+		nonNull := n
+		if c.required {
+			for i := range pageValid {
+				pageValid[i] = true
+			}
+		} else {
+			// The levels of data pages v1 are prefixed by their length.
+			if typ == pageData {
+				if len(page) < 4 || uint64(binary.LittleEndian.Uint32(page)) > uint64(len(page)-4) {
+					return nil, nil, errTruncatedPage
+				}
+				l := binary.LittleEndian.Uint32(page)
+				levels, page = page[4:4+l], page[4+l:]
+			}
+			defs, err := decodeHybrid(levels, 1, n)
+			if err != nil {
+				return nil, nil, err
+			}
+			nonNull = 0
+			for i, d := range defs {
+				if pageValid[i] = d == 1; pageValid[i] {
+					nonNull++
+				}
+			}
+		}
+		valid = append(valid, pageValid...)
+
+		encoding, _ := h.int(encodingField)
+		pvs, err := decodeValues(c.physical, encoding, page, nonNull, dict)
+		if err != nil {
+			return nil, nil, err
+		}
+		vs.append(pvs)
+	}
+	if int64(len(valid)) != numValues {
+		return nil, nil, errors.New("column chunk has fewer values than expected")
+	}
+	return valid, vs, nil
+}
+
+// decodeValues decodes n values of a data page.
+func decodeValues(physical, encoding int64, data []byte, n int, dict *pageValues) (*pageValues, error) {
+	switch encoding {
+	case encodingPlain:
+		return decodePlain(physical, data, n)
+	case encodingPlainDictionary, encodingRLEDictionary:
+		if dict == nil {
+			return nil, errors.New("dictionary encoded page without a dictionary")
+		}
+		if n == 0 {
+			return new(pageValues), nil
+		}
+		if len(data) == 0 {
+			return nil, errTruncatedPage
+		}
+		indices, err := decodeHybrid(data[1:], int(data[0]), n)
+		if err != nil {
+			return nil, err
+		}
+		return dict.lookup(indices)
+	default:
+		return nil, fmt.Errorf("unsupported encoding %d", encoding)
+	}
+}
+
+// lookup returns the values of a dictionary at the given indices.
+func (v *pageValues) lookup(indices []int) (*pageValues, error) {
+	out := new(pageValues)
+	for _, i := range indices {
+		if i >= v.len() {
+			return nil, fmt.Errorf("dictionary index %d is out of range", i)
+		}
+		switch {
+		case v.bools != nil:
+			out.bools = append(out.bools, v.bools[i])
+		case v.ints != nil:
+			out.ints = append(out.ints, v.ints[i])
+		case v.floats != nil:
+			out.floats = append(out.floats, v.floats[i])
+		case v.bytes != nil:
+			out.bytes = append(out.bytes, v.bytes[i])
+		}
+	}
+	return out, nil
+}
+
+func (v *pageValues) append(o *pageValues) {
+	v.bools = append(v.bools, o.bools...)
+	v.ints = append(v.ints, o.ints...)
+	v.floats = append(v.floats, o.floats...)
+	v.bytes = append(v.bytes, o.bytes...)
+}
+
+// appendColumn appends the values of a column chunk to column j of the builder.
+func appendColumn(b *execute.ColListTableBuilder, j int, c column, valid []bool, vs *pageValues) error {
+	var k int
+	for _, ok := range valid {
+		if !ok {
+			if err := b.AppendNil(j); err != nil {
+				return err
+			}
+			continue
+		}
+		var err error
+		switch c.meta.Type {
+		case flux.TBool:
+			err = b.AppendBool(j, vs.bools[k])
+		case flux.TFloat:
+			err = b.AppendFloat(j, vs.floats[k])
+		case flux.TString:
+			err = b.AppendString(j, string(vs.bytes[k]))
+		case flux.TInt:
+			err = b.AppendInt(j, vs.ints[k])
+		case flux.TUInt:
+			v := uint64(vs.ints[k])
+			if c.physical == typeInt32 {
+				v = uint64(uint32(vs.ints[k]))
+			}
+			err = b.AppendUInt(j, v)
+		case flux.TTime:
+			err = b.AppendTime(j, values.Time(vs.ints[k]*c.scale))
+		}
+		if err != nil {
+			return err
+		}
+		k++
+	}
+	return nil
+}
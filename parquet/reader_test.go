@@ -0,0 +1,149 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/memory"
+)
+
+// TestReader_Encodings reads pages that the writer does not write:
+// a dictionary encoded data page v2 and a data page v1 of a required column.
+func TestReader_Encodings(t *testing.T) {
+	file := []byte(magic)
+
+	// A dictionary of strings and the indices 1, 0, 1 with a bit width of 1.
+	dictOffset := int64(len(file))
+	dict := []byte("\x03\x00\x00\x00foo\x03\x00\x00\x00bar")
+	file = append(file, encodeStruct(func(e *thriftEncoder) {
+		e.fieldI32(1, pageDictionary)
+		e.fieldI32(2, int32(len(dict)))
+		e.fieldI32(3, int32(len(dict)))
+		e.fieldStruct(7, func() {
+			e.fieldI32(1, 2)
+			e.fieldI32(2, encodingPlainDictionary)
+		})
+	})...)
+	file = append(file, dict...)
+	dataOffset := int64(len(file))
+	indices := []byte{1, 1<<1 | 1, 0x05}
+	file = append(file, encodeStruct(func(e *thriftEncoder) {
+		e.fieldI32(1, pageDataV2)
+		e.fieldI32(2, int32(len(indices)))
+		e.fieldI32(3, int32(len(indices)))
+		e.fieldStruct(8, func() {
+			e.fieldI32(1, 3)
+			e.fieldI32(2, 0)
+			e.fieldI32(3, 3)
+			e.fieldI32(4, encodingRLEDictionary)
+			e.fieldI32(5, 0)
+			e.fieldI32(6, 0)
+			e.fieldBool(7, false)
+		})
+	})...)
+	file = append(file, indices...)
+	stringsSize := int64(len(file)) - dictOffset
+
+	// Dates as days since the epoch.
+	datesOffset := int64(len(file))
+	var dates []byte
+	for _, d := range []uint32{0, 1, 2} {
+		var b [4]byte
+		binary.LittleEndian.PutUint32(b[:], d)
+		dates = append(dates, b[:]...)
+	}
+	file = append(file, encodeStruct(func(e *thriftEncoder) {
+		e.fieldI32(1, pageData)
+		e.fieldI32(2, int32(len(dates)))
+		e.fieldI32(3, int32(len(dates)))
+		e.fieldStruct(5, func() {
+			e.fieldI32(1, 3)
+			e.fieldI32(2, encodingPlain)
+			e.fieldI32(3, encodingRLE)
+			e.fieldI32(4, encodingRLE)
+		})
+	})...)
+	file = append(file, dates...)
+	datesSize := int64(len(file)) - datesOffset
+
+	metadata := encodeStruct(func(e *thriftEncoder) {
+		e.fieldI32(1, 1)
+		e.fieldList(2, thriftStruct, 3, func(i int) {
+			e.elemStruct(func() {
+				switch i {
+				case 0:
+					e.fieldString(4, "schema")
+					e.fieldI32(5, 2)
+				case 1:
+					e.fieldI32(1, typeByteArray)
+					e.fieldI32(3, repetitionRequired)
+					e.fieldString(4, "name")
+				case 2:
+					e.fieldI32(1, typeInt32)
+					e.fieldI32(3, repetitionRequired)
+					e.fieldString(4, "day")
+					e.fieldI32(6, convertedDate)
+				}
+			})
+		})
+		e.fieldI64(3, 3)
+		e.fieldList(4, thriftStruct, 1, func(int) {
+			e.elemStruct(func() {
+				e.fieldList(1, thriftStruct, 2, func(j int) {
+					e.elemStruct(func() {
+						e.fieldStruct(3, func() {
+							e.fieldI32(4, codecUncompressed)
+							e.fieldI64(5, 3)
+							if j == 0 {
+								e.fieldI64(7, stringsSize)
+								e.fieldI64(9, dataOffset)
+								e.fieldI64(11, dictOffset)
+							} else {
+								e.fieldI64(7, datesSize)
+								e.fieldI64(9, datesOffset)
+							}
+						})
+					})
+				})
+				e.fieldI64(3, 3)
+			})
+		})
+	})
+	file = append(file, metadata...)
+	var length [4]byte
+	binary.LittleEndian.PutUint32(length[:], uint32(len(metadata)))
+	file = append(append(file, length[:]...), magic...)
+
+	r, err := NewReader(bytes.NewReader(file), int64(len(file)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tbl, err := r.ReadRowGroup(0, []int{0, 1}, &memory.Allocator{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := executetest.ConvertTable(tbl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const day = 24 * 60 * 60 * 1e9
+	want := &executetest.Table{
+		ColMeta: []flux.ColMeta{
+			{Label: "name", Type: flux.TString},
+			{Label: "day", Type: flux.TTime},
+		},
+		Data: [][]interface{}{
+			{"bar", execute.Time(0)},
+			{"foo", execute.Time(day)},
+			{"bar", execute.Time(2 * day)},
+		},
+	}
+	if !cmp.Equal(want.ColMeta, got.ColMeta) || !cmp.Equal(want.Data, got.Data) {
+		t.Errorf("unexpected table: -want/+got\n%s", cmp.Diff(want.Data, got.Data))
+	}
+}
@@ -0,0 +1,324 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// The types of the fields and elements of the Thrift compact protocol.
+const (
+	thriftBoolTrue  = 1
+	thriftBoolFalse = 2
+	thriftByte      = 3
+	thriftI16       = 4
+	thriftI32       = 5
+	thriftI64       = 6
+	thriftDouble    = 7
+	thriftBinary    = 8
+	thriftList      = 9
+	thriftSet       = 10
+	thriftMap       = 11
+	thriftStruct    = 12
+)
+
+// maxThriftDepth limits the nesting of the structs and containers that are decoded.
+const maxThriftDepth = 32
+
+// thriftEncoder writes structs with the Thrift compact protocol, which is used
+// for the metadata and the page headers of Parquet files.
+type thriftEncoder struct {
+	buf []byte
+	// lastField holds the id of the last field of every struct that is being written.
+	lastField []int16
+}
+
+// encodeStruct encodes the struct whose fields are written by f.
+func encodeStruct(f func(e *thriftEncoder)) []byte {
+	e := new(thriftEncoder)
+	e.beginStruct()
+	f(e)
+	e.endStruct()
+	return e.buf
+}
+
+func (e *thriftEncoder) beginStruct() {
+	e.lastField = append(e.lastField, 0)
+}
+
+func (e *thriftEncoder) endStruct() {
+	e.buf = append(e.buf, 0)
+	e.lastField = e.lastField[:len(e.lastField)-1]
+}
+
+func (e *thriftEncoder) fieldHeader(id int16, typ byte) {
+	last := &e.lastField[len(e.lastField)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		e.buf = append(e.buf, byte(delta)<<4|typ)
+	} else {
+		e.buf = append(e.buf, typ)
+		e.varint(int64(id))
+	}
+	*last = id
+}
+
+func (e *thriftEncoder) uvarint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	e.buf = append(e.buf, b[:n]...)
+}
+
+// varint writes a zigzag encoded integer.
+func (e *thriftEncoder) varint(v int64) {
+	e.uvarint(uint64(v<<1) ^ uint64(v>>63))
+}
+
+func (e *thriftEncoder) fieldBool(id int16, v bool) {
+	if v {
+		e.fieldHeader(id, thriftBoolTrue)
+	} else {
+		e.fieldHeader(id, thriftBoolFalse)
+	}
+}
+
+func (e *thriftEncoder) fieldI8(id int16, v int8) {
+	e.fieldHeader(id, thriftByte)
+	e.buf = append(e.buf, byte(v))
+}
+
+func (e *thriftEncoder) fieldI32(id int16, v int32) {
+	e.fieldHeader(id, thriftI32)
+	e.varint(int64(v))
+}
+
+func (e *thriftEncoder) fieldI64(id int16, v int64) {
+	e.fieldHeader(id, thriftI64)
+	e.varint(v)
+}
+
+func (e *thriftEncoder) fieldString(id int16, v string) {
+	e.fieldHeader(id, thriftBinary)
+	e.elemString(v)
+}
+
+// fieldStruct writes a struct field whose fields are written by f.
+func (e *thriftEncoder) fieldStruct(id int16, f func()) {
+	e.fieldHeader(id, thriftStruct)
+	e.elemStruct(f)
+}
+
+// fieldList writes a list field with n elements of the given type, which are written by f.
+func (e *thriftEncoder) fieldList(id int16, elemType byte, n int, f func(i int)) {
+	e.fieldHeader(id, thriftList)
+	if n < 15 {
+		e.buf = append(e.buf, byte(n)<<4|elemType)
+	} else {
+		e.buf = append(e.buf, 0xF0|elemType)
+		e.uvarint(uint64(n))
+	}
+	for i := 0; i < n; i++ {
+		f(i)
+	}
+}
+
+func (e *thriftEncoder) elemI32(v int32) {
+	e.varint(int64(v))
+}
+
+func (e *thriftEncoder) elemString(v string) {
+	e.uvarint(uint64(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+func (e *thriftEncoder) elemStruct(f func()) {
+	e.beginStruct()
+	f()
+	e.endStruct()
+}
+
+// thriftValues is a decoded struct, with the values of its fields by id.
+// Integers of every size are int64, binary values are []byte,
+// lists and sets are []interface{} and maps are skipped.
+type thriftValues map[int16]interface{}
+
+func (s thriftValues) int(id int16) (int64, bool) {
+	v, ok := s[id].(int64)
+	return v, ok
+}
+
+func (s thriftValues) bool(id int16) bool {
+	v, _ := s[id].(bool)
+	return v
+}
+
+func (s thriftValues) string(id int16) string {
+	v, _ := s[id].([]byte)
+	return string(v)
+}
+
+func (s thriftValues) structField(id int16) thriftValues {
+	v, _ := s[id].(thriftValues)
+	return v
+}
+
+func (s thriftValues) list(id int16) []interface{} {
+	v, _ := s[id].([]interface{})
+	return v
+}
+
+// thriftDecoder reads structs of the Thrift compact protocol.
+type thriftDecoder struct {
+	buf []byte
+	pos int
+}
+
+var errThriftTruncated = errors.New("truncated thrift data")
+
+// decodeStruct decodes a struct at the start of buf and returns the number of bytes it takes.
+func decodeStruct(buf []byte) (thriftValues, int, error) {
+	d := &thriftDecoder{buf: buf}
+	s, err := d.readStruct(0)
+	if err != nil {
+		return nil, 0, err
+	}
+	return s, d.pos, nil
+}
+
+func (d *thriftDecoder) readByte() (byte, error) {
+	if d.pos >= len(d.buf) {
+		return 0, errThriftTruncated
+	}
+	b := d.buf[d.pos]
+	d.pos++
+	return b, nil
+}
+
+func (d *thriftDecoder) uvarint() (uint64, error) {
+	v, n := binary.Uvarint(d.buf[d.pos:])
+	if n <= 0 {
+		return 0, errThriftTruncated
+	}
+	d.pos += n
+	return v, nil
+}
+
+func (d *thriftDecoder) varint() (int64, error) {
+	u, err := d.uvarint()
+	return int64(u>>1) ^ -int64(u&1), err
+}
+
+func (d *thriftDecoder) readStruct(depth int) (thriftValues, error) {
+	if depth > maxThriftDepth {
+		return nil, errors.New("thrift data is nested too deeply")
+	}
+	s := make(thriftValues)
+	var last int16
+	for {
+		h, err := d.readByte()
+		if err != nil {
+			return nil, err
+		}
+		if h == 0 {
+			return s, nil
+		}
+		typ := h & 0x0F
+		id := last + int16(h>>4)
+		if h>>4 == 0 {
+			v, err := d.varint()
+			if err != nil {
+				return nil, err
+			}
+			id = int16(v)
+		}
+		last = id
+		switch typ {
+		case thriftBoolTrue, thriftBoolFalse:
+			s[id] = typ == thriftBoolTrue
+		default:
+			v, err := d.readValue(typ, depth)
+			if err != nil {
+				return nil, err
+			}
+			if v != nil {
+				s[id] = v
+			}
+		}
+	}
+}
+
+func (d *thriftDecoder) readValue(typ byte, depth int) (interface{}, error) {
+	switch typ {
+	case thriftBoolTrue, thriftBoolFalse:
+		// Booleans of containers are a byte each.
+		b, err := d.readByte()
+		return b == thriftBoolTrue, err
+	case thriftByte:
+		b, err := d.readByte()
+		return int64(int8(b)), err
+	case thriftI16, thriftI32, thriftI64:
+		return d.varint()
+	case thriftDouble:
+		if len(d.buf)-d.pos < 8 {
+			return nil, errThriftTruncated
+		}
+		v := math.Float64frombits(binary.LittleEndian.Uint64(d.buf[d.pos:]))
+		d.pos += 8
+		return v, nil
+	case thriftBinary:
+		n, err := d.uvarint()
+		if err != nil {
+			return nil, err
+		}
+		if uint64(len(d.buf)-d.pos) < n {
+			return nil, errThriftTruncated
+		}
+		v := d.buf[d.pos : d.pos+int(n)]
+		d.pos += int(n)
+		return v, nil
+	case thriftList, thriftSet:
+		h, err := d.readByte()
+		if err != nil {
+			return nil, err
+		}
+		n := uint64(h >> 4)
+		if n == 15 {
+			if n, err = d.uvarint(); err != nil {
+				return nil, err
+			}
+		}
+		// Every element takes at least a byte.
+		if uint64(len(d.buf)-d.pos) < n {
+			return nil, errThriftTruncated
+		}
+		elems := make([]interface{}, n)
+		for i := range elems {
+			if elems[i], err = d.readValue(h&0x0F, depth+1); err != nil {
+				return nil, err
+			}
+		}
+		return elems, nil
+	case thriftMap:
+		n, err := d.uvarint()
+		if err != nil || n == 0 {
+			return nil, err
+		}
+		types, err := d.readByte()
+		if err != nil {
+			return nil, err
+		}
+		for i := uint64(0); i < n; i++ {
+			if _, err := d.readValue(types>>4, depth+1); err != nil {
+				return nil, err
+			}
+			if _, err := d.readValue(types&0x0F, depth+1); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	case thriftStruct:
+		return d.readStruct(depth + 1)
+	default:
+		return nil, fmt.Errorf("invalid thrift type %d", typ)
+	}
+}
@@ -0,0 +1,357 @@
+// Package parquet reads and writes flux tables as Apache Parquet files.
+//
+// Only the parts of the format that flux tables need are implemented: flat schemas,
+// plain and dictionary encoded pages and the snappy and gzip compression codecs.
+package parquet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/golang/snappy"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/iocounter"
+)
+
+// createdBy is written to the metadata of the files.
+const createdBy = "flux"
+
+// Writer writes flux tables to a Parquet file.
+//
+// Every buffer of a table is a row group. The columns of the file are the columns
+// of all tables in the order they first appear, and the row groups of tables without
+// one of the columns have a null value for it in every row.
+// A column must have the same type in every table.
+//
+// All columns are optional and their pages are snappy compressed and plain encoded.
+type Writer struct {
+	w         *iocounter.Writer
+	cols      []flux.ColMeta
+	rowGroups []*rowGroup
+	started   bool
+}
+
+// rowGroup is the metadata of a row group that has been written.
+type rowGroup struct {
+	numRows int64
+	chunks  map[string]*columnChunk
+}
+
+// columnChunk is the metadata of the pages of a column in a row group.
+type columnChunk struct {
+	offset           int64
+	numValues        int64
+	uncompressedSize int64
+	compressedSize   int64
+}
+
+// NewWriter creates a writer of a Parquet file to w.
+// The file is complete once Close is called.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: &iocounter.Writer{Writer: w}}
+}
+
+func (w *Writer) start() error {
+	if w.started {
+		return nil
+	}
+	w.started = true
+	_, err := w.w.Write([]byte(magic))
+	return err
+}
+
+// WriteTable writes the rows of a table.
+func (w *Writer) WriteTable(tbl flux.Table) error {
+	for _, c := range tbl.Cols() {
+		if err := w.addCol(c); err != nil {
+			return err
+		}
+	}
+	return tbl.Do(w.Write)
+}
+
+// Write writes the rows of a buffer of a table as a row group.
+func (w *Writer) Write(cr flux.ColReader) error {
+	for _, c := range cr.Cols() {
+		if err := w.addCol(c); err != nil {
+			return err
+		}
+	}
+	if err := w.start(); err != nil {
+		return err
+	}
+	n := cr.Len()
+	if n == 0 {
+		return nil
+	}
+	rg := &rowGroup{numRows: int64(n), chunks: make(map[string]*columnChunk, len(cr.Cols()))}
+	for j, c := range cr.Cols() {
+		levels, data := encodeColumn(cr, j)
+		chunk, err := w.writePage(n, levels, data)
+		if err != nil {
+			return err
+		}
+		rg.chunks[c.Label] = chunk
+	}
+	w.rowGroups = append(w.rowGroups, rg)
+	return nil
+}
+
+func (w *Writer) addCol(c flux.ColMeta) error {
+	for _, col := range w.cols {
+		if col.Label == c.Label {
+			if col.Type != c.Type {
+				return fmt.Errorf("column %s has conflicting types %v and %v", c.Label, col.Type, c.Type)
+			}
+			return nil
+		}
+	}
+	if _, ok := physicalType(c.Type); !ok {
+		return fmt.Errorf("column %s has unsupported type %v", c.Label, c.Type)
+	}
+	w.cols = append(w.cols, c)
+	return nil
+}
+
+// writePage writes a column chunk with a single data page.
+func (w *Writer) writePage(n int, levels, data []byte) (*columnChunk, error) {
+	page := make([]byte, 4, 4+len(levels)+len(data))
+	binary.LittleEndian.PutUint32(page, uint32(len(levels)))
+	page = append(append(page, levels...), data...)
+	compressed := snappy.Encode(nil, page)
+
+	header := encodeStruct(func(e *thriftEncoder) {
+		e.fieldI32(1, pageData)
+		e.fieldI32(2, int32(len(page)))
+		e.fieldI32(3, int32(len(compressed)))
+		e.fieldStruct(5, func() {
+			e.fieldI32(1, int32(n))
+			e.fieldI32(2, encodingPlain)
+			e.fieldI32(3, encodingRLE)
+			e.fieldI32(4, encodingRLE)
+		})
+	})
+	chunk := &columnChunk{
+		offset:           int64(w.w.Count()),
+		numValues:        int64(n),
+		uncompressedSize: int64(len(header) + len(page)),
+		compressedSize:   int64(len(header) + len(compressed)),
+	}
+	if _, err := w.w.Write(header); err != nil {
+		return nil, err
+	}
+	if _, err := w.w.Write(compressed); err != nil {
+		return nil, err
+	}
+	return chunk, nil
+}
+
+// Close writes the null values of the columns that row groups are missing
+// and the metadata of the file. It does not close the underlying writer.
+func (w *Writer) Close() error {
+	if err := w.start(); err != nil {
+		return err
+	}
+	for _, rg := range w.rowGroups {
+		for _, c := range w.cols {
+			if _, ok := rg.chunks[c.Label]; ok {
+				continue
+			}
+			n := int(rg.numRows)
+			chunk, err := w.writePage(n, appendRLERun(nil, n, 0), nil)
+			if err != nil {
+				return err
+			}
+			rg.chunks[c.Label] = chunk
+		}
+	}
+
+	metadata := w.encodeMetadata()
+	var size [4]byte
+	binary.LittleEndian.PutUint32(size[:], uint32(len(metadata)))
+	for _, b := range [][]byte{metadata, size[:], []byte(magic)} {
+		if _, err := w.w.Write(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *Writer) encodeMetadata() []byte {
+	var numRows int64
+	for _, rg := range w.rowGroups {
+		numRows += rg.numRows
+	}
+	return encodeStruct(func(e *thriftEncoder) {
+		e.fieldI32(1, 1)
+		e.fieldList(2, thriftStruct, len(w.cols)+1, func(i int) {
+			e.elemStruct(func() {
+				if i == 0 {
+					e.fieldString(4, "schema")
+					e.fieldI32(5, int32(len(w.cols)))
+					return
+				}
+				encodeSchemaElement(e, w.cols[i-1])
+			})
+		})
+		e.fieldI64(3, numRows)
+		e.fieldList(4, thriftStruct, len(w.rowGroups), func(i int) {
+			rg := w.rowGroups[i]
+			e.elemStruct(func() {
+				var size int64
+				e.fieldList(1, thriftStruct, len(w.cols), func(j int) {
+					c, chunk := w.cols[j], rg.chunks[w.cols[j].Label]
+					size += chunk.uncompressedSize
+					typ, _ := physicalType(c.Type)
+					e.elemStruct(func() {
+						e.fieldI64(2, chunk.offset)
+						e.fieldStruct(3, func() {
+							e.fieldI32(1, typ)
+							e.fieldList(2, thriftI32, 2, func(i int) {
+								e.elemI32([]int32{encodingPlain, encodingRLE}[i])
+							})
+							e.fieldList(3, thriftBinary, 1, func(int) {
+								e.elemString(c.Label)
+							})
+							e.fieldI32(4, codecSnappy)
+							e.fieldI64(5, chunk.numValues)
+							e.fieldI64(6, chunk.uncompressedSize)
+							e.fieldI64(7, chunk.compressedSize)
+							e.fieldI64(9, chunk.offset)
+						})
+					})
+				})
+				e.fieldI64(2, size)
+				e.fieldI64(3, rg.numRows)
+			})
+		})
+		e.fieldString(6, createdBy)
+	})
+}
+
+// physicalType returns the physical type of a column type.
+func physicalType(typ flux.ColType) (int32, bool) {
+	switch typ {
+	case flux.TFloat:
+		return typeDouble, true
+	case flux.TInt, flux.TUInt, flux.TTime:
+		return typeInt64, true
+	case flux.TString:
+		return typeByteArray, true
+	case flux.TBool:
+		return typeBoolean, true
+	default:
+		return 0, false
+	}
+}
+
+// encodeSchemaElement encodes the schema element of a column, with the converted type
+// and the logical type of the column type. Times are timestamps in nanoseconds,
+// which have no converted type.
+func encodeSchemaElement(e *thriftEncoder, c flux.ColMeta) {
+	typ, _ := physicalType(c.Type)
+	e.fieldI32(1, typ)
+	e.fieldI32(3, repetitionOptional)
+	e.fieldString(4, c.Label)
+	switch c.Type {
+	case flux.TInt, flux.TUInt:
+		converted := int32(convertedInt64)
+		if c.Type == flux.TUInt {
+			converted = convertedUint64
+		}
+		e.fieldI32(6, converted)
+		e.fieldStruct(10, func() {
+			e.fieldStruct(logicalInteger, func() {
+				e.fieldI8(1, 64)
+				e.fieldBool(2, c.Type == flux.TInt)
+			})
+		})
+	case flux.TString:
+		e.fieldI32(6, convertedUTF8)
+		e.fieldStruct(10, func() {
+			e.fieldStruct(logicalString, func() {})
+		})
+	case flux.TTime:
+		e.fieldStruct(10, func() {
+			e.fieldStruct(logicalTimestamp, func() {
+				e.fieldBool(1, true)
+				e.fieldStruct(2, func() {
+					e.fieldStruct(unitNanos, func() {})
+				})
+			})
+		})
+	}
+}
+
+// encodeColumn encodes the definition levels and the plain encoded values of a column.
+func encodeColumn(cr flux.ColReader, j int) (levels, data []byte) {
+	n := cr.Len()
+	switch c := cr.Cols()[j]; c.Type {
+	case flux.TFloat:
+		vs := cr.Floats(j)
+		for i := 0; i < n; i++ {
+			if vs.IsValid(i) {
+				data = appendUint64(data, math.Float64bits(vs.Value(i)))
+			}
+		}
+		return appendLevels(nil, n, vs.NullN(), vs.IsValid), data
+	case flux.TInt, flux.TTime:
+		var vs *array.Int64
+		if c.Type == flux.TTime {
+			vs = cr.Times(j)
+		} else {
+			vs = cr.Ints(j)
+		}
+		for i := 0; i < n; i++ {
+			if vs.IsValid(i) {
+				data = appendUint64(data, uint64(vs.Value(i)))
+			}
+		}
+		return appendLevels(nil, n, vs.NullN(), vs.IsValid), data
+	case flux.TUInt:
+		vs := cr.UInts(j)
+		for i := 0; i < n; i++ {
+			if vs.IsValid(i) {
+				data = appendUint64(data, vs.Value(i))
+			}
+		}
+		return appendLevels(nil, n, vs.NullN(), vs.IsValid), data
+	case flux.TString:
+		vs := cr.Strings(j)
+		for i := 0; i < n; i++ {
+			if vs.IsValid(i) {
+				v := vs.Value(i)
+				var l [4]byte
+				binary.LittleEndian.PutUint32(l[:], uint32(len(v)))
+				data = append(append(data, l[:]...), v...)
+			}
+		}
+		return appendLevels(nil, n, vs.NullN(), vs.IsValid), data
+	case flux.TBool:
+		vs := cr.Bools(j)
+		var count int
+		for i := 0; i < n; i++ {
+			if !vs.IsValid(i) {
+				continue
+			}
+			if count%8 == 0 {
+				data = append(data, 0)
+			}
+			if vs.Value(i) {
+				data[count/8] |= 1 << uint(count%8)
+			}
+			count++
+		}
+		return appendLevels(nil, n, vs.NullN(), vs.IsValid), data
+	}
+	return nil, nil
+}
+
+func appendUint64(dst []byte, v uint64) []byte {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	return append(dst, b[:]...)
+}
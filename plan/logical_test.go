@@ -45,6 +45,7 @@ func TestPlan_LogicalPlanFromSpec(t *testing.T) {
 			Timeout:      time.Second,
 			TimeColumn:   "_time",
 			ValueColumns: []string{"_value"},
+			Format:       "lp",
 		}
 		toKafkaOpSpec = kafka.ToKafkaOpSpec{
 			Brokers:      []string{"broker"},
@@ -91,6 +92,7 @@ func TestPlan_LogicalPlanFromSpec(t *testing.T) {
 		joinSpec = &universe.MergeJoinProcedureSpec{
 			TableNames: []string{"a", "b"},
 			On:         []string{"_time"},
+			Method:     "inner",
 		}
 		toHTTPSpec = &http.ToHTTPProcedureSpec{
 			Spec: &toHTTPOpSpec,
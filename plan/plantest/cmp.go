@@ -19,8 +19,10 @@ var CmpOptions = append(
 	semantictest.CmpOptions,
 	cmp.AllowUnexported(flux.Spec{}),
 	cmp.AllowUnexported(universe.JoinOpSpec{}),
+	cmp.AllowUnexported(universe.JoinAsOfOpSpec{}),
 	cmpopts.IgnoreUnexported(flux.Spec{}),
 	cmpopts.IgnoreUnexported(universe.JoinOpSpec{}),
+	cmpopts.IgnoreUnexported(universe.JoinAsOfOpSpec{}),
 	cmp.AllowUnexported(kafka.ToKafkaProcedureSpec{}),
 	cmpopts.IgnoreUnexported(kafka.ToKafkaProcedureSpec{}),
 )
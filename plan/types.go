@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/values"
 )
 
 type Planner interface {
@@ -329,7 +330,10 @@ func ReplaceNode(oldNode, newNode Node) {
 }
 
 type WindowSpec struct {
-	Every  flux.Duration
-	Period flux.Duration
-	Offset flux.Duration
+	Every  values.CalendarDuration
+	Period values.CalendarDuration
+	Offset values.CalendarDuration
+	// Location is the name of the time zone whose wall clock the windows are aligned to.
+	// An empty location is UTC.
+	Location string
 }
@@ -27,8 +27,10 @@ var opts = append(
 	semantictest.CmpOptions,
 	cmp.AllowUnexported(flux.Spec{}),
 	cmp.AllowUnexported(universe.JoinOpSpec{}),
+	cmp.AllowUnexported(universe.JoinAsOfOpSpec{}),
 	cmpopts.IgnoreUnexported(flux.Spec{}),
 	cmpopts.IgnoreUnexported(universe.JoinOpSpec{}),
+	cmpopts.IgnoreUnexported(universe.JoinAsOfOpSpec{}),
 )
 
 func NewQueryTestHelper(t *testing.T, tc NewQueryTestCase) {
@@ -17,7 +17,9 @@ func OperationMarshalingTestHelper(t *testing.T, data []byte, expOp *flux.Operat
 	opts := append(
 		semantictest.CmpOptions,
 		cmp.AllowUnexported(universe.JoinOpSpec{}),
+		cmp.AllowUnexported(universe.JoinAsOfOpSpec{}),
 		cmpopts.IgnoreUnexported(universe.JoinOpSpec{}),
+		cmpopts.IgnoreUnexported(universe.JoinAsOfOpSpec{}),
 	)
 
 	// Ensure we can properly unmarshal a spec
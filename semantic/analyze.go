@@ -2,7 +2,6 @@ package semantic
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/influxdata/flux/ast"
 	"github.com/influxdata/flux/codes"
@@ -629,13 +628,14 @@ func analyzeDateTimeLiteral(lit *ast.DateTimeLiteral) (*DateTimeLiteral, error)
 	}, nil
 }
 func analyzeDurationLiteral(lit *ast.DurationLiteral) (*DurationLiteral, error) {
-	duration, err := ast.DurationFrom(lit, time.Time{})
+	months, duration, err := ast.CalendarDurationFrom(lit)
 	if err != nil {
 		return nil, err
 	}
 	return &DurationLiteral{
-		loc:   loc(lit.Location()),
-		Value: duration,
+		loc:    loc(lit.Location()),
+		Months: months,
+		Value:  duration,
 	}, nil
 }
 func analyzeFloatLiteral(lit *ast.FloatLiteral) (*FloatLiteral, error) {
@@ -894,6 +894,10 @@ func (l *DateTimeLiteral) Copy() Node {
 type DurationLiteral struct {
 	loc `json:"-"`
 
+	// Months is the number of calendar months of the duration,
+	// whose length depends on the time the duration is added to.
+	Months int64 `json:"months,omitempty"`
+	// Value is the fixed part of the duration.
 	Value time.Duration `json:"value"`
 }
 
@@ -0,0 +1,212 @@
+// Package aggregate computes aggregates in two steps, so that they can be combined across
+// shards, windows or rollups. The partial function computes the partial state of an aggregate,
+// and the merge function combines partial states into another partial state, or into the final value.
+//
+// The partial state is stored in ordinary columns, so it can be written to any output and read back.
+// The mean, stddev and skew partial states merge exactly,
+// while the quantile partial state is a t-digest whose merge is approximate.
+package aggregate
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/internal/sketch"
+	"github.com/influxdata/tdigest"
+)
+
+// The aggregates that have a partial state.
+const (
+	// MethodMean is the sum and count of the values.
+	MethodMean = "mean"
+	// MethodStddev is the count, mean and sum of squared differences from the mean of the values.
+	MethodStddev = "stddev"
+	// MethodSkew is the stddev state and the sum of cubed differences from the mean of the values.
+	MethodSkew = "skew"
+	// MethodQuantile is the list of centroids of a t-digest of the values.
+	MethodQuantile = "quantile"
+)
+
+// The modes of the standard deviation.
+const (
+	ModeSample     = "sample"
+	ModePopulation = "population"
+)
+
+// DefaultCompression is the compression of the t-digests of the quantile aggregate.
+const DefaultCompression = 1000.0
+
+// The labels of the columns of the partial states.
+const (
+	CountLabel    = "_count"
+	SumLabel      = "_sum"
+	MeanLabel     = "_mean"
+	M2Label       = "_m2"
+	M3Label       = "_m3"
+	CentroidLabel = "_centroid"
+	WeightLabel   = "_weight"
+)
+
+// PartialColumns returns the columns of the partial state of an aggregate.
+func PartialColumns(method string) ([]flux.ColMeta, error) {
+	switch method {
+	case MethodMean:
+		return []flux.ColMeta{
+			{Label: CountLabel, Type: flux.TInt},
+			{Label: SumLabel, Type: flux.TFloat},
+		}, nil
+	case MethodStddev:
+		return []flux.ColMeta{
+			{Label: CountLabel, Type: flux.TInt},
+			{Label: MeanLabel, Type: flux.TFloat},
+			{Label: M2Label, Type: flux.TFloat},
+		}, nil
+	case MethodSkew:
+		return []flux.ColMeta{
+			{Label: CountLabel, Type: flux.TInt},
+			{Label: MeanLabel, Type: flux.TFloat},
+			{Label: M2Label, Type: flux.TFloat},
+			{Label: M3Label, Type: flux.TFloat},
+		}, nil
+	case MethodQuantile:
+		return []flux.ColMeta{
+			{Label: CentroidLabel, Type: flux.TFloat},
+			{Label: WeightLabel, Type: flux.TFloat},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown aggregate method %q, must be one of %s, %s, %s or %s", method, MethodMean, MethodStddev, MethodSkew, MethodQuantile)
+	}
+}
+
+// state is the partial state of an aggregate.
+// The values of a row of the partial state are in the order of the partial columns.
+type state interface {
+	// add adds a value to aggregate.
+	add(v float64)
+	// merge merges a row of another partial state.
+	merge(row []float64)
+	// rows returns the rows of the partial state.
+	rows() [][]float64
+}
+
+func newState(method string, compression float64) state {
+	switch method {
+	case MethodQuantile:
+		return &quantileState{digest: sketch.NewTDigest(compression)}
+	default:
+		return &momentState{method: method}
+	}
+}
+
+// momentState holds the count, sum and central moments of the values,
+// it is updated with the online algorithms of Welford and Pébay.
+type momentState struct {
+	method       string
+	n, sum       float64
+	mean, m2, m3 float64
+}
+
+func (s *momentState) add(v float64) {
+	s.combine(momentState{n: 1, sum: v, mean: v})
+}
+
+func (s *momentState) merge(row []float64) {
+	var b momentState
+	switch s.method {
+	case MethodMean:
+		b.n, b.sum = row[0], row[1]
+	case MethodStddev:
+		b.n, b.mean, b.m2 = row[0], row[1], row[2]
+	case MethodSkew:
+		b.n, b.mean, b.m2, b.m3 = row[0], row[1], row[2], row[3]
+	}
+	s.combine(b)
+}
+
+// combine adds the moments of other values.
+func (s *momentState) combine(b momentState) {
+	if b.n == 0 {
+		return
+	}
+	na, nb := s.n, b.n
+	n := na + nb
+	delta := b.mean - s.mean
+	s.m3 += b.m3 + delta*delta*delta*na*nb*(na-nb)/(n*n) + 3*delta*(na*b.m2-nb*s.m2)/n
+	s.m2 += b.m2 + delta*delta*na*nb/n
+	s.mean += delta * nb / n
+	s.sum += b.sum
+	s.n = n
+}
+
+func (s *momentState) rows() [][]float64 {
+	switch s.method {
+	case MethodMean:
+		return [][]float64{{s.n, s.sum}}
+	case MethodStddev:
+		return [][]float64{{s.n, s.mean, s.m2}}
+	default:
+		return [][]float64{{s.n, s.mean, s.m2, s.m3}}
+	}
+}
+
+// value computes the final value of the aggregate, it returns false if it is null.
+// It gives the same results as the aggregate functions of the universe package.
+func (s *momentState) value(mode string) (float64, bool) {
+	if s.n == 0 {
+		return 0, false
+	}
+	switch s.method {
+	case MethodMean:
+		return s.sum / s.n, true
+	case MethodStddev:
+		n := s.n
+		if mode == ModeSample {
+			n--
+		}
+		if n < 1 {
+			return math.NaN(), true
+		}
+		return math.Sqrt(s.m2 / n), true
+	default:
+		if s.n < 2 {
+			return math.NaN(), true
+		}
+		return math.Sqrt(s.n) * s.m3 / math.Pow(s.m2, 1.5), true
+	}
+}
+
+// quantileState is a t-digest of the values.
+type quantileState struct {
+	digest *sketch.TDigest
+}
+
+func (s *quantileState) add(v float64) {
+	s.digest.Add(v, 1)
+}
+
+func (s *quantileState) merge(row []float64) {
+	s.digest.Add(row[0], row[1])
+}
+
+func (s *quantileState) rows() [][]float64 {
+	centroids := s.digest.Centroids()
+	rows := make([][]float64, len(centroids))
+	for i, c := range centroids {
+		rows[i] = []float64{c.Mean, c.Weight}
+	}
+	return rows
+}
+
+// value estimates the quantile q of the values, it returns false if it is null.
+func (s *quantileState) value(q, compression float64) (float64, bool) {
+	centroids := s.digest.Centroids()
+	if len(centroids) == 0 {
+		return 0, false
+	}
+	digest := tdigest.NewWithCompression(compression)
+	for _, c := range centroids {
+		digest.Add(c.Mean, c.Weight)
+	}
+	return digest.Quantile(q), true
+}
@@ -0,0 +1,107 @@
+package aggregate
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/influxdata/flux/arrow"
+	"github.com/influxdata/flux/stdlib/universe"
+	"github.com/influxdata/tdigest"
+)
+
+func randomValues(n int) []float64 {
+	r := rand.New(rand.NewSource(7))
+	vs := make([]float64, n)
+	for i := range vs {
+		vs[i] = r.ExpFloat64()*10 + 3
+	}
+	return vs
+}
+
+// mergeParts computes the partial state of every part of the values, and merges them.
+func mergeParts(method string, vs []float64, parts int) state {
+	merged := newState(method, DefaultCompression)
+	size := len(vs) / parts
+	for p := 0; p < parts; p++ {
+		part := newState(method, DefaultCompression)
+		stop := (p + 1) * size
+		if p == parts-1 {
+			stop = len(vs)
+		}
+		for _, v := range vs[p*size : stop] {
+			part.add(v)
+		}
+		for _, row := range part.rows() {
+			merged.merge(row)
+		}
+	}
+	return merged
+}
+
+func TestMomentState_Merge(t *testing.T) {
+	vs := randomValues(1000)
+	data := arrow.NewFloat(vs, nil)
+	defer data.Release()
+
+	mean := new(universe.MeanAgg).NewFloatAgg()
+	mean.DoFloat(data)
+	stddev := (&universe.StddevAgg{Mode: ModeSample}).NewFloatAgg()
+	stddev.DoFloat(data)
+	population := (&universe.StddevAgg{Mode: ModePopulation}).NewFloatAgg()
+	population.DoFloat(data)
+	skew := new(universe.SkewAgg).NewFloatAgg()
+	skew.DoFloat(data)
+
+	testCases := []struct {
+		method string
+		mode   string
+		want   float64
+	}{
+		{method: MethodMean, want: mean.(*universe.MeanAgg).ValueFloat()},
+		{method: MethodStddev, mode: ModeSample, want: stddev.(*universe.StddevAgg).ValueFloat()},
+		{method: MethodStddev, mode: ModePopulation, want: population.(*universe.StddevAgg).ValueFloat()},
+		{method: MethodSkew, want: skew.(*universe.SkewAgg).ValueFloat()},
+	}
+	for _, tc := range testCases {
+		for _, parts := range []int{1, 3, 10} {
+			got, ok := mergeParts(tc.method, vs, parts).(*momentState).value(tc.mode)
+			if !ok {
+				t.Fatalf("%s: unexpected null value", tc.method)
+			}
+			if math.Abs(got-tc.want) > 1e-9*math.Abs(tc.want) {
+				t.Errorf("%s %s with %d parts: got %v want %v", tc.method, tc.mode, parts, got, tc.want)
+			}
+		}
+	}
+}
+
+func TestMomentState_Empty(t *testing.T) {
+	for _, method := range []string{MethodMean, MethodStddev, MethodSkew} {
+		st := newState(method, DefaultCompression)
+		st.merge(st.rows()[0])
+		if _, ok := st.(*momentState).value(ModeSample); ok {
+			t.Errorf("%s: expected a null value without values", method)
+		}
+	}
+}
+
+func TestQuantileState_Merge(t *testing.T) {
+	vs := randomValues(10000)
+	digest := tdigest.NewWithCompression(DefaultCompression)
+	for _, v := range vs {
+		digest.Add(v, 1)
+	}
+	for _, q := range []float64{0.01, 0.5, 0.9, 0.99} {
+		want := digest.Quantile(q)
+		for _, parts := range []int{1, 4, 20} {
+			got, ok := mergeParts(MethodQuantile, vs, parts).(*quantileState).value(q, DefaultCompression)
+			if !ok {
+				t.Fatal("unexpected null quantile")
+			}
+			if math.Abs(got-want) > 0.01*want {
+				t.Errorf("quantile %v with %d parts: got %v want %v", q, parts, got, want)
+			}
+		}
+	}
+}
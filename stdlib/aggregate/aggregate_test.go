@@ -0,0 +1,364 @@
+package aggregate_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/influxdata/flux"
+	_ "github.com/influxdata/flux/builtin" // We need to import the builtins for the tests to work.
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/aggregate"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
+)
+
+func TestAggregate_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "partial and merge",
+			Raw: `import "aggregate"
+from(bucket:"mydb") |> aggregate.partial(method: "quantile") |> aggregate.merge(method: "quantile", q: 0.99, column: "p99")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mydb",
+						},
+					},
+					{
+						ID: "aggregatePartial1",
+						Spec: &aggregate.PartialOpSpec{
+							Method:      aggregate.MethodQuantile,
+							Column:      "_value",
+							Compression: aggregate.DefaultCompression,
+						},
+					},
+					{
+						ID: "aggregateMerge2",
+						Spec: &aggregate.MergeOpSpec{
+							Method:      aggregate.MethodQuantile,
+							Final:       true,
+							Column:      "p99",
+							Quantile:    0.99,
+							Compression: aggregate.DefaultCompression,
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "aggregatePartial1"},
+					{Parent: "aggregatePartial1", Child: "aggregateMerge2"},
+				},
+			},
+		},
+		{
+			Name: "merge stddev into a partial state",
+			Raw: `import "aggregate"
+from(bucket:"mydb") |> aggregate.merge(method: "stddev", final: false)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mydb",
+						},
+					},
+					{
+						ID: "aggregateMerge1",
+						Spec: &aggregate.MergeOpSpec{
+							Method: aggregate.MethodStddev,
+							Column: "_value",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "aggregateMerge1"},
+				},
+			},
+		},
+		{
+			Name: "unknown method",
+			Raw: `import "aggregate"
+from(bucket:"mydb") |> aggregate.partial(method: "median")`,
+			WantErr: true,
+		},
+		{
+			Name: "final quantile without q",
+			Raw: `import "aggregate"
+from(bucket:"mydb") |> aggregate.merge(method: "quantile")`,
+			WantErr: true,
+		},
+		{
+			Name: "compression of mean",
+			Raw: `import "aggregate"
+from(bucket:"mydb") |> aggregate.partial(method: "mean", compression: 10.0)`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestPartial_Process(t *testing.T) {
+	testCases := []struct {
+		name    string
+		spec    *aggregate.PartialProcedureSpec
+		data    []flux.Table
+		want    []*executetest.Table
+		wantErr error
+	}{
+		{
+			name: "mean",
+			spec: &aggregate.PartialProcedureSpec{
+				Method: aggregate.MethodMean,
+				Column: "_value",
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "host", Type: flux.TString},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "a", int64(2)},
+					{execute.Time(2), "a", nil},
+					{execute.Time(3), "a", int64(5)},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "host", Type: flux.TString},
+					{Label: "_count", Type: flux.TInt},
+					{Label: "_sum", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{"a", int64(2), 7.0},
+				},
+			}},
+		},
+		{
+			name: "stddev",
+			spec: &aggregate.PartialProcedureSpec{
+				Method: aggregate.MethodStddev,
+				Column: "_value",
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+					{execute.Time(2), 3.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_count", Type: flux.TInt},
+					{Label: "_mean", Type: flux.TFloat},
+					{Label: "_m2", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{int64(2), 2.0, 2.0},
+				},
+			}},
+		},
+		{
+			name: "quantile",
+			spec: &aggregate.PartialProcedureSpec{
+				Method:      aggregate.MethodQuantile,
+				Column:      "_value",
+				Compression: aggregate.DefaultCompression,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 3.0},
+					{execute.Time(2), 1.0},
+					{execute.Time(3), 2.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_centroid", Type: flux.TFloat},
+					{Label: "_weight", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{1.0, 1.0},
+					{2.0, 1.0},
+					{3.0, 1.0},
+				},
+			}},
+		},
+		{
+			name: "string column",
+			spec: &aggregate.PartialProcedureSpec{
+				Method: aggregate.MethodMean,
+				Column: "_value",
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "a"},
+				},
+			}},
+			wantErr: errors.New("unsupported aggregate column type string"),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				tc.wantErr,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					return aggregate.NewPartialTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
+
+func TestMerge_Process(t *testing.T) {
+	testCases := []struct {
+		name    string
+		spec    *aggregate.MergeProcedureSpec
+		data    []flux.Table
+		want    []*executetest.Table
+		wantErr error
+	}{
+		{
+			name: "mean of partials",
+			spec: &aggregate.MergeProcedureSpec{
+				Method: aggregate.MethodMean,
+				Final:  true,
+				Column: "_value",
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "host", Type: flux.TString},
+					{Label: "shard", Type: flux.TString},
+					{Label: "_count", Type: flux.TInt},
+					{Label: "_sum", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{"a", "s1", int64(2), 7.0},
+					{"a", "s2", int64(1), 2.0},
+					{"a", "s3", int64(0), 0.0},
+					{"a", "s4", nil, nil},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "host", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{"a", 3.0},
+				},
+			}},
+		},
+		{
+			name: "stddev partials into a partial",
+			spec: &aggregate.MergeProcedureSpec{
+				Method: aggregate.MethodStddev,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_count", Type: flux.TFloat},
+					{Label: "_mean", Type: flux.TFloat},
+					{Label: "_m2", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{2.0, 2.0, 2.0},
+					{2.0, 6.0, 2.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_count", Type: flux.TInt},
+					{Label: "_mean", Type: flux.TFloat},
+					{Label: "_m2", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{int64(4), 4.0, 20.0},
+				},
+			}},
+		},
+		{
+			name: "stddev without values",
+			spec: &aggregate.MergeProcedureSpec{
+				Method: aggregate.MethodStddev,
+				Final:  true,
+				Column: "_value",
+				Mode:   aggregate.ModeSample,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_count", Type: flux.TInt},
+					{Label: "_mean", Type: flux.TFloat},
+					{Label: "_m2", Type: flux.TFloat},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{nil},
+				},
+			}},
+		},
+		{
+			name: "missing partial column",
+			spec: &aggregate.MergeProcedureSpec{
+				Method: aggregate.MethodSkew,
+				Final:  true,
+				Column: "_value",
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_count", Type: flux.TInt},
+					{Label: "_mean", Type: flux.TFloat},
+					{Label: "_m2", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{int64(2), 2.0, 2.0},
+				},
+			}},
+			wantErr: errors.New(`partial state column "_m3" does not exist`),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				tc.wantErr,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					return aggregate.NewMergeTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package aggregate
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const MergeKind = "aggregateMerge"
+
+type MergeOpSpec struct {
+	Method string `json:"method"`
+	// Final outputs the value of the aggregate instead of the merged partial state.
+	Final       bool    `json:"final"`
+	Column      string  `json:"column"`
+	Mode        string  `json:"mode,omitempty"`
+	Quantile    float64 `json:"quantile,omitempty"`
+	Compression float64 `json:"compression,omitempty"`
+}
+
+func init() {
+	mergeSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"method":      semantic.String,
+			"final":       semantic.Bool,
+			"column":      semantic.String,
+			"mode":        semantic.String,
+			"q":           semantic.Float,
+			"compression": semantic.Float,
+		},
+		[]string{"method"},
+	)
+
+	flux.RegisterPackageValue("aggregate", "merge", flux.FunctionValue(MergeKind, createMergeOpSpec, mergeSignature))
+	flux.RegisterOpSpec(MergeKind, newMergeOp)
+	plan.RegisterProcedureSpec(MergeKind, newMergeProcedure, MergeKind)
+	execute.RegisterTransformation(MergeKind, createMergeTransformation)
+}
+
+func createMergeOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	spec := &MergeOpSpec{
+		Final:  true,
+		Column: execute.DefaultValueColLabel,
+	}
+	method, err := args.GetRequiredString("method")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := PartialColumns(method); err != nil {
+		return nil, err
+	}
+	spec.Method = method
+
+	if final, ok, err := args.GetBool("final"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Final = final
+	}
+	if col, ok, err := args.GetString("column"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Column = col
+	}
+	if spec.Compression, err = getCompression(args, method); err != nil {
+		return nil, err
+	}
+
+	mode, ok, err := args.GetString("mode")
+	if err != nil {
+		return nil, err
+	}
+	if method == MethodStddev && spec.Final {
+		spec.Mode = ModeSample
+		if ok {
+			if mode != ModeSample && mode != ModePopulation {
+				return nil, fmt.Errorf("%q is not a valid standard deviation mode", mode)
+			}
+			spec.Mode = mode
+		}
+	} else if ok {
+		return nil, errors.New("mode parameter is only valid for the final value of method stddev")
+	}
+
+	q, ok, err := args.GetFloat("q")
+	if err != nil {
+		return nil, err
+	}
+	if method == MethodQuantile && spec.Final {
+		if !ok {
+			return nil, errors.New("missing required keyword argument \"q\"")
+		}
+		if q < 0 || q > 1 {
+			return nil, errors.New("quantile must be between 0 and 1")
+		}
+		spec.Quantile = q
+	} else if ok {
+		return nil, errors.New("q parameter is only valid for the final value of method quantile")
+	}
+	return spec, nil
+}
+
+func newMergeOp() flux.OperationSpec {
+	return new(MergeOpSpec)
+}
+
+func (s *MergeOpSpec) Kind() flux.OperationKind {
+	return MergeKind
+}
+
+type MergeProcedureSpec struct {
+	plan.DefaultCost
+	Method      string
+	Final       bool
+	Column      string
+	Mode        string
+	Quantile    float64
+	Compression float64
+}
+
+func newMergeProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*MergeOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	return &MergeProcedureSpec{
+		Method:      spec.Method,
+		Final:       spec.Final,
+		Column:      spec.Column,
+		Mode:        spec.Mode,
+		Quantile:    spec.Quantile,
+		Compression: spec.Compression,
+	}, nil
+}
+
+func (s *MergeProcedureSpec) Kind() plan.ProcedureKind {
+	return MergeKind
+}
+
+func (s *MergeProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	return &ns
+}
+
+// TriggerSpec implements plan.TriggerAwareProcedureSpec
+func (s *MergeProcedureSpec) TriggerSpec() plan.TriggerSpec {
+	return plan.NarrowTransformationTriggerSpec{}
+}
+
+func createMergeTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*MergeProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewMergeTransformation(d, cache, s)
+	return t, d, nil
+}
+
+type mergeTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+	spec  MergeProcedureSpec
+}
+
+// NewMergeTransformation creates a transformation that merges all rows of partial state
+// of every table. It outputs either the merged partial state, or a single row with the
+// final value of the aggregate in a float column, which is null if no values were aggregated.
+// Rows that have a null in any partial state column are ignored.
+func NewMergeTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *MergeProcedureSpec) *mergeTransformation {
+	return &mergeTransformation{
+		d:     d,
+		cache: cache,
+		spec:  *spec,
+	}
+}
+
+func (t *mergeTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *mergeTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	cols, err := PartialColumns(t.spec.Method)
+	if err != nil {
+		return err
+	}
+	// The partial state may have been read back from a source that changed
+	// the type of its columns, so any numeric type is accepted.
+	idx := make([]int, len(cols))
+	for k, c := range cols {
+		j := execute.ColIdx(c.Label, tbl.Cols())
+		if j < 0 {
+			return fmt.Errorf("partial state column %q does not exist", c.Label)
+		}
+		switch typ := tbl.Cols()[j].Type; typ {
+		case flux.TInt, flux.TUInt, flux.TFloat:
+		default:
+			return fmt.Errorf("partial state column %q must be numeric, got %v", c.Label, typ)
+		}
+		idx[k] = j
+	}
+
+	st := newState(t.spec.Method, t.spec.Compression)
+	row := make([]float64, len(cols))
+	if err := tbl.Do(func(cr flux.ColReader) error {
+	rows:
+		for i := 0; i < cr.Len(); i++ {
+			for k, j := range idx {
+				v := execute.ValueForRow(cr, i, j)
+				if v.IsNull() {
+					continue rows
+				}
+				row[k] = toFloat(v)
+			}
+			st.merge(row)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	builder, created := t.cache.TableBuilder(tbl.Key())
+	if !created {
+		return fmt.Errorf("merge aggregate found duplicate table with key: %v", tbl.Key())
+	}
+	if !t.spec.Final {
+		return appendPartial(builder, tbl.Key(), t.spec.Method, st)
+	}
+
+	if err := execute.AddTableKeyCols(tbl.Key(), builder); err != nil {
+		return err
+	}
+	j, err := builder.AddCol(flux.ColMeta{Label: t.spec.Column, Type: flux.TFloat})
+	if err != nil {
+		return err
+	}
+	var (
+		v  float64
+		ok bool
+	)
+	switch st := st.(type) {
+	case *momentState:
+		v, ok = st.value(t.spec.Mode)
+	case *quantileState:
+		v, ok = st.value(t.spec.Quantile, t.spec.Compression)
+	}
+	if ok {
+		err = builder.AppendFloat(j, v)
+	} else {
+		err = builder.AppendNil(j)
+	}
+	if err != nil {
+		return err
+	}
+	return execute.AppendKeyValues(tbl.Key(), builder)
+}
+
+func toFloat(v values.Value) float64 {
+	switch v.Type() {
+	case semantic.Int:
+		return float64(v.Int())
+	case semantic.UInt:
+		return float64(v.UInt())
+	default:
+		return v.Float()
+	}
+}
+
+func (t *mergeTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+
+func (t *mergeTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+
+func (t *mergeTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
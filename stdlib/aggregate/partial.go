@@ -0,0 +1,254 @@
+package aggregate
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+)
+
+const PartialKind = "aggregatePartial"
+
+type PartialOpSpec struct {
+	Method      string  `json:"method"`
+	Column      string  `json:"column"`
+	Compression float64 `json:"compression,omitempty"`
+}
+
+func init() {
+	partialSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"method":      semantic.String,
+			"column":      semantic.String,
+			"compression": semantic.Float,
+		},
+		[]string{"method"},
+	)
+
+	flux.RegisterPackageValue("aggregate", "partial", flux.FunctionValue(PartialKind, createPartialOpSpec, partialSignature))
+	flux.RegisterOpSpec(PartialKind, newPartialOp)
+	plan.RegisterProcedureSpec(PartialKind, newPartialProcedure, PartialKind)
+	execute.RegisterTransformation(PartialKind, createPartialTransformation)
+}
+
+func createPartialOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	spec := &PartialOpSpec{
+		Column: execute.DefaultValueColLabel,
+	}
+	method, err := args.GetRequiredString("method")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := PartialColumns(method); err != nil {
+		return nil, err
+	}
+	spec.Method = method
+
+	if col, ok, err := args.GetString("column"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Column = col
+	}
+	if spec.Compression, err = getCompression(args, method); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// getCompression reads the compression argument, which is only valid for the quantile method.
+func getCompression(args flux.Arguments, method string) (float64, error) {
+	c, ok, err := args.GetFloat("compression")
+	if err != nil {
+		return 0, err
+	}
+	if method != MethodQuantile {
+		if ok {
+			return 0, errors.New("compression parameter is only valid for method quantile")
+		}
+		return 0, nil
+	}
+	if !ok {
+		return DefaultCompression, nil
+	}
+	if c <= 0 {
+		return 0, errors.New("compression must be positive")
+	}
+	return c, nil
+}
+
+func newPartialOp() flux.OperationSpec {
+	return new(PartialOpSpec)
+}
+
+func (s *PartialOpSpec) Kind() flux.OperationKind {
+	return PartialKind
+}
+
+type PartialProcedureSpec struct {
+	plan.DefaultCost
+	Method      string
+	Column      string
+	Compression float64
+}
+
+func newPartialProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*PartialOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	return &PartialProcedureSpec{
+		Method:      spec.Method,
+		Column:      spec.Column,
+		Compression: spec.Compression,
+	}, nil
+}
+
+func (s *PartialProcedureSpec) Kind() plan.ProcedureKind {
+	return PartialKind
+}
+
+func (s *PartialProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	return &ns
+}
+
+// TriggerSpec implements plan.TriggerAwareProcedureSpec
+func (s *PartialProcedureSpec) TriggerSpec() plan.TriggerSpec {
+	return plan.NarrowTransformationTriggerSpec{}
+}
+
+func createPartialTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*PartialProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewPartialTransformation(d, cache, s)
+	return t, d, nil
+}
+
+type partialTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+	spec  PartialProcedureSpec
+}
+
+// NewPartialTransformation creates a transformation that outputs the partial state
+// of an aggregate of the non-null values of a column of every table.
+// The output table has the group key of the input table, and the partial state columns.
+func NewPartialTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *PartialProcedureSpec) *partialTransformation {
+	return &partialTransformation{
+		d:     d,
+		cache: cache,
+		spec:  *spec,
+	}
+}
+
+func (t *partialTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *partialTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	j := execute.ColIdx(t.spec.Column, tbl.Cols())
+	if j < 0 {
+		return fmt.Errorf("column %q does not exist", t.spec.Column)
+	}
+	if tbl.Key().HasCol(t.spec.Column) {
+		return errors.New("cannot aggregate columns that are part of the group key")
+	}
+	typ := tbl.Cols()[j].Type
+	switch typ {
+	case flux.TInt, flux.TUInt, flux.TFloat:
+	default:
+		return fmt.Errorf("unsupported aggregate column type %v", typ)
+	}
+
+	st := newState(t.spec.Method, t.spec.Compression)
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		switch typ {
+		case flux.TInt:
+			vs := cr.Ints(j)
+			for i := 0; i < vs.Len(); i++ {
+				if vs.IsValid(i) {
+					st.add(float64(vs.Value(i)))
+				}
+			}
+		case flux.TUInt:
+			vs := cr.UInts(j)
+			for i := 0; i < vs.Len(); i++ {
+				if vs.IsValid(i) {
+					st.add(float64(vs.Value(i)))
+				}
+			}
+		case flux.TFloat:
+			vs := cr.Floats(j)
+			for i := 0; i < vs.Len(); i++ {
+				if vs.IsValid(i) {
+					st.add(vs.Value(i))
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	builder, created := t.cache.TableBuilder(tbl.Key())
+	if !created {
+		return fmt.Errorf("partial aggregate found duplicate table with key: %v", tbl.Key())
+	}
+	return appendPartial(builder, tbl.Key(), t.spec.Method, st)
+}
+
+// appendPartial adds the group key and partial state columns to a new table, and appends the rows of the partial state.
+func appendPartial(builder execute.TableBuilder, key flux.GroupKey, method string, st state) error {
+	if err := execute.AddTableKeyCols(key, builder); err != nil {
+		return err
+	}
+	cols, err := PartialColumns(method)
+	if err != nil {
+		return err
+	}
+	idx := make([]int, len(cols))
+	for k, c := range cols {
+		if idx[k], err = builder.AddCol(c); err != nil {
+			return err
+		}
+	}
+	for _, row := range st.rows() {
+		for k, c := range cols {
+			if c.Type == flux.TInt {
+				err = builder.AppendInt(idx[k], int64(row[k]))
+			} else {
+				err = builder.AppendFloat(idx[k], row[k])
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if err := execute.AppendKeyValues(key, builder); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *partialTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+
+func (t *partialTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+
+func (t *partialTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
@@ -21,11 +21,11 @@ var pkgAST = &ast.Package{
 			Errors: nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
-					Column: 11,
-					Line:   3,
+					Column: 12,
+					Line:   5,
 				},
 				File:   "http.flux",
-				Source: "package http\n\nbuiltin to",
+				Source: "package http\n\nbuiltin to\nbuiltin from\nbuiltin get",
 				Start: ast.Position{
 					Column: 1,
 					Line:   1,
@@ -66,6 +66,74 @@ var pkgAST = &ast.Package{
 				},
 				Name: "to",
 			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 13,
+						Line:   4,
+					},
+					File:   "http.flux",
+					Source: "builtin from",
+					Start: ast.Position{
+						Column: 1,
+						Line:   4,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 13,
+							Line:   4,
+						},
+						File:   "http.flux",
+						Source: "from",
+						Start: ast.Position{
+							Column: 9,
+							Line:   4,
+						},
+					},
+				},
+				Name: "from",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 12,
+						Line:   5,
+					},
+					File:   "http.flux",
+					Source: "builtin get",
+					Start: ast.Position{
+						Column: 1,
+						Line:   5,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 12,
+							Line:   5,
+						},
+						File:   "http.flux",
+						Source: "get",
+						Start: ast.Position{
+							Column: 9,
+							Line:   5,
+						},
+					},
+				},
+				Name: "get",
+			},
 		}},
 		Imports: nil,
 		Name:    "http.flux",
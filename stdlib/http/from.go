@@ -0,0 +1,336 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/csv"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/json"
+	"github.com/influxdata/flux/line"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	"github.com/pkg/errors"
+)
+
+const (
+	FromHTTPKind           = "fromHTTP"
+	DefaultFromHTTPTimeout = 30 * time.Second
+)
+
+// FromHTTPDecoders are the decoders that http.from can use to read the response body.
+var FromHTTPDecoders = []string{"json", "csv", "line"}
+
+type FromHTTPOpSpec struct {
+	URL     string            `json:"url"`
+	Method  string            `json:"method"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body,omitempty"`
+	Timeout time.Duration     `json:"timeout"`
+	Decoder string            `json:"decoder"`
+	// Path locates the array of records in a JSON response.
+	Path string `json:"path,omitempty"`
+}
+
+func init() {
+	fromHTTPSignature := semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			"url":     semantic.String,
+			"method":  semantic.String,
+			"headers": semantic.Tvar(1),
+			"body":    semantic.String,
+			"timeout": semantic.Duration,
+			"decoder": semantic.String,
+			"path":    semantic.String,
+		},
+		Required: semantic.LabelSet{"url"},
+		Return:   flux.TableObjectType,
+	}
+	// get is from without a method and a body.
+	getHTTPSignature := semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			"url":     semantic.String,
+			"headers": semantic.Tvar(1),
+			"timeout": semantic.Duration,
+			"decoder": semantic.String,
+			"path":    semantic.String,
+		},
+		Required: semantic.LabelSet{"url"},
+		Return:   flux.TableObjectType,
+	}
+
+	flux.RegisterPackageValue("http", "from", flux.FunctionValue(FromHTTPKind, createFromHTTPOpSpec, fromHTTPSignature))
+	flux.RegisterPackageValue("http", "get", flux.FunctionValue(FromHTTPKind, createFromHTTPOpSpec, getHTTPSignature))
+	flux.RegisterOpSpec(FromHTTPKind, newFromHTTPOp)
+	plan.RegisterProcedureSpec(FromHTTPKind, newFromHTTPProcedure, FromHTTPKind)
+	execute.RegisterSource(FromHTTPKind, createFromHTTPSource)
+}
+
+func createFromHTTPOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	spec := new(FromHTTPOpSpec)
+	if err := spec.ReadArgs(args); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// ReadArgs loads a flux.Arguments into FromHTTPOpSpec.
+// The method defaults to GET, the timeout to DefaultFromHTTPTimeout and the decoder to json.
+func (s *FromHTTPOpSpec) ReadArgs(args flux.Arguments) error {
+	var err error
+	s.URL, err = args.GetRequiredString("url")
+	if err != nil {
+		return err
+	}
+	u, err := url.ParseRequestURI(s.URL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("scheme must be http or https but was %s", u.Scheme)
+	}
+
+	var ok bool
+	s.Method, ok, err = args.GetString("method")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		s.Method = http.MethodGet
+	}
+	s.Method = strings.ToUpper(s.Method)
+
+	if headers, ok, err := args.GetObject("headers"); err != nil {
+		return err
+	} else if ok {
+		s.Headers, err = toStringMap("headers", headers)
+		if err != nil {
+			return err
+		}
+	}
+
+	if s.Body, _, err = args.GetString("body"); err != nil {
+		return err
+	}
+
+	timeout, ok, err := args.GetDuration("timeout")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		s.Timeout = DefaultFromHTTPTimeout
+	} else {
+		s.Timeout = time.Duration(timeout)
+	}
+
+	s.Decoder, ok, err = args.GetString("decoder")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		s.Decoder = FromHTTPDecoders[0]
+	}
+	if !contains(FromHTTPDecoders, s.Decoder) {
+		return fmt.Errorf("invalid decoder %s, must be one of %v", s.Decoder, FromHTTPDecoders)
+	}
+
+	if s.Path, _, err = args.GetString("path"); err != nil {
+		return err
+	}
+	if s.Path != "" && s.Decoder != "json" {
+		return errors.New("path can only be used with the json decoder")
+	}
+	return nil
+}
+
+// toStringMap converts an object with string properties to a map.
+func toStringMap(name string, obj values.Object) (map[string]string, error) {
+	m := make(map[string]string, obj.Len())
+	var err error
+	obj.Range(func(k string, v values.Value) {
+		if err != nil {
+			return
+		}
+		if v.Type() != semantic.String {
+			err = fmt.Errorf("%s: value of %s must be a string, got %v", name, k, v.Type())
+			return
+		}
+		m[k] = v.Str()
+	})
+	return m, err
+}
+
+func contains(ss []string, s string) bool {
+	for _, st := range ss {
+		if st == s {
+			return true
+		}
+	}
+	return false
+}
+
+func newFromHTTPOp() flux.OperationSpec {
+	return new(FromHTTPOpSpec)
+}
+
+func (s *FromHTTPOpSpec) Kind() flux.OperationKind {
+	return FromHTTPKind
+}
+
+type FromHTTPProcedureSpec struct {
+	plan.DefaultCost
+	URL     string
+	Method  string
+	Headers map[string]string
+	Body    string
+	Timeout time.Duration
+	Decoder string
+	Path    string
+}
+
+func newFromHTTPProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*FromHTTPOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	return &FromHTTPProcedureSpec{
+		URL:     spec.URL,
+		Method:  spec.Method,
+		Headers: spec.Headers,
+		Body:    spec.Body,
+		Timeout: spec.Timeout,
+		Decoder: spec.Decoder,
+		Path:    spec.Path,
+	}, nil
+}
+
+func (s *FromHTTPProcedureSpec) Kind() plan.ProcedureKind {
+	return FromHTTPKind
+}
+
+func (s *FromHTTPProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	if s.Headers != nil {
+		ns.Headers = make(map[string]string, len(s.Headers))
+		for k, v := range s.Headers {
+			ns.Headers[k] = v
+		}
+	}
+	return &ns
+}
+
+func createFromHTTPSource(s plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
+	spec, ok := s.(*FromHTTPProcedureSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", s)
+	}
+	return NewFromHTTPSource(spec, fromHTTPClient, &nowTimeProvider{}, dsid)
+}
+
+var fromHTTPClient = newToHTTPClient()
+
+// nowTimeProvider provides wall clock time to the line decoder.
+type nowTimeProvider struct{}
+
+func (a *nowTimeProvider) CurrentTime() values.Time {
+	return values.ConvertTime(time.Now())
+}
+
+// NewFromHTTPSource creates a source that sends the request described by spec with client
+// and decodes the response body into tables.
+func NewFromHTTPSource(spec *FromHTTPProcedureSpec, client *http.Client, tp line.TimeProvider, dsid execute.DatasetID) (execute.Source, error) {
+	var decoder flux.ResultDecoder
+	switch spec.Decoder {
+	case "csv":
+		decoder = csv.NewResultDecoder(csv.ResultDecoderConfig{})
+	case "line":
+		decoder = line.NewResultDecoder(&line.ResultDecoderConfig{
+			Separator:    '\n',
+			TimeProvider: tp,
+		})
+	case "json":
+		decoder = json.NewResultDecoder(json.ResultDecoderConfig{Path: spec.Path})
+	}
+	if decoder == nil {
+		return nil, fmt.Errorf("unknown decoder type: %v", spec.Decoder)
+	}
+
+	return &httpSource{
+		d:       dsid,
+		spec:    spec,
+		client:  client,
+		decoder: decoder,
+	}, nil
+}
+
+type httpSource struct {
+	d       execute.DatasetID
+	spec    *FromHTTPProcedureSpec
+	client  *http.Client
+	decoder flux.ResultDecoder
+	ts      []execute.Transformation
+}
+
+func (hs *httpSource) AddTransformation(t execute.Transformation) {
+	hs.ts = append(hs.ts, t)
+}
+
+func (hs *httpSource) Run(ctx context.Context) {
+	err := hs.run(ctx)
+	for _, t := range hs.ts {
+		t.Finish(hs.d, err)
+	}
+}
+
+func (hs *httpSource) run(ctx context.Context) error {
+	if hs.spec.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, hs.spec.Timeout)
+		defer cancel()
+	}
+
+	var body io.Reader
+	if hs.spec.Body != "" {
+		body = strings.NewReader(hs.spec.Body)
+	}
+	req, err := http.NewRequest(hs.spec.Method, hs.spec.URL, body)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("User-Agent", DefaultToHTTPUserAgent)
+	for k, v := range hs.spec.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := hs.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "http request failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("http request failed with status %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	result, err := hs.decoder.Decode(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "decode error")
+	}
+	return result.Tables().Do(func(tbl flux.Table) error {
+		for _, t := range hs.ts {
+			if err := t.Process(hs.d, tbl); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
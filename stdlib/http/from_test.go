@@ -0,0 +1,260 @@
+package http_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/mock"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/querytest"
+	fhttp "github.com/influxdata/flux/stdlib/http"
+)
+
+func TestFromHTTP_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "get defaults",
+			Raw: `import "http"
+http.get(url: "http://localhost:8080/api")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "fromHTTP0",
+						Spec: &fhttp.FromHTTPOpSpec{
+							URL:     "http://localhost:8080/api",
+							Method:  "GET",
+							Timeout: fhttp.DefaultFromHTTPTimeout,
+							Decoder: "json",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "from with all options",
+			Raw: `import "http"
+http.from(url: "https://localhost/query", method: "post", headers: {Authorization: "Token abc", "Content-Type": "text/plain"}, body: "q", timeout: 5s, decoder: "json", path: "data.items")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "fromHTTP0",
+						Spec: &fhttp.FromHTTPOpSpec{
+							URL:    "https://localhost/query",
+							Method: "POST",
+							Headers: map[string]string{
+								"Authorization": "Token abc",
+								"Content-Type":  "text/plain",
+							},
+							Body:    "q",
+							Timeout: 5 * time.Second,
+							Decoder: "json",
+							Path:    "data.items",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "get does not take a body",
+			Raw: `import "http"
+http.get(url: "http://localhost", body: "q")`,
+			WantErr: true,
+		},
+		{
+			Name: "invalid decoder",
+			Raw: `import "http"
+http.get(url: "http://localhost", decoder: "xml")`,
+			WantErr: true,
+		},
+		{
+			Name: "path without json decoder",
+			Raw: `import "http"
+http.get(url: "http://localhost", decoder: "csv", path: "data")`,
+			WantErr: true,
+		},
+		{
+			Name: "invalid scheme",
+			Raw: `import "http"
+http.get(url: "ftp://localhost")`,
+			WantErr: true,
+		},
+		{
+			Name: "header that is not a string",
+			Raw: `import "http"
+http.get(url: "http://localhost", headers: {retries: 1})`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestFromHTTPSource_Run(t *testing.T) {
+	testCases := []struct {
+		name    string
+		spec    *fhttp.FromHTTPProcedureSpec
+		handler func(t *testing.T, w http.ResponseWriter, r *http.Request)
+		want    []*executetest.Table
+		wantErr bool
+	}{
+		{
+			name: "json",
+			spec: &fhttp.FromHTTPProcedureSpec{
+				Method:  "POST",
+				Headers: map[string]string{"Authorization": "Token abc"},
+				Body:    "query",
+				Decoder: "json",
+				Path:    "results",
+			},
+			handler: func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+				if r.Method != "POST" {
+					t.Errorf("unexpected method %s", r.Method)
+				}
+				if got := r.Header.Get("Authorization"); got != "Token abc" {
+					t.Errorf("unexpected authorization header %q", got)
+				}
+				if body, _ := ioutil.ReadAll(r.Body); string(body) != "query" {
+					t.Errorf("unexpected body %q", body)
+				}
+				_, _ = w.Write([]byte(`{"results": [{"_time": "1970-01-01T00:00:00.000000001Z", "_value": 1.5}, {"_time": "1970-01-01T00:00:00.000000002Z", "_value": 2}]}`))
+			},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.5},
+					{execute.Time(2), 2.0},
+				},
+			}},
+		},
+		{
+			name: "csv",
+			spec: &fhttp.FromHTTPProcedureSpec{Method: "GET", Decoder: "csv"},
+			handler: func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`#datatype,string,long,dateTime:RFC3339,string,double
+#group,false,false,false,true,false
+#default,_result,,,,
+,result,table,_time,host,_value
+,,0,1970-01-01T00:00:00Z,a,0.42
+,,1,1970-01-01T00:00:00Z,b,0.1
+`))
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(0), "a", 0.42},
+					},
+				},
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(0), "b", 0.1},
+					},
+				},
+			},
+		},
+		{
+			name: "line",
+			spec: &fhttp.FromHTTPProcedureSpec{Method: "GET", Decoder: "line"},
+			handler: func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte("first\nsecond\n"))
+			},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), "first"},
+					{execute.Time(1), "second"},
+				},
+			}},
+		},
+		{
+			name: "error status",
+			spec: &fhttp.FromHTTPProcedureSpec{Method: "GET", Decoder: "json"},
+			handler: func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "no such endpoint", http.StatusNotFound)
+			},
+			wantErr: true,
+		},
+		{
+			name: "timeout",
+			spec: &fhttp.FromHTTPProcedureSpec{Method: "GET", Decoder: "json", Timeout: 10 * time.Millisecond},
+			handler: func(t *testing.T, w http.ResponseWriter, r *http.Request) {
+				select {
+				case <-r.Context().Done():
+				case <-time.After(time.Second):
+				}
+				_, _ = w.Write([]byte(`[]`))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				tc.handler(t, w, r)
+			}))
+			defer server.Close()
+			tc.spec.URL = server.URL
+
+			id := executetest.RandomDatasetID()
+			d := executetest.NewDataset(id)
+			c := execute.NewTableBuilderCache(executetest.UnlimitedAllocator)
+			c.SetTriggerSpec(plan.DefaultTriggerSpec)
+			src, err := fhttp.NewFromHTTPSource(tc.spec, server.Client(), &mock.AscendingTimeProvider{}, id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			src.AddTransformation(executetest.NewYieldTransformation(d, c))
+			src.Run(context.Background())
+
+			if tc.wantErr {
+				if d.FinishedErr == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if d.FinishedErr != nil {
+				t.Fatal(d.FinishedErr)
+			}
+			got, err := executetest.TablesFromCache(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			executetest.NormalizeTables(got)
+			executetest.NormalizeTables(tc.want)
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
@@ -2,24 +2,27 @@ package http
 
 import (
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"net/url"
 	"runtime"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/internal/pkg/syncutil"
+	"github.com/influxdata/flux/lineprotocol"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/semantic"
 	"github.com/influxdata/flux/values"
-	protocol "github.com/influxdata/line-protocol"
 	"github.com/pkg/errors"
 )
 
@@ -28,6 +31,23 @@ const (
 	DefaultToHTTPTimeout = 1 * time.Second
 )
 
+// The formats that http.to can encode tables with.
+const (
+	// FormatLineProtocol writes one line of InfluxDB line protocol per row.
+	FormatLineProtocol = "lp"
+	// FormatJSON writes a JSON array with an object per row.
+	FormatJSON = "json"
+	// FormatCSV writes CSV with a header row that holds the column labels.
+	FormatCSV = "csv"
+)
+
+// formatContentTypes maps the formats to the content type that is sent by default.
+var formatContentTypes = map[string]string{
+	FormatLineProtocol: "application/vnd.influx",
+	FormatJSON:         "application/json",
+	FormatCSV:          "text/csv",
+}
+
 func init() {
 	toHTTPSignature := flux.FunctionSignature(
 		map[string]semantic.PolyType{
@@ -35,6 +55,12 @@ func init() {
 			"method":       semantic.String,
 			"name":         semantic.String,
 			"timeout":      semantic.Duration,
+			"headers":      semantic.Tvar(1),
+			"urlParams":    semantic.Tvar(2),
+			"username":     semantic.String,
+			"password":     semantic.String,
+			"token":        semantic.String,
+			"format":       semantic.String,
 			"timeColumn":   semantic.String,
 			"tagColumns":   semantic.NewArrayPolyType(semantic.String),
 			"valueColumns": semantic.NewArrayPolyType(semantic.String),
@@ -80,19 +106,30 @@ type ToHTTPOpSpec struct {
 	Method       string            `json:"method"` // default behavior should be POST
 	Name         string            `json:"name"`
 	NameColumn   string            `json:"nameColumn"` // either name or name_column must be set, if none is set try to use the "_measurement" column.
-	Headers      map[string]string `json:"headers"`    // TODO: implement Headers after bug with keys and arrays and objects is fixed (new parser implemented, with string literals as keys)
-	URLParams    map[string]string `json:"urlParams"`  // TODO: implement URLParams after bug with keys and arrays and objects is fixed (new parser implemented, with string literals as keys)
-	Timeout      time.Duration     `json:"timeout"`    // default to something reasonable if zero
+	Headers      map[string]string `json:"headers"`
+	URLParams    map[string]string `json:"urlParams"`
+	Timeout      time.Duration     `json:"timeout"` // default to something reasonable if zero
 	NoKeepAlive  bool              `json:"noKeepAlive"`
 	TimeColumn   string            `json:"timeColumn"`
 	TagColumns   []string          `json:"tagColumns"`
 	ValueColumns []string          `json:"valueColumns"`
+	// Username and Password are sent with basic authentication.
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	// Token is sent as a bearer token.
+	Token string `json:"token,omitempty"`
+	// Format is one of FormatLineProtocol, FormatJSON or FormatCSV.
+	// An empty format is line protocol.
+	Format string `json:"format,omitempty"`
 }
 
 // ReadArgs loads a flux.Arguments into ToHTTPOpSpec.  It sets several default values.
 // If the http method isn't set, it defaults to POST, it also uppercases the http method.
 // If the time_column isn't set, it defaults to execute.TimeColLabel.
 // If the value_column isn't set it defaults to a []string{execute.DefaultValueColLabel}.
+// If the format isn't set, it defaults to line protocol.
+// The Content-Type header defaults to the content type of the format and
+// the User-Agent header to DefaultToHTTPUserAgent, both can be overridden with headers.
 func (o *ToHTTPOpSpec) ReadArgs(args flux.Arguments) error {
 	var err error
 	o.URL, err = args.GetRequiredString("url")
@@ -169,13 +206,60 @@ func (o *ToHTTPOpSpec) ReadArgs(args flux.Arguments) error {
 		sort.Strings(o.ValueColumns)
 	}
 
-	// TODO: get other headers working!
+	o.Format, ok, err = args.GetString("format")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		o.Format = FormatLineProtocol
+	}
+	contentType, ok := formatContentTypes[o.Format]
+	if !ok {
+		return fmt.Errorf("invalid format %s, must be one of %s, %s or %s", o.Format, FormatLineProtocol, FormatJSON, FormatCSV)
+	}
+
 	o.Headers = map[string]string{
-		"Content-Type": "application/vnd.influx",
+		"Content-Type": contentType,
 		"User-Agent":   DefaultToHTTPUserAgent,
 	}
+	if headers, ok, err := args.GetObject("headers"); err != nil {
+		return err
+	} else if ok {
+		h, err := toStringMap("headers", headers)
+		if err != nil {
+			return err
+		}
+		for k, v := range h {
+			o.Headers[http.CanonicalHeaderKey(k)] = v
+		}
+	}
 
-	return err
+	if urlParams, ok, err := args.GetObject("urlParams"); err != nil {
+		return err
+	} else if ok {
+		o.URLParams, err = toStringMap("urlParams", urlParams)
+		if err != nil {
+			return err
+		}
+	}
+
+	if o.Username, _, err = args.GetString("username"); err != nil {
+		return err
+	}
+	if o.Password, _, err = args.GetString("password"); err != nil {
+		return err
+	}
+	if o.Token, _, err = args.GetString("token"); err != nil {
+		return err
+	}
+	if o.Password != "" && o.Username == "" {
+		return errors.New("password requires a username")
+	}
+	if o.Token != "" && o.Username != "" {
+		return errors.New("token and username are mutually exclusive")
+	}
+
+	return nil
 }
 
 func createToHTTPOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
@@ -232,6 +316,10 @@ func (o *ToHTTPProcedureSpec) Copy() plan.ProcedureSpec {
 			TimeColumn:   s.TimeColumn,
 			TagColumns:   append([]string(nil), s.TagColumns...),
 			ValueColumns: append([]string(nil), s.ValueColumns...),
+			Username:     s.Username,
+			Password:     s.Password,
+			Token:        s.Token,
+			Format:       s.Format,
 		},
 	}
 	for k, v := range s.Headers {
@@ -280,76 +368,19 @@ func NewToHTTPTransformation(d execute.Dataset, cache execute.TableBuilderCache,
 	}
 }
 
-type toHttpMetric struct {
-	tags   []*protocol.Tag
-	fields []*protocol.Field
-	name   string
-	t      time.Time
-}
-
-func (m *toHttpMetric) TagList() []*protocol.Tag {
-	return m.tags
-}
-func (m *toHttpMetric) FieldList() []*protocol.Field {
-	return m.fields
-}
-
-func (m *toHttpMetric) truncateTagsAndFields() {
-	m.fields = m.fields[:0]
-	m.tags = m.tags[:0]
-}
-
-func (m *toHttpMetric) Name() string {
-	return m.name
-}
-
-func (m *toHttpMetric) Time() time.Time {
-	return m.t
-}
-
-type idxType struct {
-	Idx  int
-	Type flux.ColType
-}
-
 func (t *ToHTTPTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
 	pr, pw := io.Pipe() // TODO: replce the pipe with something faster
-	m := &toHttpMetric{}
-	e := protocol.NewEncoder(pw)
-	e.FailOnFieldErr(true)
-	e.SetFieldSortOrder(protocol.SortFields)
-	cols := tbl.Cols()
-	labels := make(map[string]idxType, len(cols))
-	for i, col := range cols {
-		labels[col.Label] = idxType{Idx: i, Type: col.Type}
-	}
-
-	// do time
-	timeColLabel := t.spec.Spec.TimeColumn
-	timeColIdx, ok := labels[timeColLabel]
 
-	if !ok {
-		return errors.New("Could not get time column")
-	}
-	if timeColIdx.Type != flux.TTime {
-		return fmt.Errorf("column %s is not of type %s", timeColLabel, timeColIdx.Type)
-	}
-	var measurementNameCol string
-	if t.spec.Spec.Name == "" {
-		measurementNameCol = t.spec.Spec.NameColumn
-	}
-
-	// check if each col is a tag or value and cache this value for the loop
-	colMetadatas := tbl.Cols()
-	isTag := make([]bool, len(colMetadatas))
-	isValue := make([]bool, len(colMetadatas))
-
-	for i, col := range colMetadatas {
-		valIdx := sort.SearchStrings(t.spec.Spec.ValueColumns, col.Label)
-		isValue[i] = valIdx < len(t.spec.Spec.ValueColumns) && t.spec.Spec.ValueColumns[valIdx] == col.Label
-
-		tagIdx := sort.SearchStrings(t.spec.Spec.TagColumns, col.Label)
-		isTag[i] = tagIdx < len(t.spec.Spec.TagColumns) && t.spec.Spec.TagColumns[tagIdx] == col.Label
+	var encode func(w io.Writer, tbl flux.Table, builder execute.TableBuilder) error
+	switch t.spec.Spec.Format {
+	case "", FormatLineProtocol:
+		encode = t.encodeLineProtocol
+	case FormatJSON:
+		encode = encodeJSON
+	case FormatCSV:
+		encode = encodeCSV
+	default:
+		return fmt.Errorf("invalid format %s", t.spec.Spec.Format)
 	}
 
 	builder, new := t.cache.TableBuilder(tbl.Key())
@@ -361,66 +392,39 @@ func (t *ToHTTPTransformation) Process(id execute.DatasetID, tbl flux.Table) err
 
 	var wg syncutil.WaitGroup
 	wg.Do(func() error {
-		m.name = t.spec.Spec.Name
-		err := tbl.Do(func(er flux.ColReader) error {
-			l := er.Len()
-			for i := 0; i < l; i++ {
-				m.truncateTagsAndFields()
-				for j, col := range er.Cols() {
-					switch {
-					case col.Label == timeColLabel:
-						m.t = values.Time(er.Times(j).Value(i)).Time()
-					case measurementNameCol != "" && measurementNameCol == col.Label:
-						if col.Type != flux.TString {
-							return errors.New("invalid type for measurement column")
-						}
-						m.name = er.Strings(j).ValueString(i)
-					case isTag[j]:
-						if col.Type != flux.TString {
-							return errors.New("invalid type for tag column")
-						}
-						m.tags = append(m.tags, &protocol.Tag{Key: col.Label, Value: er.Strings(j).ValueString(i)})
-
-					case isValue[j]:
-						switch col.Type {
-						case flux.TFloat:
-							m.fields = append(m.fields, &protocol.Field{Key: col.Label, Value: er.Floats(j).Value(i)})
-						case flux.TInt:
-							m.fields = append(m.fields, &protocol.Field{Key: col.Label, Value: er.Ints(j).Value(i)})
-						case flux.TUInt:
-							m.fields = append(m.fields, &protocol.Field{Key: col.Label, Value: er.UInts(j).Value(i)})
-						case flux.TString:
-							m.fields = append(m.fields, &protocol.Field{Key: col.Label, Value: er.Strings(j).ValueString(i)})
-						case flux.TTime:
-							m.fields = append(m.fields, &protocol.Field{Key: col.Label, Value: values.Time(er.Times(j).Value(i))})
-						case flux.TBool:
-							m.fields = append(m.fields, &protocol.Field{Key: col.Label, Value: er.Bools(j).Value(i)})
-						default:
-							return fmt.Errorf("invalid type for column %s", col.Label)
-						}
-					}
-				}
-				_, err := e.Encode(m)
-				if err != nil {
-					return err
-				}
-
-				if err := execute.AppendRecord(i, er, builder); err != nil {
-					return err
-				}
-			}
-			return nil
-		})
-		if e := pw.Close(); e != nil && err == nil {
-			err = e
+		err := encode(pw, tbl, builder)
+		if err != nil {
+			// Fail the request instead of sending a truncated body.
+			_ = pw.CloseWithError(err)
+			return err
 		}
-		return err
+		return pw.Close()
 	})
 
-	req, err := http.NewRequest(t.spec.Spec.Method, t.spec.Spec.URL, pr)
+	u, err := url.Parse(t.spec.Spec.URL)
 	if err != nil {
 		return err
 	}
+	if len(t.spec.Spec.URLParams) > 0 {
+		q := u.Query()
+		for k, v := range t.spec.Spec.URLParams {
+			q.Set(k, v)
+		}
+		u.RawQuery = q.Encode()
+	}
+
+	req, err := http.NewRequest(t.spec.Spec.Method, u.String(), pr)
+	if err != nil {
+		return err
+	}
+	for k, v := range t.spec.Spec.Headers {
+		req.Header.Set(k, v)
+	}
+	if t.spec.Spec.Username != "" {
+		req.SetBasicAuth(t.spec.Spec.Username, t.spec.Spec.Password)
+	} else if t.spec.Spec.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+t.spec.Spec.Token)
+	}
 
 	if t.spec.Spec.Timeout > 0 {
 		ctx, cancel := context.WithTimeout(context.Background(), t.spec.Spec.Timeout)
@@ -439,6 +443,11 @@ func (t *ToHTTPTransformation) Process(id execute.DatasetID, tbl flux.Table) err
 	if err := wg.Wait(); err != nil {
 		return err
 	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		_ = resp.Body.Close()
+		return fmt.Errorf("http request failed with status %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
 	if err := resp.Body.Close(); err != nil {
 		return err
 	}
@@ -446,6 +455,179 @@ func (t *ToHTTPTransformation) Process(id execute.DatasetID, tbl flux.Table) err
 	return req.Body.Close()
 }
 
+// encodeLineProtocol writes a line of line protocol for every row of the table.
+func (t *ToHTTPTransformation) encodeLineProtocol(w io.Writer, tbl flux.Table, builder execute.TableBuilder) error {
+	enc, err := lineprotocol.NewRowEncoder(tbl.Cols(), lineprotocol.EncoderConfig{
+		TimeColumn:        t.spec.Spec.TimeColumn,
+		Measurement:       t.spec.Spec.Name,
+		MeasurementColumn: t.spec.Spec.NameColumn,
+		TagColumns:        t.spec.Spec.TagColumns,
+		FieldColumns:      t.spec.Spec.ValueColumns,
+	})
+	if err != nil {
+		return err
+	}
+	var line []byte
+	return tbl.Do(func(er flux.ColReader) error {
+		for i := 0; i < er.Len(); i++ {
+			line, err = enc.AppendRow(line[:0], er, i)
+			if err != nil {
+				return err
+			}
+			if _, err := w.Write(line); err != nil {
+				return err
+			}
+			if err := execute.AppendRecord(i, er, builder); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// encodeJSON writes the table as a JSON array with an object for every row.
+// Times are RFC3339 strings and null values are JSON nulls.
+func encodeJSON(w io.Writer, tbl flux.Table, builder execute.TableBuilder) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	first := true
+	writeJSON := func(v interface{}) error {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(b)
+		return err
+	}
+	err := tbl.Do(func(er flux.ColReader) error {
+		cols := er.Cols()
+		for i := 0; i < er.Len(); i++ {
+			if !first {
+				if _, err := io.WriteString(w, ","); err != nil {
+					return err
+				}
+			}
+			first = false
+			// Write the keys in column order rather than sorted like a map would be.
+			if _, err := io.WriteString(w, "{"); err != nil {
+				return err
+			}
+			for j, col := range cols {
+				if j > 0 {
+					if _, err := io.WriteString(w, ","); err != nil {
+						return err
+					}
+				}
+				if err := writeJSON(col.Label); err != nil {
+					return err
+				}
+				if _, err := io.WriteString(w, ":"); err != nil {
+					return err
+				}
+				if err := writeJSON(rowValue(er, i, j)); err != nil {
+					return err
+				}
+			}
+			if _, err := io.WriteString(w, "}"); err != nil {
+				return err
+			}
+			if err := execute.AppendRecord(i, er, builder); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]\n")
+	return err
+}
+
+// encodeCSV writes the table as CSV with a header row of column labels.
+// Times are RFC3339 strings and null values are empty.
+func encodeCSV(w io.Writer, tbl flux.Table, builder execute.TableBuilder) error {
+	cw := csv.NewWriter(w)
+	cols := tbl.Cols()
+	record := make([]string, len(cols))
+	for j, col := range cols {
+		record[j] = col.Label
+	}
+	if err := cw.Write(record); err != nil {
+		return err
+	}
+	err := tbl.Do(func(er flux.ColReader) error {
+		for i := 0; i < er.Len(); i++ {
+			for j := range cols {
+				record[j] = formatValue(rowValue(er, i, j))
+			}
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+			if err := execute.AppendRecord(i, er, builder); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// rowValue returns the value of column j in row i as a Go value or nil if it is null.
+func rowValue(er flux.ColReader, i, j int) interface{} {
+	switch er.Cols()[j].Type {
+	case flux.TBool:
+		if vs := er.Bools(j); vs.IsValid(i) {
+			return vs.Value(i)
+		}
+	case flux.TInt:
+		if vs := er.Ints(j); vs.IsValid(i) {
+			return vs.Value(i)
+		}
+	case flux.TUInt:
+		if vs := er.UInts(j); vs.IsValid(i) {
+			return vs.Value(i)
+		}
+	case flux.TFloat:
+		if vs := er.Floats(j); vs.IsValid(i) {
+			return vs.Value(i)
+		}
+	case flux.TString:
+		if vs := er.Strings(j); vs.IsValid(i) {
+			return vs.ValueString(i)
+		}
+	case flux.TTime:
+		if vs := er.Times(j); vs.IsValid(i) {
+			return values.Time(vs.Value(i)).Time().Format(time.RFC3339Nano)
+		}
+	}
+	return nil
+}
+
+func formatValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case bool:
+		return strconv.FormatBool(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
 func (t *ToHTTPTransformation) UpdateWatermark(id execute.DatasetID, pt execute.Time) error {
 	return t.d.UpdateWatermark(pt)
 }
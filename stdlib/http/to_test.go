@@ -8,10 +8,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/flux"
 	_ "github.com/influxdata/flux/builtin" // We need to import the builtins for the tests to work.
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/querytest"
 	fhttp "github.com/influxdata/flux/stdlib/http"
 	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
@@ -45,6 +47,7 @@ from(bucket:"mybucket") |> http.to(url: "https://localhost:8081", name:"series1"
 								"Content-Type": "application/vnd.influx",
 								"User-Agent":   "fluxd/dev",
 							},
+							Format: "lp",
 						},
 					},
 				},
@@ -53,6 +56,65 @@ from(bucket:"mybucket") |> http.to(url: "https://localhost:8081", name:"series1"
 				},
 			},
 		},
+		{
+			Name: "to webhook with json format",
+			Raw: `
+import "http"
+from(bucket:"mybucket") |> http.to(url: "https://localhost:8081/hook", format: "json", headers: {"x-api-key": "abc", "Content-Type": "application/x-ndjson"}, urlParams: {channel: "alerts"}, token: "secret")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mybucket",
+						},
+					},
+					{
+						ID: "toHTTP1",
+						Spec: &fhttp.ToHTTPOpSpec{
+							URL:          "https://localhost:8081/hook",
+							NameColumn:   "_measurement",
+							Method:       "POST",
+							Timeout:      fhttp.DefaultToHTTPTimeout,
+							TimeColumn:   execute.DefaultTimeColLabel,
+							ValueColumns: []string{execute.DefaultValueColLabel},
+							Headers: map[string]string{
+								"Content-Type": "application/x-ndjson",
+								"User-Agent":   "fluxd/dev",
+								"X-Api-Key":    "abc",
+							},
+							URLParams: map[string]string{"channel": "alerts"},
+							Token:     "secret",
+							Format:    "json",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "toHTTP1"},
+				},
+			},
+		},
+		{
+			Name: "invalid format",
+			Raw: `
+import "http"
+from(bucket:"mybucket") |> http.to(url: "https://localhost:8081", format: "xml")`,
+			WantErr: true,
+		},
+		{
+			Name: "password without username",
+			Raw: `
+import "http"
+from(bucket:"mybucket") |> http.to(url: "https://localhost:8081", password: "p")`,
+			WantErr: true,
+		},
+		{
+			Name: "token and username",
+			Raw: `
+import "http"
+from(bucket:"mybucket") |> http.to(url: "https://localhost:8081", username: "u", token: "t")`,
+			WantErr: true,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -572,3 +634,128 @@ one_table_w_tag,fred=elevendyone _value=4 41
 		})
 	}
 }
+
+func TestToHTTP_ProcessFormats(t *testing.T) {
+	type request struct {
+		method string
+		query  string
+		header http.Header
+		body   string
+	}
+	var got request
+	var status int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		got = request{
+			method: r.Method,
+			query:  r.URL.RawQuery,
+			header: r.Header,
+			body:   string(body),
+		}
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	data := func() flux.Table {
+		return executetest.MustCopyTable(&executetest.Table{
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "host", Type: flux.TString},
+				{Label: "_value", Type: flux.TFloat},
+			},
+			Data: [][]interface{}{
+				{execute.Time(0), "a", 1.5},
+				{execute.Time(int64(time.Second)), "b,c", nil},
+			},
+		})
+	}
+	testCases := []struct {
+		name    string
+		spec    *fhttp.ToHTTPOpSpec
+		status  int
+		want    request
+		wantErr bool
+	}{
+		{
+			name: "json with bearer token and url params",
+			spec: &fhttp.ToHTTPOpSpec{
+				Method:    "POST",
+				Headers:   map[string]string{"Content-Type": "application/json"},
+				URLParams: map[string]string{"channel": "alerts"},
+				Token:     "secret",
+				Format:    "json",
+			},
+			want: request{
+				method: "POST",
+				query:  "channel=alerts",
+				header: http.Header{
+					"Authorization": {"Bearer secret"},
+					"Content-Type":  {"application/json"},
+				},
+				body: `[{"_time":"1970-01-01T00:00:00Z","host":"a","_value":1.5},{"_time":"1970-01-01T00:00:01Z","host":"b,c","_value":null}]` + "\n",
+			},
+		},
+		{
+			name: "csv with basic auth",
+			spec: &fhttp.ToHTTPOpSpec{
+				Method:   "PUT",
+				Headers:  map[string]string{"Content-Type": "text/csv"},
+				Username: "user",
+				Password: "pass",
+				Format:   "csv",
+			},
+			want: request{
+				method: "PUT",
+				header: http.Header{
+					"Authorization": {"Basic dXNlcjpwYXNz"},
+					"Content-Type":  {"text/csv"},
+				},
+				body: "_time,host,_value\n1970-01-01T00:00:00Z,a,1.5\n1970-01-01T00:00:01Z,\"b,c\",\n",
+			},
+		},
+		{
+			name:    "error status",
+			spec:    &fhttp.ToHTTPOpSpec{Method: "POST", Format: "json"},
+			status:  http.StatusUnauthorized,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			status = http.StatusOK
+			if tc.status != 0 {
+				status = tc.status
+			}
+			tc.spec.URL = server.URL
+			tc.spec.Timeout = 10 * time.Second
+			d := executetest.NewDataset(executetest.RandomDatasetID())
+			c := execute.NewTableBuilderCache(executetest.UnlimitedAllocator)
+			c.SetTriggerSpec(plan.DefaultTriggerSpec)
+			tr := fhttp.NewToHTTPTransformation(d, c, &fhttp.ToHTTPProcedureSpec{Spec: tc.spec})
+			err := tr.Process(executetest.RandomDatasetID(), data())
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.method != tc.want.method {
+				t.Errorf("unexpected method: want %s, got %s", tc.want.method, got.method)
+			}
+			if got.query != tc.want.query {
+				t.Errorf("unexpected query: want %q, got %q", tc.want.query, got.query)
+			}
+			for k, v := range tc.want.header {
+				if !cmp.Equal(v, got.header[k]) {
+					t.Errorf("unexpected %s header: want %v, got %v", k, v, got.header[k])
+				}
+			}
+			if got.body != tc.want.body {
+				t.Errorf("unexpected body -want/+got\n%s", cmp.Diff(tc.want.body, got.body))
+			}
+		})
+	}
+}
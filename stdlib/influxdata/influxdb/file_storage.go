@@ -0,0 +1,666 @@
+package influxdb
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cespare/xxhash"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const seriesFileExt = ".series"
+
+// FileStorage is a reference Storage implementation that keeps
+// every series in its own file on the local file system.
+//
+// The directory layout is <dir>/<bucket>/<measurement>/<series>.series,
+// where the bucket and measurement names are hex encoded so that no name
+// can resolve outside of dir, and the series is the hash of its key.
+// The first line of a series file is a JSON header describing the series
+// and every following line holds a single timestamp and value.
+type FileStorage struct {
+	dir string
+	mu  sync.RWMutex
+}
+
+// NewFileStorage creates a FileStorage rooted at dir.
+func NewFileStorage(dir string) *FileStorage {
+	return &FileStorage{dir: dir}
+}
+
+// seriesHeader is the first line of each series file.
+type seriesHeader struct {
+	Measurement string `json:"measurement"`
+	Tags        []Tag  `json:"tags"`
+	Field       string `json:"field"`
+	Type        string `json:"type"`
+}
+
+func (h *seriesHeader) key() string {
+	var b strings.Builder
+	b.WriteString(h.Measurement)
+	for _, t := range h.Tags {
+		b.WriteByte(',')
+		b.WriteString(t.Key)
+		b.WriteByte('=')
+		b.WriteString(t.Value)
+	}
+	b.WriteByte('#')
+	b.WriteString(h.Field)
+	return b.String()
+}
+
+// tag returns the value of a tag of the series.
+// The measurement and field are available as _measurement and _field.
+func (h *seriesHeader) tag(key string) (string, bool) {
+	switch key {
+	case "_measurement":
+		return h.Measurement, true
+	case "_field":
+		return h.Field, true
+	}
+	for _, t := range h.Tags {
+		if t.Key == key {
+			return t.Value, true
+		}
+	}
+	return "", false
+}
+
+func (h *seriesHeader) colType() flux.ColType {
+	switch h.Type {
+	case "float":
+		return flux.TFloat
+	case "int":
+		return flux.TInt
+	case "uint":
+		return flux.TUInt
+	case "string":
+		return flux.TString
+	case "bool":
+		return flux.TBool
+	default:
+		return flux.TInvalid
+	}
+}
+
+type seriesFile struct {
+	path   string
+	header seriesHeader
+}
+
+type seriesPoint struct {
+	time  values.Time
+	value values.Value
+}
+
+func (s *FileStorage) bucketDir(bucket string) string {
+	return filepath.Join(s.dir, hex.EncodeToString([]byte(bucket)))
+}
+
+func (s *FileStorage) seriesPath(bucket string, h *seriesHeader) string {
+	sum := xxhash.Sum64String(h.key())
+	var buf [8]byte
+	for i := range buf {
+		buf[i] = byte(sum >> (8 * uint(7-i)))
+	}
+	return filepath.Join(s.bucketDir(bucket), hex.EncodeToString([]byte(h.Measurement)), hex.EncodeToString(buf[:])+seriesFileExt)
+}
+
+// Write appends the points to the series files of the bucket.
+// The bucket is created if it does not exist.
+func (s *FileStorage) Write(ctx context.Context, bucket string, points []Point) error {
+	if bucket == "" {
+		return errors.New(codes.Invalid, "bucket name must not be empty")
+	}
+
+	// Group the lines per series file so each file is opened only once.
+	files := make(map[string]*seriesWrite)
+	var order []string
+	for _, p := range points {
+		if p.Measurement == "" {
+			return errors.New(codes.Invalid, "point is missing a measurement")
+		}
+		for _, f := range p.Fields {
+			typ, err := fieldType(f.Value)
+			if err != nil {
+				return errors.Wrapf(err, codes.Inherit, "field %q", f.Key)
+			}
+			h := seriesHeader{
+				Measurement: p.Measurement,
+				Tags:        p.Tags,
+				Field:       f.Key,
+				Type:        typ,
+			}
+			path := s.seriesPath(bucket, &h)
+			w, ok := files[path]
+			if !ok {
+				w = &seriesWrite{header: h}
+				files[path] = w
+				order = append(order, path)
+			} else if w.header.Type != typ {
+				return errors.Newf(codes.Invalid, "field type conflict for %q: %s != %s", f.Key, typ, w.header.Type)
+			}
+			w.lines = append(w.lines, encodeLine(p.Time, f.Value))
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, path := range order {
+		if err := files[path].write(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type seriesWrite struct {
+	header seriesHeader
+	lines  []string
+}
+
+func (w *seriesWrite) write(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	if existing, err := readSeriesHeader(path); err == nil {
+		if existing.Type != w.header.Type {
+			return errors.Newf(codes.Invalid, "field type conflict for %q: %s != %s", w.header.Field, w.header.Type, existing.Type)
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	} else {
+		// New series, write the header first.
+		header, err := json.Marshal(w.header)
+		if err != nil {
+			return err
+		}
+		w.lines = append([]string{string(header)}, w.lines...)
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(f)
+	for _, l := range w.lines {
+		if _, err := bw.WriteString(l); err != nil {
+			_ = f.Close()
+			return err
+		}
+		if err := bw.WriteByte('\n'); err != nil {
+			_ = f.Close()
+			return err
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Read returns the tables of the series in the bucket that match the read spec.
+// Without grouping every series with points in the bounds is returned as its own table.
+func (s *FileStorage) Read(ctx context.Context, spec ReadSpec, alloc *memory.Allocator) (flux.TableIterator, error) {
+	switch spec.GroupMode {
+	case flux.GroupModeNone, flux.GroupModeBy:
+	default:
+		return nil, errors.Newf(codes.Unimplemented, "unsupported group mode %v", spec.GroupMode)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	series, err := s.listSeries(spec.Bucket)
+	if err != nil {
+		return nil, err
+	}
+	if spec.Predicate != nil {
+		matched := series[:0]
+		for _, sf := range series {
+			if spec.Predicate.Matches(sf.header.tag) {
+				matched = append(matched, sf)
+			}
+		}
+		series = matched
+	}
+	return &fileTableIterator{
+		ctx:    ctx,
+		s:      s,
+		spec:   spec,
+		series: series,
+		alloc:  alloc,
+	}, nil
+}
+
+// listSeries reads the header of every series in the bucket
+// and returns them sorted by series key.
+func (s *FileStorage) listSeries(bucket string) ([]seriesFile, error) {
+	dir := s.bucketDir(bucket)
+	measurements, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.Newf(codes.NotFound, "bucket %q not found", bucket)
+		}
+		return nil, err
+	}
+
+	var series []seriesFile
+	for _, m := range measurements {
+		if !m.IsDir() {
+			continue
+		}
+		files, err := ioutil.ReadDir(filepath.Join(dir, m.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			if f.IsDir() || filepath.Ext(f.Name()) != seriesFileExt {
+				continue
+			}
+			path := filepath.Join(dir, m.Name(), f.Name())
+			h, err := readSeriesHeader(path)
+			if err != nil {
+				return nil, err
+			}
+			series = append(series, seriesFile{path: path, header: *h})
+		}
+	}
+	sort.Slice(series, func(i, j int) bool {
+		return series[i].header.key() < series[j].header.key()
+	})
+	return series, nil
+}
+
+func readSeriesHeader(path string) (*seriesHeader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	line, err := bufio.NewReader(f).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	h := new(seriesHeader)
+	if err := json.Unmarshal([]byte(line), h); err != nil {
+		return nil, errors.Wrapf(err, codes.Internal, "corrupt series file %s", path)
+	}
+	return h, nil
+}
+
+// readPoints reads all points of the series sorted by time.
+// When several points share a timestamp the last one written wins.
+func readPoints(sf *seriesFile) ([]seriesPoint, error) {
+	f, err := os.Open(sf.path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	typ := sf.header.colType()
+	if typ == flux.TInvalid {
+		return nil, errors.Newf(codes.Internal, "unknown field type %q in series file %s", sf.header.Type, sf.path)
+	}
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024*1024)
+	// Skip the header.
+	scanner.Scan()
+
+	var points []seriesPoint
+	for scanner.Scan() {
+		p, err := decodeLine(scanner.Text(), typ)
+		if err != nil {
+			return nil, errors.Wrapf(err, codes.Internal, "corrupt series file %s", sf.path)
+		}
+		points = append(points, p)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(points, func(i, j int) bool {
+		return points[i].time < points[j].time
+	})
+	deduped := points[:0]
+	for i, p := range points {
+		if i+1 < len(points) && points[i+1].time == p.time {
+			continue
+		}
+		deduped = append(deduped, p)
+	}
+	return deduped, nil
+}
+
+func fieldType(v values.Value) (string, error) {
+	switch v.Type() {
+	case semantic.Float:
+		return "float", nil
+	case semantic.Int:
+		return "int", nil
+	case semantic.UInt:
+		return "uint", nil
+	case semantic.String:
+		return "string", nil
+	case semantic.Bool:
+		return "bool", nil
+	default:
+		return "", errors.Newf(codes.Invalid, "unsupported field type %v", v.Type())
+	}
+}
+
+func encodeLine(t values.Time, v values.Value) string {
+	var s string
+	switch v.Type() {
+	case semantic.Float:
+		s = strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	case semantic.Int:
+		s = strconv.FormatInt(v.Int(), 10)
+	case semantic.UInt:
+		s = strconv.FormatUint(v.UInt(), 10)
+	case semantic.String:
+		s = strconv.Quote(v.Str())
+	case semantic.Bool:
+		s = strconv.FormatBool(v.Bool())
+	}
+	return strconv.FormatInt(int64(t), 10) + " " + s
+}
+
+func decodeLine(line string, typ flux.ColType) (seriesPoint, error) {
+	i := strings.IndexByte(line, ' ')
+	if i < 0 {
+		return seriesPoint{}, fmt.Errorf("invalid line %q", line)
+	}
+	ts, err := strconv.ParseInt(line[:i], 10, 64)
+	if err != nil {
+		return seriesPoint{}, err
+	}
+	p := seriesPoint{time: values.Time(ts)}
+	s := line[i+1:]
+	switch typ {
+	case flux.TFloat:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return seriesPoint{}, err
+		}
+		p.value = values.NewFloat(f)
+	case flux.TInt:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return seriesPoint{}, err
+		}
+		p.value = values.NewInt(n)
+	case flux.TUInt:
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return seriesPoint{}, err
+		}
+		p.value = values.NewUInt(n)
+	case flux.TString:
+		str, err := strconv.Unquote(s)
+		if err != nil {
+			return seriesPoint{}, err
+		}
+		p.value = values.NewString(str)
+	case flux.TBool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return seriesPoint{}, err
+		}
+		p.value = values.NewBool(b)
+	}
+	return p, nil
+}
+
+type fileTableIterator struct {
+	ctx    context.Context
+	s      *FileStorage
+	spec   ReadSpec
+	series []seriesFile
+	alloc  *memory.Allocator
+}
+
+func (fi *fileTableIterator) Do(f func(flux.Table) error) error {
+	if fi.spec.GroupMode == flux.GroupModeBy {
+		return fi.doGroups(f)
+	}
+	for i := range fi.series {
+		if err := fi.ctx.Err(); err != nil {
+			return err
+		}
+		points, err := fi.readPoints(&fi.series[i])
+		if err != nil {
+			return err
+		}
+		if len(points) == 0 {
+			continue
+		}
+		if fi.spec.LimitSet {
+			points = limitPoints(points, fi.spec.PointsLimit, fi.spec.PointsOffset)
+		}
+		tbl, err := fi.buildTable(&fi.series[i], points)
+		if err != nil {
+			return err
+		}
+		if err := f(tbl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// doGroups merges the series into tables the same way the group transformation does.
+func (fi *fileTableIterator) doGroups(f func(flux.Table) error) error {
+	on := make(map[string]bool, len(fi.spec.GroupKeys))
+	for _, k := range fi.spec.GroupKeys {
+		on[k] = true
+	}
+
+	groups := execute.NewGroupLookup()
+	for i := range fi.series {
+		if err := fi.ctx.Err(); err != nil {
+			return err
+		}
+		points, err := fi.readPoints(&fi.series[i])
+		if err != nil {
+			return err
+		}
+		if len(points) == 0 {
+			continue
+		}
+		tbl, err := fi.buildTable(&fi.series[i], points)
+		if err != nil {
+			return err
+		}
+
+		seriesKey := tbl.Key()
+		var cols []flux.ColMeta
+		var vs []values.Value
+		for j, c := range seriesKey.Cols() {
+			if on[c.Label] {
+				cols = append(cols, c)
+				vs = append(vs, seriesKey.Value(j))
+			}
+		}
+		key := execute.NewGroupKey(cols, vs)
+
+		var builder *execute.ColListTableBuilder
+		if b, ok := groups.Lookup(key); ok {
+			builder = b.(*execute.ColListTableBuilder)
+		} else {
+			builder = execute.NewColListTableBuilder(key, fi.alloc)
+			groups.Set(key, builder)
+		}
+		colMap, err := execute.AddNewTableCols(tbl, builder, nil)
+		if err != nil {
+			return err
+		}
+		if err := tbl.Do(func(cr flux.ColReader) error {
+			for i := 0; i < cr.Len(); i++ {
+				if err := execute.AppendMappedRecordWithNulls(i, cr, builder, colMap); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	groups.Range(func(key flux.GroupKey, value interface{}) {
+		if err != nil {
+			return
+		}
+		builder := value.(*execute.ColListTableBuilder)
+		var tbl flux.Table
+		if tbl, err = builder.Table(); err != nil {
+			return
+		}
+		if fi.spec.LimitSet {
+			if tbl, err = limitTable(tbl, fi.spec.PointsLimit, fi.spec.PointsOffset, fi.alloc); err != nil {
+				return
+			}
+		}
+		err = f(tbl)
+	})
+	return err
+}
+
+// readPoints reads the points of the series that are within the bounds of the read spec.
+func (fi *fileTableIterator) readPoints(sf *seriesFile) ([]seriesPoint, error) {
+	fi.s.mu.RLock()
+	points, err := readPoints(sf)
+	fi.s.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	if b := fi.spec.Bounds; b != nil {
+		start := sort.Search(len(points), func(i int) bool {
+			return points[i].time >= b.Start
+		})
+		stop := sort.Search(len(points), func(i int) bool {
+			return points[i].time >= b.Stop
+		})
+		points = points[start:stop]
+	}
+	return points, nil
+}
+
+func limitPoints(points []seriesPoint, n, offset int64) []seriesPoint {
+	if offset >= int64(len(points)) {
+		return nil
+	}
+	points = points[offset:]
+	if n < int64(len(points)) {
+		points = points[:n]
+	}
+	return points
+}
+
+// limitTable returns a table with at most n rows of tbl after skipping offset rows.
+func limitTable(tbl flux.Table, n, offset int64, alloc *memory.Allocator) (flux.Table, error) {
+	builder := execute.NewColListTableBuilder(tbl.Key(), alloc)
+	if err := execute.AddTableCols(tbl, builder); err != nil {
+		return nil, err
+	}
+	var row int64
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		for i := 0; i < cr.Len(); i++ {
+			if row >= offset && row < offset+n {
+				if err := execute.AppendRecord(i, cr, builder); err != nil {
+					return err
+				}
+			}
+			row++
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return builder.Table()
+}
+
+func (fi *fileTableIterator) buildTable(sf *seriesFile, points []seriesPoint) (flux.Table, error) {
+	h := &sf.header
+	var keyCols []flux.ColMeta
+	var keyValues []values.Value
+	if b := fi.spec.Bounds; b != nil {
+		keyCols = append(keyCols,
+			flux.ColMeta{Label: execute.DefaultStartColLabel, Type: flux.TTime},
+			flux.ColMeta{Label: execute.DefaultStopColLabel, Type: flux.TTime},
+		)
+		keyValues = append(keyValues, values.NewTime(b.Start), values.NewTime(b.Stop))
+	}
+	keyCols = append(keyCols,
+		flux.ColMeta{Label: "_field", Type: flux.TString},
+		flux.ColMeta{Label: "_measurement", Type: flux.TString},
+	)
+	keyValues = append(keyValues,
+		values.NewString(h.Field),
+		values.NewString(h.Measurement),
+	)
+	for _, t := range h.Tags {
+		keyCols = append(keyCols, flux.ColMeta{Label: t.Key, Type: flux.TString})
+		keyValues = append(keyValues, values.NewString(t.Value))
+	}
+	key := execute.NewGroupKey(keyCols, keyValues)
+
+	builder := execute.NewColListTableBuilder(key, fi.alloc)
+	// Like range, the _start and _stop columns come first.
+	nBounds := 0
+	if fi.spec.Bounds != nil {
+		nBounds = 2
+	}
+	for _, c := range keyCols[:nBounds] {
+		if _, err := builder.AddCol(c); err != nil {
+			return nil, err
+		}
+	}
+	timeIdx, err := builder.AddCol(flux.ColMeta{Label: execute.DefaultTimeColLabel, Type: flux.TTime})
+	if err != nil {
+		return nil, err
+	}
+	valueIdx, err := builder.AddCol(flux.ColMeta{Label: execute.DefaultValueColLabel, Type: h.colType()})
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range keyCols[nBounds:] {
+		if _, err := builder.AddCol(c); err != nil {
+			return nil, err
+		}
+	}
+	for _, p := range points {
+		if err := builder.AppendTime(timeIdx, p.time); err != nil {
+			return nil, err
+		}
+		if err := builder.AppendValue(valueIdx, p.value); err != nil {
+			return nil, err
+		}
+	}
+	if err := execute.AppendKeyValuesN(key, builder, len(points)); err != nil {
+		return nil, err
+	}
+	return builder.Table()
+}
@@ -0,0 +1,368 @@
+package influxdb_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
+	"github.com/influxdata/flux/values"
+)
+
+func newTestStorage(t *testing.T) (*influxdb.FileStorage, func()) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "flux-influxdb-storage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return influxdb.NewFileStorage(dir), func() { _ = os.RemoveAll(dir) }
+}
+
+func readTables(t *testing.T, s influxdb.Reader, spec influxdb.ReadSpec) []*executetest.Table {
+	t.Helper()
+	tables, err := s.Read(context.Background(), spec, executetest.UnlimitedAllocator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []*executetest.Table
+	if err := tables.Do(func(tbl flux.Table) error {
+		et, err := executetest.ConvertTable(tbl)
+		if err != nil {
+			return err
+		}
+		got = append(got, et)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	executetest.NormalizeTables(got)
+	return got
+}
+
+func TestFileStorage_WriteRead(t *testing.T) {
+	s, cleanup := newTestStorage(t)
+	defer cleanup()
+
+	points := []influxdb.Point{
+		{
+			Measurement: "cpu",
+			Tags:        []influxdb.Tag{{Key: "host", Value: "a"}},
+			Fields: []influxdb.Field{
+				{Key: "usage", Value: values.NewFloat(2.5)},
+				{Key: "cores", Value: values.NewInt(4)},
+			},
+			Time: values.Time(20),
+		},
+		{
+			Measurement: "cpu",
+			Tags:        []influxdb.Tag{{Key: "host", Value: "a"}},
+			Fields:      []influxdb.Field{{Key: "usage", Value: values.NewFloat(1.5)}},
+			Time:        values.Time(10),
+		},
+		{
+			Measurement: "cpu",
+			Tags:        []influxdb.Tag{{Key: "host", Value: "b"}},
+			Fields:      []influxdb.Field{{Key: "usage", Value: values.NewFloat(3)}},
+			Time:        values.Time(10),
+		},
+		{
+			Measurement: "log",
+			Fields:      []influxdb.Field{{Key: "msg", Value: values.NewString("a \"quoted\"\nline")}},
+			Time:        values.Time(10),
+		},
+	}
+	if err := s.Write(context.Background(), "telegraf", points); err != nil {
+		t.Fatal(err)
+	}
+	// Overwrite a point and append to an existing series.
+	if err := s.Write(context.Background(), "telegraf", []influxdb.Point{{
+		Measurement: "cpu",
+		Tags:        []influxdb.Tag{{Key: "host", Value: "a"}},
+		Fields:      []influxdb.Field{{Key: "usage", Value: values.NewFloat(5)}},
+		Time:        values.Time(20),
+	}}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*executetest.Table{
+		{
+			KeyCols: []string{"_field", "_measurement", "host"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TInt},
+				{Label: "_field", Type: flux.TString},
+				{Label: "_measurement", Type: flux.TString},
+				{Label: "host", Type: flux.TString},
+			},
+			Data: [][]interface{}{
+				{execute.Time(20), int64(4), "cores", "cpu", "a"},
+			},
+		},
+		{
+			KeyCols: []string{"_field", "_measurement", "host"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+				{Label: "_field", Type: flux.TString},
+				{Label: "_measurement", Type: flux.TString},
+				{Label: "host", Type: flux.TString},
+			},
+			Data: [][]interface{}{
+				{execute.Time(10), 1.5, "usage", "cpu", "a"},
+				{execute.Time(20), 5.0, "usage", "cpu", "a"},
+			},
+		},
+		{
+			KeyCols: []string{"_field", "_measurement", "host"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+				{Label: "_field", Type: flux.TString},
+				{Label: "_measurement", Type: flux.TString},
+				{Label: "host", Type: flux.TString},
+			},
+			Data: [][]interface{}{
+				{execute.Time(10), 3.0, "usage", "cpu", "b"},
+			},
+		},
+		{
+			KeyCols: []string{"_field", "_measurement"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TString},
+				{Label: "_field", Type: flux.TString},
+				{Label: "_measurement", Type: flux.TString},
+			},
+			Data: [][]interface{}{
+				{execute.Time(10), "a \"quoted\"\nline", "msg", "log"},
+			},
+		},
+	}
+
+	got := readTables(t, s, influxdb.ReadSpec{Bucket: "telegraf"})
+	executetest.NormalizeTables(want)
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestFileStorage_Errors(t *testing.T) {
+	s, cleanup := newTestStorage(t)
+	defer cleanup()
+
+	if _, err := s.Read(context.Background(), influxdb.ReadSpec{Bucket: "missing"}, executetest.UnlimitedAllocator); err == nil {
+		t.Error("expected error reading a missing bucket")
+	}
+
+	point := func(v values.Value) []influxdb.Point {
+		return []influxdb.Point{{
+			Measurement: "m",
+			Fields:      []influxdb.Field{{Key: "f", Value: v}},
+			Time:        values.Time(1),
+		}}
+	}
+	if err := s.Write(context.Background(), "b", point(values.NewFloat(1))); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Write(context.Background(), "b", point(values.NewString("x"))); err == nil {
+		t.Error("expected field type conflict error")
+	}
+	if err := s.Write(context.Background(), "b", point(values.NewTime(1))); err == nil {
+		t.Error("expected unsupported field type error")
+	}
+}
+
+func TestFileStorage_Names(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flux-influxdb-storage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	s := influxdb.NewFileStorage(filepath.Join(dir, "storage"))
+
+	// Names that are special to the file system stay inside of the storage directory.
+	points := []influxdb.Point{{
+		Measurement: "..",
+		Fields:      []influxdb.Field{{Key: "f", Value: values.NewFloat(1)}},
+		Time:        values.Time(1),
+	}}
+	for _, bucket := range []string{"..", ".", "a/../..", `a\b`} {
+		if err := s.Write(context.Background(), bucket, points); err != nil {
+			t.Fatal(err)
+		}
+		if got := readTables(t, s, influxdb.ReadSpec{Bucket: bucket}); len(got) != 1 {
+			t.Errorf("bucket %q: expected 1 table, got %d", bucket, len(got))
+		}
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].Name() != "storage" {
+		t.Errorf("expected only the storage directory in %s, got %v", dir, files)
+	}
+}
+
+func TestFileStorage_ReadSpec(t *testing.T) {
+	s, cleanup := newTestStorage(t)
+	defer cleanup()
+
+	var points []influxdb.Point
+	for _, host := range []string{"a", "b", "c"} {
+		for i := 1; i <= 4; i++ {
+			points = append(points, influxdb.Point{
+				Measurement: "cpu",
+				Tags:        []influxdb.Tag{{Key: "host", Value: host}},
+				Fields:      []influxdb.Field{{Key: "usage", Value: values.NewFloat(float64(i))}},
+				Time:        values.Time(i * 10),
+			})
+		}
+	}
+	points = append(points, influxdb.Point{
+		Measurement: "mem",
+		Fields:      []influxdb.Field{{Key: "free", Value: values.NewInt(1)}},
+		Time:        values.Time(20),
+	})
+	if err := s.Write(context.Background(), "b", points); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name string
+		spec influxdb.ReadSpec
+		want []*executetest.Table
+	}{
+		{
+			name: "bounds and predicate",
+			spec: influxdb.ReadSpec{
+				Bucket: "b",
+				Bounds: &execute.Bounds{Start: 15, Stop: 40},
+				Predicate: &influxdb.Predicate{
+					Op: influxdb.PredicateOpAnd,
+					Children: []*influxdb.Predicate{
+						{Op: influxdb.PredicateOpEqual, Key: "_measurement", Value: "cpu"},
+						{Op: influxdb.PredicateOpNotEqual, Key: "host", Value: "a"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"_start", "_stop", "_field", "_measurement", "host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_start", Type: flux.TTime},
+						{Label: "_stop", Type: flux.TTime},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "_field", Type: flux.TString},
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(15), execute.Time(40), execute.Time(20), 2.0, "usage", "cpu", "b"},
+						{execute.Time(15), execute.Time(40), execute.Time(30), 3.0, "usage", "cpu", "b"},
+					},
+				},
+				{
+					KeyCols: []string{"_start", "_stop", "_field", "_measurement", "host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_start", Type: flux.TTime},
+						{Label: "_stop", Type: flux.TTime},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "_field", Type: flux.TString},
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(15), execute.Time(40), execute.Time(20), 2.0, "usage", "cpu", "c"},
+						{execute.Time(15), execute.Time(40), execute.Time(30), 3.0, "usage", "cpu", "c"},
+					},
+				},
+			},
+		},
+		{
+			name: "limit per series",
+			spec: influxdb.ReadSpec{
+				Bucket:       "b",
+				Predicate:    &influxdb.Predicate{Op: influxdb.PredicateOpEqual, Key: "host", Value: "a"},
+				LimitSet:     true,
+				PointsLimit:  2,
+				PointsOffset: 1,
+			},
+			want: []*executetest.Table{{
+				KeyCols: []string{"_field", "_measurement", "host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "_field", Type: flux.TString},
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "host", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(20), 2.0, "usage", "cpu", "a"},
+					{execute.Time(30), 3.0, "usage", "cpu", "a"},
+				},
+			}},
+		},
+		{
+			name: "group by measurement with limit",
+			spec: influxdb.ReadSpec{
+				Bucket:      "b",
+				Bounds:      &execute.Bounds{Start: 0, Stop: 25},
+				GroupMode:   flux.GroupModeBy,
+				GroupKeys:   []string{"_measurement"},
+				LimitSet:    true,
+				PointsLimit: 3,
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"_measurement"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_start", Type: flux.TTime},
+						{Label: "_stop", Type: flux.TTime},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "_field", Type: flux.TString},
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(0), execute.Time(25), execute.Time(10), 1.0, "usage", "cpu", "a"},
+						{execute.Time(0), execute.Time(25), execute.Time(20), 2.0, "usage", "cpu", "a"},
+						{execute.Time(0), execute.Time(25), execute.Time(10), 1.0, "usage", "cpu", "b"},
+					},
+				},
+				{
+					KeyCols: []string{"_measurement"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_start", Type: flux.TTime},
+						{Label: "_stop", Type: flux.TTime},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TInt},
+						{Label: "_field", Type: flux.TString},
+						{Label: "_measurement", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(0), execute.Time(25), execute.Time(20), int64(1), "free", "mem"},
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got := readTables(t, s, tc.spec)
+			executetest.NormalizeTables(tc.want)
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
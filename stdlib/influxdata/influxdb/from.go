@@ -1,13 +1,19 @@
-// From is an operation that mocks the real implementation of InfluxDB's from.
-// It is used in Flux to compile queries that resemble real queries issued against InfluxDB.
-// Implementors of the real from are expected to replace its implementation via flux.ReplacePackageValue.
+// From is an operation that reads series data from a bucket.
+// Once RegisterStorage is called, the data is read from the Storage provided through the execute.Dependencies of the query.
+// Implementors of the real from may still replace its implementation via flux.ReplacePackageValue.
 package influxdb
 
 import (
+	"context"
 	"fmt"
+
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/memory"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	"github.com/pkg/errors"
 )
 
 const FromKind = "from"
@@ -51,6 +57,20 @@ func (s *FromOpSpec) Kind() flux.OperationKind {
 type FromProcedureSpec struct {
 	plan.DefaultCost
 	Bucket string
+
+	BoundsSet bool
+	Bounds    flux.Bounds
+
+	FilterSet bool
+	Filter    *Predicate
+
+	GroupingSet bool
+	GroupMode   flux.GroupMode
+	GroupKeys   []string
+
+	LimitSet     bool
+	PointsLimit  int64
+	PointsOffset int64
 }
 
 func newFromProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -71,5 +91,126 @@ func (s *FromProcedureSpec) Kind() plan.ProcedureKind {
 func (s *FromProcedureSpec) Copy() plan.ProcedureSpec {
 	ns := new(FromProcedureSpec)
 	*ns = *s
+	if s.GroupKeys != nil {
+		ns.GroupKeys = make([]string, len(s.GroupKeys))
+		copy(ns.GroupKeys, s.GroupKeys)
+	}
 	return ns
 }
+
+// TimeBounds implements plan.BoundsAwareProcedureSpec.
+func (s *FromProcedureSpec) TimeBounds(predecessorBounds *plan.Bounds) *plan.Bounds {
+	if !s.BoundsSet {
+		return nil
+	}
+	return &plan.Bounds{
+		Start: values.ConvertTime(s.Bounds.Start.Time(s.Bounds.Now)),
+		Stop:  values.ConvertTime(s.Bounds.Stop.Time(s.Bounds.Now)),
+	}
+}
+
+func createFromSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
+	spec, ok := prSpec.(*FromProcedureSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", prSpec)
+	}
+	if spec.Bucket == "" {
+		return nil, errors.New("bucket must be specified")
+	}
+
+	s, err := GetStorage(a.Dependencies())
+	if err != nil {
+		return nil, err
+	}
+	return NewFromSource(spec, s, a.Allocator(), dsid), nil
+}
+
+// NewFromSource creates a source that reads the tables described by spec from the storage reader.
+func NewFromSource(spec *FromProcedureSpec, r Reader, alloc *memory.Allocator, dsid execute.DatasetID) execute.Source {
+	return &fromSource{
+		id:    dsid,
+		spec:  spec,
+		r:     r,
+		alloc: alloc,
+	}
+}
+
+type fromSource struct {
+	id    execute.DatasetID
+	spec  *FromProcedureSpec
+	r     Reader
+	alloc *memory.Allocator
+	ts    []execute.Transformation
+}
+
+func (s *fromSource) AddTransformation(t execute.Transformation) {
+	s.ts = append(s.ts, t)
+}
+
+func (s *fromSource) Run(ctx context.Context) {
+	var err error
+	for _, t := range s.ts {
+		// Tables can be read only once, so each downstream
+		// transformation receives the result of its own read.
+		if err = s.run(ctx, t); err != nil {
+			break
+		}
+	}
+	for _, t := range s.ts {
+		t.Finish(s.id, errors.Wrap(err, "error in influxdb.from()"))
+	}
+}
+
+func (s *fromSource) run(ctx context.Context, t execute.Transformation) error {
+	tables, err := s.r.Read(ctx, s.readSpec(), s.alloc)
+	if err != nil {
+		return err
+	}
+
+	var max execute.Time
+	maxSet := false
+	if err := tables.Do(func(tbl flux.Table) error {
+		if err := t.Process(s.id, tbl); err != nil {
+			return err
+		}
+		if idx := execute.ColIdx(execute.DefaultStopColLabel, tbl.Key().Cols()); idx >= 0 {
+			if stop := tbl.Key().ValueTime(idx); !maxSet || stop > max {
+				max = stop
+				maxSet = true
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if maxSet {
+		return t.UpdateWatermark(s.id, max)
+	}
+	return nil
+}
+
+func (s *fromSource) readSpec() ReadSpec {
+	spec := ReadSpec{
+		Bucket: s.spec.Bucket,
+	}
+	if s.spec.BoundsSet {
+		b := s.spec.TimeBounds(nil)
+		spec.Bounds = &execute.Bounds{
+			Start: b.Start,
+			Stop:  b.Stop,
+		}
+	}
+	if s.spec.FilterSet {
+		spec.Predicate = s.spec.Filter
+	}
+	if s.spec.GroupingSet {
+		spec.GroupMode = s.spec.GroupMode
+		spec.GroupKeys = s.spec.GroupKeys
+	}
+	if s.spec.LimitSet {
+		spec.LimitSet = true
+		spec.PointsLimit = s.spec.PointsLimit
+		spec.PointsOffset = s.spec.PointsOffset
+	}
+	return spec
+}
@@ -1,16 +1,25 @@
 package influxdb_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/querytest"
 	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
 	"github.com/influxdata/flux/stdlib/universe"
+	"github.com/influxdata/flux/values"
 )
 
+func init() {
+	influxdb.RegisterStorage()
+}
+
 func TestFrom_NewQuery(t *testing.T) {
 	tests := []querytest.NewQueryTestCase{
 		{
@@ -82,3 +91,61 @@ func TestFrom_NewQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestFrom_Run(t *testing.T) {
+	s, cleanup := newTestStorage(t)
+	defer cleanup()
+
+	if err := s.Write(context.Background(), "mybucket", []influxdb.Point{
+		{
+			Measurement: "cpu",
+			Tags:        []influxdb.Tag{{Key: "host", Value: "a"}},
+			Fields:      []influxdb.Field{{Key: "usage", Value: values.NewFloat(1)}},
+			Time:        values.Time(1),
+		},
+		{
+			Measurement: "cpu",
+			Tags:        []influxdb.Tag{{Key: "host", Value: "a"}},
+			Fields:      []influxdb.Field{{Key: "usage", Value: values.NewFloat(2)}},
+			Time:        values.Time(2),
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	id := executetest.RandomDatasetID()
+	d := executetest.NewDataset(id)
+	c := execute.NewTableBuilderCache(executetest.UnlimitedAllocator)
+	c.SetTriggerSpec(plan.DefaultTriggerSpec)
+
+	src := influxdb.NewFromSource(&influxdb.FromProcedureSpec{Bucket: "mybucket"}, s, executetest.UnlimitedAllocator, id)
+	src.AddTransformation(executetest.NewYieldTransformation(d, c))
+	src.Run(context.Background())
+	if d.FinishedErr != nil {
+		t.Fatal(d.FinishedErr)
+	}
+
+	got, err := executetest.TablesFromCache(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*executetest.Table{{
+		KeyCols: []string{"_field", "_measurement", "host"},
+		ColMeta: []flux.ColMeta{
+			{Label: "_time", Type: flux.TTime},
+			{Label: "_value", Type: flux.TFloat},
+			{Label: "_field", Type: flux.TString},
+			{Label: "_measurement", Type: flux.TString},
+			{Label: "host", Type: flux.TString},
+		},
+		Data: [][]interface{}{
+			{execute.Time(1), 1.0, "usage", "cpu", "a"},
+			{execute.Time(2), 2.0, "usage", "cpu", "a"},
+		},
+	}}
+	executetest.NormalizeTables(got)
+	executetest.NormalizeTables(want)
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(want, got))
+	}
+}
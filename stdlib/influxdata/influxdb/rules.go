@@ -0,0 +1,249 @@
+package influxdb
+
+import (
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+// PushDownRangeRule merges a range into the preceding from
+// so that only the points within the bounds are read from storage.
+type PushDownRangeRule struct{}
+
+func (PushDownRangeRule) Name() string {
+	return "influxdb.PushDownRangeRule"
+}
+
+// Pattern matches from |> range
+func (PushDownRangeRule) Pattern() plan.Pattern {
+	return plan.Pat(universe.RangeKind, plan.Pat(FromKind))
+}
+
+func (PushDownRangeRule) Rewrite(node plan.Node) (plan.Node, bool, error) {
+	fromNode := node.Predecessors()[0]
+	fromSpec := fromNode.ProcedureSpec().(*FromProcedureSpec)
+	rangeSpec := node.ProcedureSpec().(*universe.RangeProcedureSpec)
+
+	// Range changes the group key of grouped tables and must not be applied
+	// before a limit, so it can only be pushed down before either of them.
+	if fromSpec.BoundsSet || fromSpec.GroupingSet || fromSpec.LimitSet {
+		return node, false, nil
+	}
+	// The storage only knows about the default columns.
+	if rangeSpec.TimeColumn != execute.DefaultTimeColLabel ||
+		rangeSpec.StartColumn != execute.DefaultStartColLabel ||
+		rangeSpec.StopColumn != execute.DefaultStopColLabel {
+		return node, false, nil
+	}
+
+	newFromSpec := fromSpec.Copy().(*FromProcedureSpec)
+	newFromSpec.BoundsSet = true
+	newFromSpec.Bounds = rangeSpec.Bounds
+	n, err := plan.MergeToPhysicalNode(node, fromNode, newFromSpec)
+	if err != nil {
+		return nil, false, err
+	}
+	return n, true, nil
+}
+
+// PushDownFilterRule merges a filter into the preceding from when its predicate
+// only compares tag values with string literals.
+// Comparisons may use == and != and be combined with and/or.
+type PushDownFilterRule struct{}
+
+func (PushDownFilterRule) Name() string {
+	return "influxdb.PushDownFilterRule"
+}
+
+// Pattern matches from |> filter
+func (PushDownFilterRule) Pattern() plan.Pattern {
+	return plan.Pat(universe.FilterKind, plan.Pat(FromKind))
+}
+
+func (PushDownFilterRule) Rewrite(node plan.Node) (plan.Node, bool, error) {
+	fromNode := node.Predecessors()[0]
+	fromSpec := fromNode.ProcedureSpec().(*FromProcedureSpec)
+	filterSpec := node.ProcedureSpec().(*universe.FilterProcedureSpec)
+
+	if fromSpec.LimitSet {
+		return node, false, nil
+	}
+	pred, ok := toPredicate(filterSpec.Fn)
+	if !ok {
+		return node, false, nil
+	}
+
+	newFromSpec := fromSpec.Copy().(*FromProcedureSpec)
+	if newFromSpec.FilterSet {
+		pred = &Predicate{
+			Op:       PredicateOpAnd,
+			Children: []*Predicate{newFromSpec.Filter, pred},
+		}
+	}
+	newFromSpec.FilterSet = true
+	newFromSpec.Filter = pred
+	n, err := plan.MergeToPhysicalNode(node, fromNode, newFromSpec)
+	if err != nil {
+		return nil, false, err
+	}
+	return n, true, nil
+}
+
+// toPredicate converts a filter function into a storage predicate.
+// It reports false if the function uses anything other than tag comparisons.
+func toPredicate(fn *semantic.FunctionExpression) (*Predicate, bool) {
+	if fn == nil || fn.Block == nil || fn.Block.Parameters == nil ||
+		len(fn.Block.Parameters.List) != 1 || fn.Defaults != nil {
+		return nil, false
+	}
+	body, ok := fn.Block.Body.(semantic.Expression)
+	if !ok {
+		return nil, false
+	}
+	return toPredicateNode(body, fn.Block.Parameters.List[0].Key.Name)
+}
+
+func toPredicateNode(e semantic.Expression, param string) (*Predicate, bool) {
+	switch e := e.(type) {
+	case *semantic.LogicalExpression:
+		left, ok := toPredicateNode(e.Left, param)
+		if !ok {
+			return nil, false
+		}
+		right, ok := toPredicateNode(e.Right, param)
+		if !ok {
+			return nil, false
+		}
+		op := PredicateOpAnd
+		if e.Operator == ast.OrOperator {
+			op = PredicateOpOr
+		}
+		return &Predicate{
+			Op:       op,
+			Children: []*Predicate{left, right},
+		}, true
+	case *semantic.BinaryExpression:
+		var op PredicateOp
+		switch e.Operator {
+		case ast.EqualOperator:
+			op = PredicateOpEqual
+		case ast.NotEqualOperator:
+			op = PredicateOpNotEqual
+		default:
+			return nil, false
+		}
+		key, ok := tagKey(e.Left, param)
+		lit, isLit := e.Right.(*semantic.StringLiteral)
+		if !ok || !isLit {
+			// Try the operands the other way around.
+			key, ok = tagKey(e.Right, param)
+			lit, isLit = e.Left.(*semantic.StringLiteral)
+			if !ok || !isLit {
+				return nil, false
+			}
+		}
+		return &Predicate{
+			Op:    op,
+			Key:   key,
+			Value: lit.Value,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+// tagKey returns the tag key if e is a member of the record parameter that refers to a tag.
+func tagKey(e semantic.Expression, param string) (string, bool) {
+	m, ok := e.(*semantic.MemberExpression)
+	if !ok {
+		return "", false
+	}
+	obj, ok := m.Object.(*semantic.IdentifierExpression)
+	if !ok || obj.Name != param {
+		return "", false
+	}
+	switch m.Property {
+	case execute.DefaultTimeColLabel,
+		execute.DefaultValueColLabel,
+		execute.DefaultStartColLabel,
+		execute.DefaultStopColLabel:
+		return "", false
+	}
+	return m.Property, true
+}
+
+// PushDownGroupRule merges a group into the preceding from
+// so that the storage returns the tables already regrouped.
+type PushDownGroupRule struct{}
+
+func (PushDownGroupRule) Name() string {
+	return "influxdb.PushDownGroupRule"
+}
+
+// Pattern matches from |> group
+func (PushDownGroupRule) Pattern() plan.Pattern {
+	return plan.Pat(universe.GroupKind, plan.Pat(FromKind))
+}
+
+func (PushDownGroupRule) Rewrite(node plan.Node) (plan.Node, bool, error) {
+	fromNode := node.Predecessors()[0]
+	fromSpec := fromNode.ProcedureSpec().(*FromProcedureSpec)
+	groupSpec := node.ProcedureSpec().(*universe.GroupProcedureSpec)
+
+	if fromSpec.LimitSet || groupSpec.GroupMode != flux.GroupModeBy {
+		return node, false, nil
+	}
+	// Grouping by a column that varies within a series can't be done by the storage.
+	for _, k := range groupSpec.GroupKeys {
+		if k == execute.DefaultTimeColLabel || k == execute.DefaultValueColLabel {
+			return node, false, nil
+		}
+	}
+
+	newFromSpec := fromSpec.Copy().(*FromProcedureSpec)
+	newFromSpec.GroupingSet = true
+	newFromSpec.GroupMode = groupSpec.GroupMode
+	newFromSpec.GroupKeys = make([]string, len(groupSpec.GroupKeys))
+	copy(newFromSpec.GroupKeys, groupSpec.GroupKeys)
+	n, err := plan.MergeToPhysicalNode(node, fromNode, newFromSpec)
+	if err != nil {
+		return nil, false, err
+	}
+	return n, true, nil
+}
+
+// PushDownLimitRule merges a limit into the preceding from.
+// Nothing else may be pushed down once the limit is set.
+type PushDownLimitRule struct{}
+
+func (PushDownLimitRule) Name() string {
+	return "influxdb.PushDownLimitRule"
+}
+
+// Pattern matches from |> limit
+func (PushDownLimitRule) Pattern() plan.Pattern {
+	return plan.Pat(universe.LimitKind, plan.Pat(FromKind))
+}
+
+func (PushDownLimitRule) Rewrite(node plan.Node) (plan.Node, bool, error) {
+	fromNode := node.Predecessors()[0]
+	fromSpec := fromNode.ProcedureSpec().(*FromProcedureSpec)
+	limitSpec := node.ProcedureSpec().(*universe.LimitProcedureSpec)
+
+	if fromSpec.LimitSet {
+		return node, false, nil
+	}
+
+	newFromSpec := fromSpec.Copy().(*FromProcedureSpec)
+	newFromSpec.LimitSet = true
+	newFromSpec.PointsLimit = limitSpec.N
+	newFromSpec.PointsOffset = limitSpec.Offset
+	n, err := plan.MergeToPhysicalNode(node, fromNode, newFromSpec)
+	if err != nil {
+		return nil, false, err
+	}
+	return n, true, nil
+}
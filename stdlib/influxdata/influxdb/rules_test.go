@@ -0,0 +1,197 @@
+package influxdb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/plan/plantest"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+func filterFn(body semantic.Expression) *semantic.FunctionExpression {
+	return &semantic.FunctionExpression{
+		Block: &semantic.FunctionBlock{
+			Parameters: &semantic.FunctionParameters{
+				List: []*semantic.FunctionParameter{{Key: &semantic.Identifier{Name: "r"}}},
+			},
+			Body: body,
+		},
+	}
+}
+
+func compare(op ast.OperatorKind, property string, value semantic.Expression) *semantic.BinaryExpression {
+	return &semantic.BinaryExpression{
+		Operator: op,
+		Left: &semantic.MemberExpression{
+			Object:   &semantic.IdentifierExpression{Name: "r"},
+			Property: property,
+		},
+		Right: value,
+	}
+}
+
+func TestPushDownRules(t *testing.T) {
+	now := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	bounds := flux.Bounds{
+		Start: flux.Time{IsRelative: true, Relative: -time.Hour},
+		Stop:  flux.Now,
+		Now:   now,
+	}
+	rangeSpec := func() *universe.RangeProcedureSpec {
+		return &universe.RangeProcedureSpec{
+			Bounds:      bounds,
+			TimeColumn:  "_time",
+			StartColumn: "_start",
+			StopColumn:  "_stop",
+		}
+	}
+	tagFilter := &universe.FilterProcedureSpec{
+		Fn: filterFn(&semantic.LogicalExpression{
+			Operator: ast.AndOperator,
+			Left:     compare(ast.EqualOperator, "_measurement", &semantic.StringLiteral{Value: "cpu"}),
+			Right: &semantic.LogicalExpression{
+				Operator: ast.OrOperator,
+				Left:     compare(ast.NotEqualOperator, "host", &semantic.StringLiteral{Value: "a"}),
+				Right:    compare(ast.EqualOperator, "_field", &semantic.StringLiteral{Value: "usage"}),
+			},
+		}),
+	}
+	tagPredicate := &influxdb.Predicate{
+		Op: influxdb.PredicateOpAnd,
+		Children: []*influxdb.Predicate{
+			{Op: influxdb.PredicateOpEqual, Key: "_measurement", Value: "cpu"},
+			{
+				Op: influxdb.PredicateOpOr,
+				Children: []*influxdb.Predicate{
+					{Op: influxdb.PredicateOpNotEqual, Key: "host", Value: "a"},
+					{Op: influxdb.PredicateOpEqual, Key: "_field", Value: "usage"},
+				},
+			},
+		},
+	}
+	valueFilter := &universe.FilterProcedureSpec{
+		Fn: filterFn(compare(ast.GreaterThanOperator, "_value", &semantic.FloatLiteral{Value: 1})),
+	}
+	groupSpec := &universe.GroupProcedureSpec{
+		GroupMode: flux.GroupModeBy,
+		GroupKeys: []string{"host"},
+	}
+	limitSpec := &universe.LimitProcedureSpec{N: 10, Offset: 2}
+	rules := []plan.Rule{
+		influxdb.PushDownRangeRule{},
+		influxdb.PushDownFilterRule{},
+		influxdb.PushDownGroupRule{},
+		influxdb.PushDownLimitRule{},
+	}
+
+	tests := []plantest.RuleTestCase{
+		{
+			Name:  "range filter group limit",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", &influxdb.FromProcedureSpec{Bucket: "b"}),
+					plan.CreatePhysicalNode("range", rangeSpec()),
+					plan.CreatePhysicalNode("filter", tagFilter),
+					plan.CreatePhysicalNode("group", groupSpec),
+					plan.CreatePhysicalNode("limit", limitSpec),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("merged_from_range_filter_group_limit", &influxdb.FromProcedureSpec{
+						Bucket:       "b",
+						BoundsSet:    true,
+						Bounds:       bounds,
+						FilterSet:    true,
+						Filter:       tagPredicate,
+						GroupingSet:  true,
+						GroupMode:    flux.GroupModeBy,
+						GroupKeys:    []string{"host"},
+						LimitSet:     true,
+						PointsLimit:  10,
+						PointsOffset: 2,
+					}),
+				},
+			},
+		},
+		{
+			Name:  "filter on value",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", &influxdb.FromProcedureSpec{Bucket: "b"}),
+					plan.CreatePhysicalNode("filter", valueFilter),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "range after limit",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", &influxdb.FromProcedureSpec{Bucket: "b"}),
+					plan.CreatePhysicalNode("limit", limitSpec),
+					plan.CreatePhysicalNode("range", rangeSpec()),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("merged_from_limit", &influxdb.FromProcedureSpec{
+						Bucket:       "b",
+						LimitSet:     true,
+						PointsLimit:  10,
+						PointsOffset: 2,
+					}),
+					plan.CreatePhysicalNode("range", rangeSpec()),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+		},
+		{
+			Name:  "group except",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", &influxdb.FromProcedureSpec{Bucket: "b"}),
+					plan.CreatePhysicalNode("group", &universe.GroupProcedureSpec{
+						GroupMode: flux.GroupModeExcept,
+						GroupKeys: []string{"_time", "_value"},
+					}),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "from with multiple successors",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", &influxdb.FromProcedureSpec{Bucket: "b"}),
+					plan.CreatePhysicalNode("range", rangeSpec()),
+					plan.CreatePhysicalNode("limit", limitSpec),
+				},
+				Edges: [][2]int{{0, 1}, {0, 2}},
+			},
+			NoChange: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			plantest.PhysicalRuleTestHelper(t, &tc)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package influxdb
+
+import (
+	"context"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/values"
+)
+
+// RegisterStorage registers the source of from and the transformation of to,
+// which read and write the Storage provided through the execute.Dependencies of a query,
+// along with the rules that push range, filter, group and limit down into from.
+// They are not registered by default, so that programs that embed flux can register
+// their own implementations. It must be called once, before any query is compiled.
+func RegisterStorage() {
+	execute.RegisterSource(FromKind, createFromSource)
+	execute.RegisterTransformation(ToKind, createToTransformation)
+	plan.RegisterPhysicalRules(
+		PushDownRangeRule{},
+		PushDownFilterRule{},
+		PushDownGroupRule{},
+		PushDownLimitRule{},
+	)
+}
+
+// StorageDependency is the key used to provide a Storage
+// to the from and to functions through execute.Dependencies.
+const StorageDependency = "influxdata/influxdb/storage"
+
+// Storage is the interface that from and to use to read
+// and write series data.
+type Storage interface {
+	Reader
+	Writer
+}
+
+// Reader reads series data from a bucket.
+type Reader interface {
+	// Read returns the tables that match the read spec.
+	// Each table holds the points of a single series and is grouped by
+	// _measurement, _field and the series tag keys.
+	Read(ctx context.Context, spec ReadSpec, alloc *memory.Allocator) (flux.TableIterator, error)
+}
+
+// Writer writes points into a bucket.
+type Writer interface {
+	Write(ctx context.Context, bucket string, points []Point) error
+}
+
+// ReadSpec describes the data that should be read from storage.
+type ReadSpec struct {
+	Bucket string
+
+	// Bounds restricts the points to the given time range.
+	// When set, every table also has _start and _stop columns in its group key.
+	Bounds *execute.Bounds
+
+	// Predicate selects the series that are read.
+	// A nil predicate selects every series.
+	Predicate *Predicate
+
+	// GroupMode and GroupKeys regroup the series the same way group() does.
+	// Only flux.GroupModeNone and flux.GroupModeBy are supported.
+	GroupMode flux.GroupMode
+	GroupKeys []string
+
+	// LimitSet indicates that at most PointsLimit rows are returned
+	// from each table after skipping the first PointsOffset rows.
+	LimitSet     bool
+	PointsLimit  int64
+	PointsOffset int64
+}
+
+// PredicateOp is the operation performed by a Predicate node.
+type PredicateOp int
+
+const (
+	PredicateOpEqual PredicateOp = iota
+	PredicateOpNotEqual
+	PredicateOpAnd
+	PredicateOpOr
+)
+
+// Predicate is a boolean expression over the tags of a series,
+// where _measurement and _field are treated like any other tag.
+// Comparison nodes compare the tag Key with Value and
+// logical nodes combine the results of their Children.
+type Predicate struct {
+	Op       PredicateOp
+	Key      string
+	Value    string
+	Children []*Predicate
+}
+
+// Matches reports whether the series with the given tags matches the predicate.
+// The tag function returns the value of a tag and whether the series has it.
+// A comparison with a tag that the series does not have never matches,
+// the same way a filter comparison with a null value never passes.
+func (p *Predicate) Matches(tag func(key string) (string, bool)) bool {
+	switch p.Op {
+	case PredicateOpEqual, PredicateOpNotEqual:
+		v, ok := tag(p.Key)
+		if !ok {
+			return false
+		}
+		return (v == p.Value) == (p.Op == PredicateOpEqual)
+	case PredicateOpAnd:
+		for _, c := range p.Children {
+			if !c.Matches(tag) {
+				return false
+			}
+		}
+		return true
+	case PredicateOpOr:
+		for _, c := range p.Children {
+			if c.Matches(tag) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// Tag is a single key/value pair that identifies a series.
+type Tag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Field is a single named value of a point.
+type Field struct {
+	Key   string
+	Value values.Value
+}
+
+// Point is a set of field values for a series at a single point in time.
+// Tags must be sorted by key.
+type Point struct {
+	Measurement string
+	Tags        []Tag
+	Fields      []Field
+	Time        values.Time
+}
+
+// GetStorage returns the Storage that was provided in the dependencies.
+func GetStorage(deps execute.Dependencies) (Storage, error) {
+	v, ok := deps[StorageDependency]
+	if !ok || v == nil {
+		return nil, errors.New(codes.Invalid, "no storage has been configured for the influxdb package")
+	}
+	s, ok := v.(Storage)
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "invalid storage dependency type %T", v)
+	}
+	return s, nil
+}
+
+// InjectStorage adds the Storage to the dependencies.
+func InjectStorage(deps execute.Dependencies, s Storage) {
+	deps[StorageDependency] = s
+}
@@ -1,13 +1,25 @@
 package influxdb
 
 import (
+	"context"
+	"sort"
+
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
 )
 
 // ToKind is the kind for the `to` flux function
 const ToKind = "to"
 
+// DefaultToBatchSize is the number of points written to storage at once.
+const DefaultToBatchSize = 5000
+
 var ToSignature = flux.FunctionSignature(
 	map[string]semantic.PolyType{
 		"bucket":            semantic.String,
@@ -18,7 +30,7 @@ var ToSignature = flux.FunctionSignature(
 		"token":             semantic.String,
 		"timeColumn":        semantic.String,
 		"measurementColumn": semantic.String,
-		"tagColumns":        semantic.Array,
+		"tagColumns":        semantic.NewArrayPolyType(semantic.String),
 		"fieldFn": semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
 			Parameters: map[string]semantic.PolyType{
 				"r": semantic.Tvar(1),
@@ -31,5 +43,359 @@ var ToSignature = flux.FunctionSignature(
 )
 
 func init() {
-	flux.RegisterPackageValue("influxdata/influxdb", ToKind, flux.FunctionValueWithSideEffect(ToKind, nil, ToSignature))
+	flux.RegisterPackageValue("influxdata/influxdb", ToKind, flux.FunctionValueWithSideEffect(ToKind, createToOpSpec, ToSignature))
+	flux.RegisterOpSpec(ToKind, func() flux.OperationSpec { return &ToOpSpec{} })
+	plan.RegisterProcedureSpecWithSideEffect(ToKind, newToProcedure, ToKind)
+}
+
+// ToOpSpec is the flux.OperationSpec for the `to` flux function.
+type ToOpSpec struct {
+	Bucket            string                       `json:"bucket"`
+	BucketID          string                       `json:"bucketID"`
+	Org               string                       `json:"org"`
+	OrgID             string                       `json:"orgID"`
+	Host              string                       `json:"host"`
+	Token             string                       `json:"token"`
+	TimeColumn        string                       `json:"timeColumn"`
+	MeasurementColumn string                       `json:"measurementColumn"`
+	TagColumns        []string                     `json:"tagColumns"`
+	FieldFn           *semantic.FunctionExpression `json:"fieldFn"`
+}
+
+// ReadArgs reads the args from flux.Arguments into the op spec.
+// The time column defaults to _time and the measurement column to _measurement.
+func (o *ToOpSpec) ReadArgs(args flux.Arguments) error {
+	var err error
+	var ok bool
+
+	if o.Bucket, ok, err = args.GetString("bucket"); err != nil {
+		return err
+	}
+	if o.BucketID, _, err = args.GetString("bucketID"); err != nil {
+		return err
+	}
+	if ok == (o.BucketID != "") {
+		return errors.New(codes.Invalid, "must specify exactly one of bucket or bucketID")
+	}
+
+	if o.Org, _, err = args.GetString("org"); err != nil {
+		return err
+	}
+	if o.OrgID, _, err = args.GetString("orgID"); err != nil {
+		return err
+	}
+	if o.Host, _, err = args.GetString("host"); err != nil {
+		return err
+	}
+	if o.Token, _, err = args.GetString("token"); err != nil {
+		return err
+	}
+
+	if o.TimeColumn, ok, err = args.GetString("timeColumn"); err != nil {
+		return err
+	} else if !ok {
+		o.TimeColumn = execute.DefaultTimeColLabel
+	}
+
+	if o.MeasurementColumn, ok, err = args.GetString("measurementColumn"); err != nil {
+		return err
+	} else if !ok {
+		o.MeasurementColumn = "_measurement"
+	}
+
+	if tags, ok, err := args.GetArray("tagColumns", semantic.String); err != nil {
+		return err
+	} else if ok {
+		o.TagColumns = make([]string, tags.Len())
+		tags.Sort(func(i, j values.Value) bool {
+			return i.Str() < j.Str()
+		})
+		tags.Range(func(i int, v values.Value) {
+			o.TagColumns[i] = v.Str()
+		})
+	}
+
+	if fn, ok, err := args.GetFunction("fieldFn"); err != nil {
+		return err
+	} else if ok {
+		if o.FieldFn, err = interpreter.ResolveFunction(fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func createToOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+	s := new(ToOpSpec)
+	if err := s.ReadArgs(args); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (ToOpSpec) Kind() flux.OperationKind {
+	return ToKind
+}
+
+// ToProcedureSpec is the procedure spec for the `to` flux function.
+type ToProcedureSpec struct {
+	plan.DefaultCost
+	Spec *ToOpSpec
+}
+
+func (o *ToProcedureSpec) Kind() plan.ProcedureKind {
+	return ToKind
+}
+
+func (o *ToProcedureSpec) Copy() plan.ProcedureSpec {
+	s := o.Spec
+	res := &ToProcedureSpec{
+		Spec: &ToOpSpec{
+			Bucket:            s.Bucket,
+			BucketID:          s.BucketID,
+			Org:               s.Org,
+			OrgID:             s.OrgID,
+			Host:              s.Host,
+			Token:             s.Token,
+			TimeColumn:        s.TimeColumn,
+			MeasurementColumn: s.MeasurementColumn,
+			TagColumns:        append([]string(nil), s.TagColumns...),
+		},
+	}
+	if s.FieldFn != nil {
+		res.Spec.FieldFn = s.FieldFn.Copy().(*semantic.FunctionExpression)
+	}
+	return res
+}
+
+func newToProcedure(qs flux.OperationSpec, a plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*ToOpSpec)
+	if !ok && spec != nil {
+		return nil, errors.Newf(codes.Internal, "invalid spec type %T", qs)
+	}
+	return &ToProcedureSpec{Spec: spec}, nil
+}
+
+func createToTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*ToProcedureSpec)
+	if !ok {
+		return nil, nil, errors.Newf(codes.Internal, "invalid spec type %T", spec)
+	}
+	if s.Spec.Host != "" {
+		return nil, nil, errors.New(codes.Unimplemented, "writing to a remote host is not supported")
+	}
+	w, err := GetStorage(a.Dependencies())
+	if err != nil {
+		return nil, nil, err
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t, err := NewToTransformation(a.Context(), d, cache, s, w)
+	if err != nil {
+		return nil, nil, err
+	}
+	return t, d, nil
+}
+
+// ToTransformation writes the tables it receives to storage
+// and passes them through unchanged.
+type ToTransformation struct {
+	ctx    context.Context
+	d      execute.Dataset
+	cache  execute.TableBuilderCache
+	spec   *ToProcedureSpec
+	w      Writer
+	fn     *execute.RowMapFn
+	bucket string
+}
+
+func (t *ToTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+// NewToTransformation creates a ToTransformation that writes into w.
+func NewToTransformation(ctx context.Context, d execute.Dataset, cache execute.TableBuilderCache, spec *ToProcedureSpec, w Writer) (*ToTransformation, error) {
+	t := &ToTransformation{
+		ctx:    ctx,
+		d:      d,
+		cache:  cache,
+		spec:   spec,
+		w:      w,
+		bucket: spec.Spec.Bucket,
+	}
+	if t.bucket == "" {
+		t.bucket = spec.Spec.BucketID
+	}
+	if spec.Spec.FieldFn != nil {
+		fn, err := execute.NewRowMapFn(spec.Spec.FieldFn)
+		if err != nil {
+			return nil, err
+		}
+		t.fn = fn
+	}
+	return t, nil
+}
+
+func (t *ToTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	builder, created := t.cache.TableBuilder(tbl.Key())
+	if created {
+		if err := execute.AddTableCols(tbl, builder); err != nil {
+			return err
+		}
+	}
+
+	cols := tbl.Cols()
+	spec := t.spec.Spec
+	timeIdx := execute.ColIdx(spec.TimeColumn, cols)
+	if timeIdx < 0 {
+		return errors.Newf(codes.Invalid, "no time column %q", spec.TimeColumn)
+	} else if cols[timeIdx].Type != flux.TTime {
+		return errors.Newf(codes.Invalid, "column %q is not of type time", spec.TimeColumn)
+	}
+	measurementIdx := execute.ColIdx(spec.MeasurementColumn, cols)
+	if measurementIdx < 0 {
+		return errors.Newf(codes.Invalid, "no measurement column %q", spec.MeasurementColumn)
+	} else if cols[measurementIdx].Type != flux.TString {
+		return errors.Newf(codes.Invalid, "column %q is not of type string", spec.MeasurementColumn)
+	}
+
+	var fieldIdx, valueIdx int
+	if t.fn == nil {
+		fieldIdx = execute.ColIdx("_field", cols)
+		if fieldIdx < 0 {
+			return errors.New(codes.Invalid, "no _field column, a fieldFn must be specified")
+		}
+		valueIdx = execute.ColIdx(execute.DefaultValueColLabel, cols)
+		if valueIdx < 0 {
+			return errors.New(codes.Invalid, "no _value column, a fieldFn must be specified")
+		}
+	} else if err := t.fn.Prepare(cols); err != nil {
+		return err
+	}
+
+	tagIdxs, err := t.tagColumns(cols)
+	if err != nil {
+		return err
+	}
+
+	points := make([]Point, 0, DefaultToBatchSize)
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		for i := 0; i < cr.Len(); i++ {
+			if cr.Times(timeIdx).IsNull(i) || cr.Strings(measurementIdx).IsNull(i) {
+				return errors.Newf(codes.Invalid, "null value in column %q or %q", spec.TimeColumn, spec.MeasurementColumn)
+			}
+			p := Point{
+				Measurement: cr.Strings(measurementIdx).ValueString(i),
+				Time:        values.Time(cr.Times(timeIdx).Value(i)),
+				Tags:        make([]Tag, 0, len(tagIdxs)),
+			}
+			for _, j := range tagIdxs {
+				if cr.Strings(j).IsNull(i) {
+					continue
+				}
+				p.Tags = append(p.Tags, Tag{Key: cols[j].Label, Value: cr.Strings(j).ValueString(i)})
+			}
+
+			if t.fn == nil {
+				if cr.Strings(fieldIdx).IsNull(i) {
+					return errors.New(codes.Invalid, "null value in column _field")
+				}
+				v := execute.ValueForRow(cr, i, valueIdx)
+				if !v.IsNull() {
+					p.Fields = []Field{{Key: cr.Strings(fieldIdx).ValueString(i), Value: v}}
+				}
+			} else {
+				obj, err := t.fn.Eval(i, cr)
+				if err != nil {
+					return err
+				}
+				obj.Range(func(k string, v values.Value) {
+					if !v.IsNull() {
+						p.Fields = append(p.Fields, Field{Key: k, Value: v})
+					}
+				})
+				sort.Slice(p.Fields, func(i, j int) bool {
+					return p.Fields[i].Key < p.Fields[j].Key
+				})
+			}
+			if len(p.Fields) > 0 {
+				points = append(points, p)
+			}
+			if len(points) == DefaultToBatchSize {
+				if err := t.w.Write(t.ctx, t.bucket, points); err != nil {
+					return err
+				}
+				points = points[:0]
+			}
+
+			if err := execute.AppendRecord(i, cr, builder); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if len(points) > 0 {
+		return t.w.Write(t.ctx, t.bucket, points)
+	}
+	return nil
+}
+
+// tagColumns returns the indexes of the tag columns sorted by label.
+// When no tag columns were specified, every string column that is not
+// the measurement, field or value column is a tag.
+func (t *ToTransformation) tagColumns(cols []flux.ColMeta) ([]int, error) {
+	spec := t.spec.Spec
+	var idxs []int
+	if spec.TagColumns != nil {
+		for _, label := range spec.TagColumns {
+			j := execute.ColIdx(label, cols)
+			if j < 0 {
+				return nil, errors.Newf(codes.Invalid, "no tag column %q", label)
+			} else if cols[j].Type != flux.TString {
+				return nil, errors.Newf(codes.Invalid, "tag column %q is not of type string", label)
+			}
+			idxs = append(idxs, j)
+		}
+	} else {
+		var fields semantic.Type
+		if t.fn != nil {
+			fields = t.fn.Type()
+		}
+		for j, c := range cols {
+			if c.Type != flux.TString {
+				continue
+			}
+			switch c.Label {
+			case spec.MeasurementColumn, "_field", execute.DefaultValueColLabel:
+				continue
+			}
+			if fields != nil {
+				if _, ok := fields.Properties()[c.Label]; ok {
+					continue
+				}
+			}
+			idxs = append(idxs, j)
+		}
+	}
+	sort.Slice(idxs, func(i, j int) bool {
+		return cols[idxs[i]].Label < cols[idxs[j]].Label
+	})
+	return idxs, nil
+}
+
+func (t *ToTransformation) UpdateWatermark(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateWatermark(pt)
+}
+
+func (t *ToTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+
+func (t *ToTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
 }
@@ -0,0 +1,215 @@
+package influxdb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
+)
+
+func TestTo_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "from with to",
+			Raw:  `import "influxdata/influxdb" influxdb.from(bucket: "mybucket") |> influxdb.to(bucket: "other", tagColumns: ["b", "a"])`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mybucket",
+						},
+					},
+					{
+						ID: "to1",
+						Spec: &influxdb.ToOpSpec{
+							Bucket:            "other",
+							TimeColumn:        "_time",
+							MeasurementColumn: "_measurement",
+							TagColumns:        []string{"a", "b"},
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "to1"},
+				},
+			},
+		},
+		{
+			Name:    "to without bucket",
+			Raw:     `import "influxdata/influxdb" influxdb.from(bucket: "mybucket") |> influxdb.to()`,
+			WantErr: true,
+		},
+		{
+			Name:    "to with bucket and bucketID",
+			Raw:     `import "influxdata/influxdb" influxdb.from(bucket: "mybucket") |> influxdb.to(bucket: "a", bucketID: "b")`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestTo_Process(t *testing.T) {
+	testCases := []struct {
+		name string
+		spec *influxdb.ToOpSpec
+		data []flux.Table
+		want []*executetest.Table
+	}{
+		{
+			name: "field and value columns",
+			spec: &influxdb.ToOpSpec{
+				Bucket:            "b",
+				TimeColumn:        "_time",
+				MeasurementColumn: "_measurement",
+			},
+			data: []flux.Table{executetest.MustCopyTable(&executetest.Table{
+				KeyCols: []string{"_measurement", "_field", "host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "_field", Type: flux.TString},
+					{Label: "host", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "cpu", "usage", "a", 1.0},
+					{execute.Time(2), "cpu", "usage", "a", nil},
+					{execute.Time(3), "cpu", "usage", "a", 3.0},
+				},
+			})},
+			want: []*executetest.Table{{
+				KeyCols: []string{"_field", "_measurement", "host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "_field", Type: flux.TString},
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "host", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0, "usage", "cpu", "a"},
+					{execute.Time(3), 3.0, "usage", "cpu", "a"},
+				},
+			}},
+		},
+		{
+			name: "field function",
+			spec: &influxdb.ToOpSpec{
+				Bucket:            "b",
+				TimeColumn:        "time",
+				MeasurementColumn: "name",
+				TagColumns:        []string{"host"},
+				FieldFn: &semantic.FunctionExpression{
+					Block: &semantic.FunctionBlock{
+						Parameters: &semantic.FunctionParameters{
+							List: []*semantic.FunctionParameter{{Key: &semantic.Identifier{Name: "r"}}},
+						},
+						Body: &semantic.ObjectExpression{
+							Properties: []*semantic.Property{
+								{
+									Key: &semantic.Identifier{Name: "temp"},
+									Value: &semantic.MemberExpression{
+										Object:   &semantic.IdentifierExpression{Name: "r"},
+										Property: "temp",
+									},
+								},
+								{
+									Key: &semantic.Identifier{Name: "ok"},
+									Value: &semantic.MemberExpression{
+										Object:   &semantic.IdentifierExpression{Name: "r"},
+										Property: "ok",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			data: []flux.Table{executetest.MustCopyTable(&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "time", Type: flux.TTime},
+					{Label: "name", Type: flux.TString},
+					{Label: "host", Type: flux.TString},
+					{Label: "room", Type: flux.TString},
+					{Label: "temp", Type: flux.TInt},
+					{Label: "ok", Type: flux.TBool},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "sensor", "a", "kitchen", int64(20), true},
+					{execute.Time(2), "sensor", "a", "kitchen", int64(21), false},
+				},
+			})},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"_field", "_measurement", "host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TBool},
+						{Label: "_field", Type: flux.TString},
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), true, "ok", "sensor", "a"},
+						{execute.Time(2), false, "ok", "sensor", "a"},
+					},
+				},
+				{
+					KeyCols: []string{"_field", "_measurement", "host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TInt},
+						{Label: "_field", Type: flux.TString},
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), int64(20), "temp", "sensor", "a"},
+						{execute.Time(2), int64(21), "temp", "sensor", "a"},
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			s, cleanup := newTestStorage(t)
+			defer cleanup()
+
+			d := executetest.NewDataset(executetest.RandomDatasetID())
+			c := execute.NewTableBuilderCache(executetest.UnlimitedAllocator)
+			c.SetTriggerSpec(plan.DefaultTriggerSpec)
+
+			tr, err := influxdb.NewToTransformation(context.Background(), d, c, &influxdb.ToProcedureSpec{Spec: tc.spec}, s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, tbl := range tc.data {
+				if err := tr.Process(executetest.RandomDatasetID(), tbl); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got := readTables(t, s, influxdb.ReadSpec{Bucket: tc.spec.Bucket})
+			executetest.NormalizeTables(tc.want)
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
@@ -0,0 +1,295 @@
+// Package interpolate provides transformations that insert rows at a regular interval
+// into series with gaps, and compute the values of the new rows from their neighbors.
+package interpolate
+
+import (
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	"github.com/pkg/errors"
+)
+
+const InterpolateKind = "interpolate"
+
+// The methods that compute the values of the inserted rows.
+const (
+	// MethodLinear computes the value on the line between the previous and the next row.
+	MethodLinear = "linear"
+	// MethodNearest uses the value of the row that is closest in time,
+	// the previous row wins a tie.
+	MethodNearest = "nearest"
+	// MethodNext uses the value of the next row.
+	MethodNext = "next"
+)
+
+type InterpolateOpSpec struct {
+	Every  flux.Duration `json:"every"`
+	Method string        `json:"method"`
+}
+
+func init() {
+	interpolateSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"every": semantic.Duration,
+		},
+		[]string{"every"},
+	)
+
+	for _, method := range []string{MethodLinear, MethodNearest, MethodNext} {
+		flux.RegisterPackageValue("interpolate", method, flux.FunctionValue(InterpolateKind, createInterpolateOpSpec(method), interpolateSignature))
+	}
+	flux.RegisterOpSpec(InterpolateKind, newInterpolateOp)
+	plan.RegisterProcedureSpec(InterpolateKind, newInterpolateProcedure, InterpolateKind)
+	execute.RegisterTransformation(InterpolateKind, createInterpolateTransformation)
+}
+
+func createInterpolateOpSpec(method string) func(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	return func(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+		if err := a.AddParentFromArgs(args); err != nil {
+			return nil, err
+		}
+
+		every, err := args.GetRequiredDuration("every")
+		if err != nil {
+			return nil, err
+		}
+		if every <= 0 {
+			return nil, errors.New("every must be a positive duration")
+		}
+
+		return &InterpolateOpSpec{
+			Every:  every,
+			Method: method,
+		}, nil
+	}
+}
+
+func newInterpolateOp() flux.OperationSpec {
+	return new(InterpolateOpSpec)
+}
+
+func (s *InterpolateOpSpec) Kind() flux.OperationKind {
+	return InterpolateKind
+}
+
+type InterpolateProcedureSpec struct {
+	plan.DefaultCost
+	Every  flux.Duration
+	Method string
+}
+
+func newInterpolateProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*InterpolateOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+
+	return &InterpolateProcedureSpec{
+		Every:  spec.Every,
+		Method: spec.Method,
+	}, nil
+}
+
+func (s *InterpolateProcedureSpec) Kind() plan.ProcedureKind {
+	return InterpolateKind
+}
+
+func (s *InterpolateProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	return &ns
+}
+
+func createInterpolateTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*InterpolateProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewInterpolateTransformation(d, cache, s)
+	return t, d, nil
+}
+
+type interpolateTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+
+	every  int64
+	method string
+}
+
+func NewInterpolateTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *InterpolateProcedureSpec) *interpolateTransformation {
+	return &interpolateTransformation{
+		d:      d,
+		cache:  cache,
+		every:  int64(spec.Every),
+		method: spec.Method,
+	}
+}
+
+func (t *interpolateTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *interpolateTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	timeIdx := execute.ColIdx(execute.DefaultTimeColLabel, tbl.Cols())
+	if timeIdx < 0 || tbl.Cols()[timeIdx].Type != flux.TTime {
+		return fmt.Errorf("interpolate requires a %s column of type time", execute.DefaultTimeColLabel)
+	}
+	valueIdx := execute.ColIdx(execute.DefaultValueColLabel, tbl.Cols())
+	if valueIdx < 0 {
+		return fmt.Errorf("interpolate requires a %s column", execute.DefaultValueColLabel)
+	}
+
+	builder, created := t.cache.TableBuilder(tbl.Key())
+	if !created {
+		return fmt.Errorf("interpolate found duplicate table with key: %v", tbl.Key())
+	}
+	for j, c := range tbl.Cols() {
+		if j == valueIdx && t.method == MethodLinear {
+			switch c.Type {
+			case flux.TInt, flux.TUInt, flux.TFloat:
+				// Interpolated values are floats.
+				c.Type = flux.TFloat
+			default:
+				return fmt.Errorf("linear interpolation requires a numeric %s column, got %v", c.Label, c.Type)
+			}
+		}
+		if _, err := builder.AddCol(c); err != nil {
+			return err
+		}
+	}
+
+	var (
+		prev      values.Value
+		prevTime  execute.Time
+		seenFirst bool
+	)
+	return tbl.Do(func(cr flux.ColReader) error {
+		times := cr.Times(timeIdx)
+		for i := 0; i < cr.Len(); i++ {
+			value := execute.ValueForRow(cr, i, valueIdx)
+			if t.method == MethodLinear && !value.IsNull() {
+				value = values.NewFloat(toFloat(value))
+			}
+			// Rows without a time are kept, but cannot be interpolated from.
+			if times.IsNull(i) {
+				if err := t.appendRow(builder, cr, i, valueIdx, value); err != nil {
+					return err
+				}
+				continue
+			}
+
+			ts := execute.Time(times.Value(i))
+			if seenFirst {
+				if ts < prevTime {
+					return errors.New("interpolate requires the rows of every table to be sorted by time")
+				}
+				if err := t.insertRows(builder, tbl.Key(), timeIdx, valueIdx, prevTime, prev, ts, value); err != nil {
+					return err
+				}
+			}
+			if err := t.appendRow(builder, cr, i, valueIdx, value); err != nil {
+				return err
+			}
+			prev, prevTime, seenFirst = value, ts, true
+		}
+		return nil
+	})
+}
+
+// appendRow appends the row i of the column reader, with the given value for the value column.
+func (t *interpolateTransformation) appendRow(builder execute.TableBuilder, cr flux.ColReader, i, valueIdx int, value values.Value) error {
+	for j := range cr.Cols() {
+		v := value
+		if j != valueIdx {
+			v = execute.ValueForRow(cr, i, j)
+		}
+		if err := builder.AppendValue(j, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertRows inserts a row at every multiple of every that is strictly between the times
+// of the previous and the next row. The inserted rows have the values of the group key,
+// and all other columns besides the time and value columns are null.
+func (t *interpolateTransformation) insertRows(builder execute.TableBuilder, key flux.GroupKey, timeIdx, valueIdx int, prevTime execute.Time, prev values.Value, nextTime execute.Time, next values.Value) error {
+	// The first multiple of every after the previous time.
+	start := int64(prevTime) - mod(int64(prevTime), t.every) + t.every
+	for ts := start; ts < int64(nextTime); ts += t.every {
+		for j, c := range builder.Cols() {
+			var err error
+			switch {
+			case j == timeIdx:
+				err = builder.AppendTime(j, execute.Time(ts))
+			case j == valueIdx:
+				err = builder.AppendValue(j, t.interpolate(execute.Time(ts), prevTime, prev, nextTime, next))
+			case key.HasCol(c.Label):
+				err = builder.AppendValue(j, key.LabelValue(c.Label))
+			default:
+				err = builder.AppendNil(j)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// interpolate computes the value at time ts from the values of the rows around it.
+func (t *interpolateTransformation) interpolate(ts, prevTime execute.Time, prev values.Value, nextTime execute.Time, next values.Value) values.Value {
+	switch t.method {
+	case MethodLinear:
+		if prev.IsNull() || next.IsNull() {
+			return values.NewNull(semantic.Float)
+		}
+		p, n := prev.Float(), next.Float()
+		return values.NewFloat(p + (n-p)*float64(ts-prevTime)/float64(nextTime-prevTime))
+	case MethodNearest:
+		if nextTime-ts < ts-prevTime {
+			return next
+		}
+		return prev
+	default:
+		return next
+	}
+}
+
+// mod returns the remainder of a divided by b that has the sign of b.
+func mod(a, b int64) int64 {
+	m := a % b
+	if m < 0 {
+		m += b
+	}
+	return m
+}
+
+func toFloat(v values.Value) float64 {
+	switch v.Type() {
+	case semantic.Int:
+		return float64(v.Int())
+	case semantic.UInt:
+		return float64(v.UInt())
+	default:
+		return v.Float()
+	}
+}
+
+func (t *interpolateTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+
+func (t *interpolateTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+
+func (t *interpolateTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
@@ -0,0 +1,257 @@
+package interpolate_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux"
+	_ "github.com/influxdata/flux/builtin" // We need to import the builtins for the tests to work.
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
+	"github.com/influxdata/flux/stdlib/interpolate"
+)
+
+func TestInterpolate_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "linear",
+			Raw: `import "interpolate"
+from(bucket:"mydb") |> interpolate.linear(every: 1m)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mydb",
+						},
+					},
+					{
+						ID: "interpolate1",
+						Spec: &interpolate.InterpolateOpSpec{
+							Every:  flux.Duration(time.Minute),
+							Method: interpolate.MethodLinear,
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "interpolate1"},
+				},
+			},
+		},
+		{
+			Name: "nearest",
+			Raw: `import "interpolate"
+from(bucket:"mydb") |> interpolate.nearest(every: 10s)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mydb",
+						},
+					},
+					{
+						ID: "interpolate1",
+						Spec: &interpolate.InterpolateOpSpec{
+							Every:  flux.Duration(10 * time.Second),
+							Method: interpolate.MethodNearest,
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "interpolate1"},
+				},
+			},
+		},
+		{
+			Name: "missing every",
+			Raw: `import "interpolate"
+from(bucket:"mydb") |> interpolate.next()`,
+			WantErr: true,
+		},
+		{
+			Name: "negative every",
+			Raw: `import "interpolate"
+from(bucket:"mydb") |> interpolate.next(every: -1m)`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestInterpolate_Process(t *testing.T) {
+	testCases := []struct {
+		name    string
+		spec    *interpolate.InterpolateProcedureSpec
+		data    []flux.Table
+		want    []*executetest.Table
+		wantErr error
+	}{
+		{
+			name: "linear",
+			spec: &interpolate.InterpolateProcedureSpec{
+				Every:  flux.Duration(10),
+				Method: interpolate.MethodLinear,
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "host", Type: flux.TString},
+					{Label: "_value", Type: flux.TInt},
+					{Label: "region", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(5), "a", int64(0), "east"},
+					{execute.Time(10), "a", int64(5), "east"},
+					{execute.Time(35), "a", int64(30), "west"},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "host", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "region", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(5), "a", 0.0, "east"},
+					{execute.Time(10), "a", 5.0, "east"},
+					{execute.Time(20), "a", 15.0, nil},
+					{execute.Time(30), "a", 25.0, nil},
+					{execute.Time(35), "a", 30.0, "west"},
+				},
+			}},
+		},
+		{
+			name: "linear null value",
+			spec: &interpolate.InterpolateProcedureSpec{
+				Every:  flux.Duration(10),
+				Method: interpolate.MethodLinear,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), 1.0},
+					{execute.Time(20), nil},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), 1.0},
+					{execute.Time(10), nil},
+					{execute.Time(20), nil},
+				},
+			}},
+		},
+		{
+			name: "nearest",
+			spec: &interpolate.InterpolateProcedureSpec{
+				Every:  flux.Duration(10),
+				Method: interpolate.MethodNearest,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), "a"},
+					{execute.Time(40), "b"},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), "a"},
+					{execute.Time(10), "a"},
+					{execute.Time(20), "a"},
+					{execute.Time(30), "b"},
+					{execute.Time(40), "b"},
+				},
+			}},
+		},
+		{
+			name: "next",
+			spec: &interpolate.InterpolateProcedureSpec{
+				Every:  flux.Duration(10),
+				Method: interpolate.MethodNext,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TBool},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), false},
+					{execute.Time(10), false},
+					{execute.Time(25), true},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TBool},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), false},
+					{execute.Time(10), false},
+					{execute.Time(20), true},
+					{execute.Time(25), true},
+				},
+			}},
+		},
+		{
+			name: "unsorted",
+			spec: &interpolate.InterpolateProcedureSpec{
+				Every:  flux.Duration(10),
+				Method: interpolate.MethodNext,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(20), 1.0},
+					{execute.Time(10), 2.0},
+				},
+			}},
+			wantErr: errors.New("interpolate requires the rows of every table to be sorted by time"),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				tc.wantErr,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					return interpolate.NewInterpolateTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// DO NOT EDIT: This file is autogenerated via the builtin command.
+
+package json
+
+import (
+	flux "github.com/influxdata/flux"
+	ast "github.com/influxdata/flux/ast"
+)
+
+func init() {
+	flux.RegisterPackage(pkgAST)
+}
+
+var pkgAST = &ast.Package{
+	BaseNode: ast.BaseNode{
+		Errors: nil,
+		Loc:    nil,
+	},
+	Files: []*ast.File{&ast.File{
+		BaseNode: ast.BaseNode{
+			Errors: nil,
+			Loc: &ast.SourceLocation{
+				End: ast.Position{
+					Column: 13,
+					Line:   3,
+				},
+				File:   "json.flux",
+				Source: "package json\n\nbuiltin from",
+				Start: ast.Position{
+					Column: 1,
+					Line:   1,
+				},
+			},
+		},
+		Body: []ast.Statement{&ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 13,
+						Line:   3,
+					},
+					File:   "json.flux",
+					Source: "builtin from",
+					Start: ast.Position{
+						Column: 1,
+						Line:   3,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 13,
+							Line:   3,
+						},
+						File:   "json.flux",
+						Source: "from",
+						Start: ast.Position{
+							Column: 9,
+							Line:   3,
+						},
+					},
+				},
+				Name: "from",
+			},
+		}},
+		Imports: nil,
+		Name:    "json.flux",
+		Package: &ast.PackageClause{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 13,
+						Line:   1,
+					},
+					File:   "json.flux",
+					Source: "package json",
+					Start: ast.Position{
+						Column: 1,
+						Line:   1,
+					},
+				},
+			},
+			Name: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 13,
+							Line:   1,
+						},
+						File:   "json.flux",
+						Source: "json",
+						Start: ast.Position{
+							Column: 9,
+							Line:   1,
+						},
+					},
+				},
+				Name: "json",
+			},
+		},
+	}},
+	Package: "json",
+	Path:    "json",
+}
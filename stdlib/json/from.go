@@ -0,0 +1,183 @@
+package json
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	fjson "github.com/influxdata/flux/json"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+)
+
+const FromJSONKind = "fromJSON"
+
+type FromJSONOpSpec struct {
+	String string `json:"string,omitempty"`
+	File   string `json:"file,omitempty"`
+}
+
+func init() {
+	fromJSONSignature := semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			"string": semantic.String,
+			"file":   semantic.String,
+		},
+		Required: nil,
+		Return:   flux.TableObjectType,
+	}
+	flux.RegisterPackageValue("json", "from", flux.FunctionValue(FromJSONKind, createFromJSONOpSpec, fromJSONSignature))
+	flux.RegisterOpSpec(FromJSONKind, newFromJSONOp)
+	plan.RegisterProcedureSpec(FromJSONKind, newFromJSONProcedure, FromJSONKind)
+	execute.RegisterSource(FromJSONKind, createFromJSONSource)
+}
+
+func createFromJSONOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	spec := new(FromJSONOpSpec)
+
+	if s, ok, err := args.GetString("string"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.String = s
+	}
+
+	if file, ok, err := args.GetString("file"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.File = file
+	}
+
+	if spec.String == "" && spec.File == "" {
+		return nil, errors.New(codes.Invalid, "must provide JSON lines as a string or a file name")
+	}
+
+	if spec.String != "" && spec.File != "" {
+		return nil, errors.New(codes.Invalid, "must provide exactly one of the parameters string or file")
+	}
+
+	if spec.File != "" {
+		if _, err := os.Stat(spec.File); err != nil {
+			return nil, errors.Wrap(err, codes.Invalid, "failed to stat json file")
+		}
+	}
+
+	return spec, nil
+}
+
+func newFromJSONOp() flux.OperationSpec {
+	return new(FromJSONOpSpec)
+}
+
+func (s *FromJSONOpSpec) Kind() flux.OperationKind {
+	return FromJSONKind
+}
+
+type FromJSONProcedureSpec struct {
+	plan.DefaultCost
+	String string
+	File   string
+}
+
+func newFromJSONProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*FromJSONOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+
+	return &FromJSONProcedureSpec{
+		String: spec.String,
+		File:   spec.File,
+	}, nil
+}
+
+func (s *FromJSONProcedureSpec) Kind() plan.ProcedureKind {
+	return FromJSONKind
+}
+
+func (s *FromJSONProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(FromJSONProcedureSpec)
+	ns.String = s.String
+	ns.File = s.File
+	return ns
+}
+
+func createFromJSONSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
+	spec, ok := prSpec.(*FromJSONProcedureSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", prSpec)
+	}
+	return execute.CreateSourceFromDecoder(&JSONIterator{spec: spec, alloc: a.Allocator()}, dsid, a)
+}
+
+// JSONIterator reads newline delimited JSON objects as a single table.
+// The columns are the keys of the objects and their types are inferred
+// from the values, as described by json.ResultDecoder.
+type JSONIterator struct {
+	spec  *FromJSONProcedureSpec
+	alloc *memory.Allocator
+
+	file   *os.File
+	r      io.Reader
+	tables []flux.Table
+}
+
+var _ execute.SourceDecoder = (*JSONIterator)(nil)
+
+func (j *JSONIterator) Connect(ctx context.Context) error {
+	if j.spec.File == "" {
+		j.r = strings.NewReader(j.spec.String)
+		return nil
+	}
+	f, err := os.Open(j.spec.File)
+	if err != nil {
+		return errors.Wrap(err, codes.Invalid, "failed to open json file")
+	}
+	j.file = f
+	j.r = f
+	return nil
+}
+
+func (j *JSONIterator) Fetch(ctx context.Context) (bool, error) {
+	if j.r != nil {
+		r := j.r
+		j.r = nil
+		decoder := fjson.NewResultDecoder(fjson.ResultDecoderConfig{
+			Lines:     true,
+			Allocator: j.alloc,
+		})
+		result, err := decoder.Decode(r)
+		if err != nil {
+			return false, err
+		}
+		if err := result.Tables().Do(func(tbl flux.Table) error {
+			j.tables = append(j.tables, tbl)
+			return nil
+		}); err != nil {
+			return false, err
+		}
+	}
+	return len(j.tables) > 0, nil
+}
+
+func (j *JSONIterator) Decode(ctx context.Context) (flux.Table, error) {
+	if len(j.tables) == 0 {
+		return execute.NewColListTableBuilder(execute.NewGroupKey(nil, nil), j.alloc).Table()
+	}
+	tbl := j.tables[0]
+	j.tables = j.tables[1:]
+	return tbl, nil
+}
+
+func (j *JSONIterator) Close() error {
+	if j.file == nil {
+		return nil
+	}
+	return j.file.Close()
+}
@@ -0,0 +1,157 @@
+package json_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	_ "github.com/influxdata/flux/builtin" // We need to import the builtins for the tests to work.
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/json"
+)
+
+func TestFromJSON_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name:    "from no args",
+			Raw:     `import "json" json.from()`,
+			WantErr: true,
+		},
+		{
+			Name:    "from conflicting args",
+			Raw:     `import "json" json.from(string: "{}", file: "f.json")`,
+			WantErr: true,
+		},
+		{
+			Name:    "from missing file",
+			Raw:     `import "json" json.from(file: "f.json")`,
+			WantErr: true,
+		},
+		{
+			Name: "from string",
+			Raw:  `import "json" json.from(string: "{\"a\": 1}")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "fromJSON0",
+						Spec: &json.FromJSONOpSpec{
+							String: `{"a": 1}`,
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestFromJSONOperation_Marshaling(t *testing.T) {
+	data := []byte(`{"id":"fromJSON","kind":"fromJSON","spec":{"file":"cpu.json"}}`)
+	op := &flux.Operation{
+		ID: "fromJSON",
+		Spec: &json.FromJSONOpSpec{
+			File: "cpu.json",
+		},
+	}
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+func runQuery(script string) ([]*executetest.Table, error) {
+	program, err := lang.Compile(script, time.Unix(0, 0))
+	if err != nil {
+		return nil, err
+	}
+	q, err := program.Start(context.Background(), &memory.Allocator{})
+	if err != nil {
+		return nil, err
+	}
+	defer q.Done()
+	var tables []*executetest.Table
+	for res := range q.Results() {
+		if err := res.Tables().Do(func(tbl flux.Table) error {
+			et, err := executetest.ConvertTable(tbl)
+			if err != nil {
+				return err
+			}
+			tables = append(tables, et)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if err := q.Err(); err != nil {
+		return nil, err
+	}
+	executetest.NormalizeTables(tables)
+	return tables, nil
+}
+
+func TestFromJSON_Run(t *testing.T) {
+	dir, err := ioutil.TempDir("", "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "cpu.json")
+	if err := ioutil.WriteFile(file, []byte(`{"_time": "1970-01-01T00:00:00.00000001Z", "host": "a", "_value": 1}
+{"_time": "1970-01-01T00:00:00.00000002Z", "host": "b", "_value": 2.5, "ok": true}
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := runQuery(`
+import "json"
+
+json.from(file: "` + file + `") |> group(columns: ["host"])`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cols := []flux.ColMeta{
+		{Label: "_time", Type: flux.TTime},
+		{Label: "host", Type: flux.TString},
+		{Label: "_value", Type: flux.TFloat},
+		{Label: "ok", Type: flux.TBool},
+	}
+	want := []*executetest.Table{
+		{
+			KeyCols: []string{"host"},
+			ColMeta: cols,
+			Data: [][]interface{}{
+				{execute.Time(10), "a", 1.0, nil},
+			},
+		},
+		{
+			KeyCols: []string{"host"},
+			ColMeta: cols,
+			Data: [][]interface{}{
+				{execute.Time(20), "b", 2.5, true},
+			},
+		},
+	}
+	executetest.NormalizeTables(want)
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(want, got))
+	}
+
+	if _, err := runQuery(`
+import "json"
+
+json.from(string: "{\"a\": 1}\n[1]")`); err == nil {
+		t.Error("expected an error for a line that is not an object")
+	}
+}
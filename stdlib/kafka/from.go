@@ -0,0 +1,331 @@
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/csv"
+	"github.com/influxdata/flux/execute"
+	fjson "github.com/influxdata/flux/json"
+	"github.com/influxdata/flux/lineprotocol"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	"github.com/pkg/errors"
+	kafka "github.com/segmentio/kafka-go"
+)
+
+const (
+	// FromKafkaKind is the Kind for the FromKafka Flux function
+	FromKafkaKind = "fromKafka"
+
+	// DefaultFromKafkaMaxMessages is the number of messages read when maxMessages isn't set.
+	DefaultFromKafkaMaxMessages = 1000
+	// DefaultFromKafkaTimeout is how long messages are read when timeout isn't set.
+	DefaultFromKafkaTimeout = 10 * time.Second
+)
+
+// FromKafkaDecoders are the decoders that kafka.from can use to read the message values.
+var FromKafkaDecoders = []string{"line", "csv", "json"}
+
+type FromKafkaOpSpec struct {
+	Brokers     []string      `json:"brokers"`
+	Topic       string        `json:"topic"`
+	GroupID     string        `json:"groupID,omitempty"`
+	Decoder     string        `json:"decoder"`
+	MaxMessages int           `json:"maxMessages"`
+	Timeout     time.Duration `json:"timeout"`
+}
+
+func init() {
+	fromKafkaSignature := semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			"brokers":     semantic.NewArrayPolyType(semantic.String),
+			"topic":       semantic.String,
+			"groupID":     semantic.String,
+			"decoder":     semantic.String,
+			"maxMessages": semantic.Int,
+			"timeout":     semantic.Duration,
+		},
+		Required: semantic.LabelSet{"brokers", "topic"},
+		Return:   flux.TableObjectType,
+	}
+	flux.RegisterPackageValue("kafka", "from", flux.FunctionValue(FromKafkaKind, createFromKafkaOpSpec, fromKafkaSignature))
+	flux.RegisterOpSpec(FromKafkaKind, func() flux.OperationSpec { return &FromKafkaOpSpec{} })
+	plan.RegisterProcedureSpec(FromKafkaKind, newFromKafkaProcedure, FromKafkaKind)
+	execute.RegisterSource(FromKafkaKind, createFromKafkaSource)
+}
+
+// DefaultKafkaReaderFactory makes the KafkaReader used by kafka.from, it is injectable for testing.
+var DefaultKafkaReaderFactory = func(conf kafka.ReaderConfig) KafkaReader {
+	return kafka.NewReader(conf)
+}
+
+// KafkaReader is an interface for what we need from DefaultKafkaReaderFactory
+type KafkaReader interface {
+	io.Closer
+	ReadMessage(context.Context) (kafka.Message, error)
+}
+
+// ReadArgs loads a flux.Arguments into FromKafkaOpSpec.  It sets several default values.
+// If the decoder isn't set, it defaults to line.
+// If maxMessages isn't set, it defaults to DefaultFromKafkaMaxMessages.
+// If the timeout isn't set, it defaults to DefaultFromKafkaTimeout.
+func (o *FromKafkaOpSpec) ReadArgs(args flux.Arguments) error {
+	brokers, err := args.GetRequiredArray("brokers", semantic.String)
+	if err != nil {
+		return err
+	}
+	if brokers.Len() < 1 {
+		return errors.New("at least one broker is required")
+	}
+	o.Brokers = make([]string, brokers.Len())
+	for i := range o.Brokers {
+		o.Brokers[i] = brokers.Get(i).Str()
+	}
+
+	o.Topic, err = args.GetRequiredString("topic")
+	if err != nil {
+		return err
+	}
+	if len(o.Topic) == 0 {
+		return errors.New("invalid topic name")
+	}
+
+	o.GroupID, _, err = args.GetString("groupID")
+	if err != nil {
+		return err
+	}
+
+	var ok bool
+	o.Decoder, ok, err = args.GetString("decoder")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		o.Decoder = FromKafkaDecoders[0]
+	}
+	if !contains(FromKafkaDecoders, o.Decoder) {
+		return fmt.Errorf("invalid decoder %s, must be one of %v", o.Decoder, FromKafkaDecoders)
+	}
+
+	maxMessages, ok, err := args.GetInt("maxMessages")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		o.MaxMessages = DefaultFromKafkaMaxMessages
+	} else if maxMessages <= 0 {
+		return errors.New("maxMessages must be greater than zero")
+	} else {
+		o.MaxMessages = int(maxMessages)
+	}
+
+	timeout, ok, err := args.GetDuration("timeout")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		o.Timeout = DefaultFromKafkaTimeout
+	} else if timeout <= 0 {
+		return errors.New("timeout must be greater than zero")
+	} else {
+		o.Timeout = time.Duration(timeout)
+	}
+	return nil
+}
+
+func contains(ss []string, s string) bool {
+	for _, st := range ss {
+		if st == s {
+			return true
+		}
+	}
+	return false
+}
+
+func createFromKafkaOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	s := new(FromKafkaOpSpec)
+	if err := s.ReadArgs(args); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (FromKafkaOpSpec) Kind() flux.OperationKind {
+	return FromKafkaKind
+}
+
+type FromKafkaProcedureSpec struct {
+	plan.DefaultCost
+	Spec *FromKafkaOpSpec
+}
+
+func (o *FromKafkaProcedureSpec) Kind() plan.ProcedureKind {
+	return FromKafkaKind
+}
+
+func (o *FromKafkaProcedureSpec) Copy() plan.ProcedureSpec {
+	s := *o.Spec
+	s.Brokers = append([]string(nil), o.Spec.Brokers...)
+	return &FromKafkaProcedureSpec{Spec: &s}
+}
+
+func newFromKafkaProcedure(qs flux.OperationSpec, a plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*FromKafkaOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	return &FromKafkaProcedureSpec{Spec: spec}, nil
+}
+
+func createFromKafkaSource(s plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
+	spec, ok := s.(*FromKafkaProcedureSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", s)
+	}
+	return NewKafkaSource(spec, dsid, a.Allocator()), nil
+}
+
+// NewKafkaSource creates a source that reads a bounded window of messages
+// with a reader from DefaultKafkaReaderFactory and builds its tables with alloc.
+func NewKafkaSource(spec *FromKafkaProcedureSpec, dsid execute.DatasetID, alloc *memory.Allocator) execute.Source {
+	return &kafkaSource{
+		d:     dsid,
+		spec:  spec.Spec,
+		alloc: alloc,
+	}
+}
+
+type kafkaSource struct {
+	d     execute.DatasetID
+	spec  *FromKafkaOpSpec
+	alloc *memory.Allocator
+	ts    []execute.Transformation
+}
+
+func (ks *kafkaSource) AddTransformation(t execute.Transformation) {
+	ks.ts = append(ks.ts, t)
+}
+
+func (ks *kafkaSource) Run(ctx context.Context) {
+	err := ks.run(ctx)
+	for _, t := range ks.ts {
+		t.Finish(ks.d, err)
+	}
+}
+
+func (ks *kafkaSource) run(ctx context.Context) error {
+	msgs, err := ks.readMessages(ctx)
+	if err != nil {
+		return err
+	}
+	return ks.decode(msgs, func(tbl flux.Table) error {
+		for _, t := range ks.ts {
+			if err := t.Process(ks.d, tbl); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// readMessages reads until maxMessages messages have been read or the timeout expires.
+// Running into the timeout is not an error, it only ends the window.
+func (ks *kafkaSource) readMessages(ctx context.Context) ([]kafka.Message, error) {
+	reader := DefaultKafkaReaderFactory(kafka.ReaderConfig{
+		Brokers: ks.spec.Brokers,
+		Topic:   ks.spec.Topic,
+		GroupID: ks.spec.GroupID,
+	})
+	defer reader.Close()
+
+	readCtx, cancel := context.WithTimeout(ctx, ks.spec.Timeout)
+	defer cancel()
+	msgs := make([]kafka.Message, 0, ks.spec.MaxMessages)
+	for len(msgs) < ks.spec.MaxMessages {
+		msg, err := reader.ReadMessage(readCtx)
+		if err != nil {
+			if readCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil {
+				break
+			}
+			return nil, errors.Wrap(err, "failed to read kafka message")
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+func (ks *kafkaSource) decode(msgs []kafka.Message, f func(flux.Table) error) error {
+	switch ks.spec.Decoder {
+	case "line":
+		return decodeLines(msgs, ks.alloc, f)
+	case "csv":
+		// Every message is a CSV document of its own.
+		for _, msg := range msgs {
+			result, err := csv.NewResultDecoder(csv.ResultDecoderConfig{}).Decode(bytes.NewReader(msg.Value))
+			if err != nil {
+				return errors.Wrap(err, "decode error")
+			}
+			if err := result.Tables().Do(f); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "json":
+		records, err := jsonRecords(msgs)
+		if err != nil {
+			return err
+		}
+		result, err := fjson.NewResultDecoder(fjson.ResultDecoderConfig{Allocator: ks.alloc}).Decode(bytes.NewReader(records))
+		if err != nil {
+			return errors.Wrap(err, "decode error")
+		}
+		return result.Tables().Do(f)
+	default:
+		return fmt.Errorf("unknown decoder type: %v", ks.spec.Decoder)
+	}
+}
+
+// decodeLines parses every message as line protocol, such as the messages written by kafka.to,
+// and produces a table for every field of every series, see lineprotocol.SeriesTables.
+// Points without a timestamp get the time of their message.
+func decodeLines(msgs []kafka.Message, alloc *memory.Allocator, f func(flux.Table) error) error {
+	var points []lineprotocol.Point
+	for _, msg := range msgs {
+		ps, err := lineprotocol.ParsePoints(msg.Value, values.ConvertTime(msg.Time))
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode message at offset %d", msg.Offset)
+		}
+		points = append(points, ps...)
+	}
+	return lineprotocol.SeriesTables(points, alloc, f)
+}
+
+// jsonRecords combines the messages into a single JSON array.
+// A message is either a record or an array of records.
+func jsonRecords(msgs []kafka.Message) ([]byte, error) {
+	var records []json.RawMessage
+	for i, msg := range msgs {
+		value := bytes.TrimSpace(msg.Value)
+		if len(value) > 0 && value[0] == '[' {
+			var elements []json.RawMessage
+			if err := json.Unmarshal(value, &elements); err != nil {
+				return nil, errors.Wrapf(err, "message %d", i)
+			}
+			records = append(records, elements...)
+			continue
+		}
+		records = append(records, json.RawMessage(value))
+	}
+	if records == nil {
+		records = []json.RawMessage{}
+	}
+	return json.Marshal(records)
+}
@@ -0,0 +1,260 @@
+package kafka_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/querytest"
+	fkafka "github.com/influxdata/flux/stdlib/kafka"
+	kafka "github.com/segmentio/kafka-go"
+)
+
+func TestFromKafka_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "from with defaults",
+			Raw:  `import "kafka" kafka.from(brokers:["brokerurl:8989"], topic:"metrics")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "fromKafka0",
+						Spec: &fkafka.FromKafkaOpSpec{
+							Brokers:     []string{"brokerurl:8989"},
+							Topic:       "metrics",
+							Decoder:     "line",
+							MaxMessages: fkafka.DefaultFromKafkaMaxMessages,
+							Timeout:     fkafka.DefaultFromKafkaTimeout,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "from with options",
+			Raw:  `import "kafka" kafka.from(brokers:["a:9092", "b:9092"], topic:"metrics", groupID:"flux", decoder:"json", maxMessages:10, timeout:1s)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "fromKafka0",
+						Spec: &fkafka.FromKafkaOpSpec{
+							Brokers:     []string{"a:9092", "b:9092"},
+							Topic:       "metrics",
+							GroupID:     "flux",
+							Decoder:     "json",
+							MaxMessages: 10,
+							Timeout:     time.Second,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:    "no brokers",
+			Raw:     `import "kafka" kafka.from(brokers:[], topic:"metrics")`,
+			WantErr: true,
+		},
+		{
+			Name:    "invalid decoder",
+			Raw:     `import "kafka" kafka.from(brokers:["a:9092"], topic:"metrics", decoder:"avro")`,
+			WantErr: true,
+		},
+		{
+			Name:    "invalid max messages",
+			Raw:     `import "kafka" kafka.from(brokers:["a:9092"], topic:"metrics", maxMessages:0)`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+// kafkaReaderMock returns its messages and then blocks until the context is done.
+type kafkaReaderMock struct {
+	config kafka.ReaderConfig
+	msgs   []kafka.Message
+	err    error
+	closed bool
+}
+
+func (k *kafkaReaderMock) Close() error {
+	k.closed = true
+	return nil
+}
+
+func (k *kafkaReaderMock) ReadMessage(ctx context.Context) (kafka.Message, error) {
+	if len(k.msgs) == 0 {
+		if k.err != nil {
+			return kafka.Message{}, k.err
+		}
+		<-ctx.Done()
+		return kafka.Message{}, ctx.Err()
+	}
+	msg := k.msgs[0]
+	k.msgs = k.msgs[1:]
+	return msg, nil
+}
+
+func TestFromKafka_Run(t *testing.T) {
+	t0 := time.Unix(0, 10).UTC()
+	t1 := time.Unix(0, 20).UTC()
+	testCases := []struct {
+		name    string
+		spec    *fkafka.FromKafkaOpSpec
+		msgs    []kafka.Message
+		err     error
+		want    []*executetest.Table
+		wantErr bool
+	}{
+		{
+			name: "line stops at max messages",
+			spec: &fkafka.FromKafkaOpSpec{Decoder: "line", MaxMessages: 2, Timeout: time.Minute},
+			msgs: []kafka.Message{
+				{Time: t0, Value: []byte("cpu,host=a usage=1\ncpu,host=a usage=2 30\n")},
+				{Time: t1, Value: []byte("cpu,host=b usage=3")},
+				{Time: t1, Value: []byte("cpu,host=b usage=4")},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"_measurement", "host", "_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(10), 1.0, "cpu", "a", "usage"},
+						{execute.Time(30), 2.0, "cpu", "a", "usage"},
+					},
+				},
+				{
+					KeyCols: []string{"_measurement", "host", "_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(20), 3.0, "cpu", "b", "usage"},
+					},
+				},
+			},
+		},
+		{
+			name: "line parse error",
+			spec: &fkafka.FromKafkaOpSpec{Decoder: "line", MaxMessages: 1, Timeout: time.Minute},
+			msgs: []kafka.Message{
+				{Time: t0, Value: []byte("not line protocol")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "json stops at timeout",
+			spec: &fkafka.FromKafkaOpSpec{Decoder: "json", MaxMessages: 100, Timeout: 10 * time.Millisecond},
+			msgs: []kafka.Message{
+				{Value: []byte(`{"host": "a", "_value": 1}`)},
+				{Value: []byte(`[{"host": "b", "_value": 2}, {"host": "c", "_value": 3.5}]`)},
+			},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "host", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{"a", 1.0},
+					{"b", 2.0},
+					{"c", 3.5},
+				},
+			}},
+		},
+		{
+			name: "csv",
+			spec: &fkafka.FromKafkaOpSpec{Decoder: "csv", MaxMessages: 1, Timeout: time.Minute},
+			msgs: []kafka.Message{
+				{Value: []byte(`#datatype,string,long,string,double
+#group,false,false,true,false
+#default,_result,,,
+,result,table,host,_value
+,,0,a,0.42
+`)},
+			},
+			want: []*executetest.Table{{
+				KeyCols: []string{"host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "host", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{"a", 0.42},
+				},
+			}},
+		},
+		{
+			name:    "read error",
+			spec:    &fkafka.FromKafkaOpSpec{Decoder: "line", MaxMessages: 10, Timeout: time.Minute},
+			err:     errors.New("broker unavailable"),
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.spec.Brokers = []string{"broker:9092"}
+			tc.spec.Topic = "metrics"
+			tc.spec.GroupID = "flux"
+			reader := &kafkaReaderMock{msgs: tc.msgs, err: tc.err}
+			fkafka.DefaultKafkaReaderFactory = func(conf kafka.ReaderConfig) fkafka.KafkaReader {
+				reader.config = conf
+				return reader
+			}
+
+			id := executetest.RandomDatasetID()
+			d := executetest.NewDataset(id)
+			c := execute.NewTableBuilderCache(executetest.UnlimitedAllocator)
+			c.SetTriggerSpec(plan.DefaultTriggerSpec)
+			src := fkafka.NewKafkaSource(&fkafka.FromKafkaProcedureSpec{Spec: tc.spec}, id, executetest.UnlimitedAllocator)
+			src.AddTransformation(executetest.NewYieldTransformation(d, c))
+			src.Run(context.Background())
+
+			if !reader.closed {
+				t.Error("reader was not closed")
+			}
+			if reader.config.GroupID != "flux" || reader.config.Topic != "metrics" {
+				t.Errorf("unexpected reader config %+v", reader.config)
+			}
+			if tc.wantErr {
+				if d.FinishedErr == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if d.FinishedErr != nil {
+				t.Fatal(d.FinishedErr)
+			}
+			got, err := executetest.TablesFromCache(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			executetest.NormalizeTables(got)
+			executetest.NormalizeTables(tc.want)
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
@@ -1,22 +1,18 @@
 package kafka
 
 import (
-	"bufio"
 	"context"
 	"encoding/binary"
 	"fmt"
 	"io"
 	"sort"
-	"time"
 
 	"github.com/cespare/xxhash"
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/execute"
-	"github.com/influxdata/flux/internal/pkg/syncutil"
+	"github.com/influxdata/flux/lineprotocol"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/semantic"
-	"github.com/influxdata/flux/values"
-	protocol "github.com/influxdata/line-protocol"
 	"github.com/pkg/errors"
 	kafka "github.com/segmentio/kafka-go"
 )
@@ -244,35 +240,6 @@ func NewToKafkaTransformation(d execute.Dataset, cache execute.TableBuilderCache
 	}
 }
 
-type toKafkaMetric struct {
-	tags   []*protocol.Tag
-	fields []*protocol.Field
-	name   string
-	t      time.Time
-}
-
-func (m *toKafkaMetric) TagList() []*protocol.Tag {
-	return m.tags
-}
-func (m *toKafkaMetric) FieldList() []*protocol.Field {
-	return m.fields
-}
-func (m *toKafkaMetric) truncateTagsAndFields() {
-	m.fields = m.fields[:0]
-	m.tags = m.tags[:0]
-}
-func (m *toKafkaMetric) Name() string {
-	return m.name
-}
-func (m *toKafkaMetric) Time() time.Time {
-	return m.t
-}
-
-type idxType struct {
-	Idx  int
-	Type flux.ColType
-}
-
 func (t *ToKafkaTransformation) Process(id execute.DatasetID, tbl flux.Table) (err error) {
 	w := DefaultKafkaWriterFactory(kafka.WriterConfig{
 		Brokers:       t.spec.Spec.Brokers,
@@ -294,39 +261,16 @@ func (t *ToKafkaTransformation) Process(id execute.DatasetID, tbl flux.Table) (e
 			return
 		}
 	}()
-	pr, pw := io.Pipe() // TODO: replce the pipe with something faster
-	// I'd like a linereader in line-protocol
-	m := &toKafkaMetric{}
-	e := protocol.NewEncoder(pw)
-	e.FailOnFieldErr(true)
-	e.SetFieldSortOrder(protocol.SortFields)
-	cols := tbl.Cols()
-	labels := make(map[string]idxType, len(cols))
-	for i, col := range cols {
-		labels[col.Label] = idxType{Idx: i, Type: col.Type}
-	}
-	// do time
-	timeColLabel := t.spec.Spec.TimeColumn
-	timeColIdx, ok := labels[timeColLabel]
-	if !ok {
-		return errors.New("Could not get time column")
-	}
-	if timeColIdx.Type != flux.TTime {
-		return fmt.Errorf("column %s is not of type %s", timeColLabel, timeColIdx.Type)
-	}
-	var measurementNameCol string
-	if t.spec.Spec.Name == "" {
-		measurementNameCol = t.spec.Spec.NameColumn
-	}
-	// check if each col is a tag or value and cache this value for the loop
-	colMetadatas := tbl.Cols()
-	isTag := make([]bool, len(colMetadatas))
-	isValue := make([]bool, len(colMetadatas))
-	for i, col := range colMetadatas {
-		isValue[i] = sort.SearchStrings(t.spec.Spec.ValueColumns, col.Label) < len(t.spec.Spec.ValueColumns) && t.spec.Spec.ValueColumns[sort.SearchStrings(t.spec.Spec.ValueColumns, col.Label)] == col.Label
-		isTag[i] = sort.SearchStrings(t.spec.Spec.TagColumns, col.Label) < len(t.spec.Spec.TagColumns) && t.spec.Spec.TagColumns[sort.SearchStrings(t.spec.Spec.TagColumns, col.Label)] == col.Label
+	enc, err := lineprotocol.NewRowEncoder(tbl.Cols(), lineprotocol.EncoderConfig{
+		TimeColumn:        t.spec.Spec.TimeColumn,
+		Measurement:       t.spec.Spec.Name,
+		MeasurementColumn: t.spec.Spec.NameColumn,
+		TagColumns:        t.spec.Spec.TagColumns,
+		FieldColumns:      t.spec.Spec.ValueColumns,
+	})
+	if err != nil {
+		return err
 	}
-	m.name = t.spec.Spec.Name
 
 	builder, new := t.cache.TableBuilder(tbl.Key())
 	if new {
@@ -335,91 +279,39 @@ func (t *ToKafkaTransformation) Process(id execute.DatasetID, tbl flux.Table) (e
 		}
 	}
 
-	var wg syncutil.WaitGroup
-	wg.Do(func() error {
-		if err := tbl.Do(func(er flux.ColReader) error {
-			l := er.Len()
-			for i := 0; i < l; i++ {
-				m.truncateTagsAndFields()
-				for j, col := range er.Cols() {
-					switch {
-					case col.Label == timeColLabel:
-						m.t = values.Time(er.Times(j).Value(i)).Time()
-					case measurementNameCol != "" && measurementNameCol == col.Label:
-						if col.Type != flux.TString {
-							return errors.New("invalid type for measurement column")
-						}
-						m.name = er.Strings(j).ValueString(i)
-					case isTag[j]:
-						if col.Type != flux.TString {
-							return errors.New("invalid type for measurement column")
-						}
-						m.tags = append(m.tags, &protocol.Tag{Key: col.Label, Value: er.Strings(j).ValueString(i)})
-					case isValue[j]:
-						switch col.Type {
-						case flux.TFloat:
-							m.fields = append(m.fields, &protocol.Field{Key: col.Label, Value: er.Floats(j).Value(i)})
-						case flux.TInt:
-							m.fields = append(m.fields, &protocol.Field{Key: col.Label, Value: er.Ints(j).Value(i)})
-						case flux.TUInt:
-							m.fields = append(m.fields, &protocol.Field{Key: col.Label, Value: er.UInts(j).Value(i)})
-						case flux.TString:
-							m.fields = append(m.fields, &protocol.Field{Key: col.Label, Value: er.Strings(j).ValueString(i)})
-						case flux.TTime:
-							m.fields = append(m.fields, &protocol.Field{Key: col.Label, Value: values.Time(er.Times(j).Value(i))})
-						case flux.TBool:
-							m.fields = append(m.fields, &protocol.Field{Key: col.Label, Value: er.Bools(j).Value(i)})
-						default:
-							return fmt.Errorf("invalid type for column %s", col.Label)
-						}
+	// write a message to kafka for every line
+	var msgBuf []kafka.Message
+	if err := tbl.Do(func(er flux.ColReader) error {
+		for i := 0; i < er.Len(); i++ {
+			line, err := enc.AppendRow(nil, er, i)
+			if err != nil {
+				return err
+			}
+			if len(line) > 0 {
+				v := line[:len(line)-1] // without the trailing newline
+				key := make([]byte, 8)
+				binary.LittleEndian.PutUint64(key, xxhash.Sum64(v))
+				msgBuf = append(msgBuf, kafka.Message{Key: key, Value: v})
+				if len(msgBuf) == t.spec.Spec.MsgBufSize {
+					if err := w.WriteMessages(context.Background(), msgBuf...); err != nil {
+						return err
 					}
-				}
-				_, err := e.Encode(m)
-				if err != nil {
-					return err
-				}
-				if err := execute.AppendRecord(i, er, builder); err != nil {
-					return err
+					msgBuf = nil
 				}
 			}
-			return nil
-		}); err != nil {
-			_ = pw.Close()
-			return err
-		}
-		return pw.Close()
-	})
-	// write the data to kafka
-	{
-		scan := bufio.NewScanner(pr)
-		msgBuf := make([]kafka.Message, 128)
-		i := 0
-		// todo, make this a little more async
-		for scan.Scan() {
-			v := append([]byte(nil), scan.Bytes()...) // we do this since scan.Bytes()'s result can be overwritten by calls to Scan()
-			if cap(msgBuf[i].Key) != 8 {
-				msgBuf[i].Key = make([]byte, 8)
-			}
-			binary.LittleEndian.PutUint64(msgBuf[i].Key, xxhash.Sum64(v))
-			msgBuf[i].Value = v
-			if i == t.spec.Spec.MsgBufSize-1 {
-				if err = w.WriteMessages(context.Background(), msgBuf...); err != nil {
-					return err
-				}
-				msgBuf = msgBuf[:0]
-				i = 0
+			if err := execute.AppendRecord(i, er, builder); err != nil {
+				return err
 			}
-			i++
-		}
-		// send the remainder of the messages
-		if len(msgBuf) > 0 {
-			err = w.WriteMessages(context.Background(), msgBuf[:i]...)
 		}
-	}
-	if err := wg.Wait(); err != nil {
+		return nil
+	}); err != nil {
 		return err
 	}
-	return err
+	// send the remainder of the messages
+	if len(msgBuf) > 0 {
+		return w.WriteMessages(context.Background(), msgBuf...)
+	}
+	return nil
 }
 
 func (t *ToKafkaTransformation) UpdateWatermark(id execute.DatasetID, pt execute.Time) error {
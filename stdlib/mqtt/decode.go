@@ -0,0 +1,90 @@
+package mqtt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/influxdata/flux/lineprotocol"
+	"github.com/influxdata/flux/values"
+	"github.com/pkg/errors"
+)
+
+// jsonPoint is the schema that mqtt.to writes with format: "JSON".
+// The time is either nanoseconds since the epoch or an RFC3339 string.
+type jsonPoint struct {
+	Measurement string                     `json:"measurement"`
+	Tags        map[string]string          `json:"tags"`
+	Values      map[string]json.RawMessage `json:"values"`
+	Time        json.RawMessage            `json:"time"`
+}
+
+// parseJSON parses a payload holding a point or an array of points.
+// Numbers become floats, points without a time get the time now.
+func parseJSON(payload []byte, now values.Time) ([]lineprotocol.Point, error) {
+	payload = bytes.TrimSpace(payload)
+	var jps []jsonPoint
+	if len(payload) > 0 && payload[0] == '[' {
+		if err := json.Unmarshal(payload, &jps); err != nil {
+			return nil, err
+		}
+	} else {
+		var jp jsonPoint
+		if err := json.Unmarshal(payload, &jp); err != nil {
+			return nil, err
+		}
+		jps = append(jps, jp)
+	}
+
+	points := make([]lineprotocol.Point, 0, len(jps))
+	for _, jp := range jps {
+		if len(jp.Measurement) == 0 {
+			return nil, errors.New("missing measurement")
+		}
+		p := lineprotocol.Point{
+			Measurement: jp.Measurement,
+			Tags:        jp.Tags,
+			Fields:      make(map[string]interface{}, len(jp.Values)),
+			Time:        now,
+		}
+		for k, raw := range jp.Values {
+			var v interface{}
+			if err := json.Unmarshal(raw, &v); err != nil {
+				return nil, err
+			}
+			switch v.(type) {
+			case float64, string, bool:
+				p.Fields[k] = v
+			default:
+				return nil, fmt.Errorf("value %s must be a number, string or boolean", k)
+			}
+		}
+		if len(jp.Time) > 0 && string(jp.Time) != "null" {
+			t, err := parseJSONTime(jp.Time)
+			if err != nil {
+				return nil, err
+			}
+			p.Time = t
+		}
+		points = append(points, p)
+	}
+	return points, nil
+}
+
+func parseJSONTime(raw json.RawMessage) (values.Time, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return 0, err
+		}
+		return values.ConvertTime(t), nil
+	}
+	ns, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %s", raw)
+	}
+	return values.Time(ns), nil
+}
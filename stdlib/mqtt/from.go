@@ -0,0 +1,457 @@
+package mqtt
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	MQTT "github.com/eclipse/paho.mqtt.golang"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/line"
+	"github.com/influxdata/flux/lineprotocol"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	"github.com/pkg/errors"
+)
+
+const (
+	FromMQTTKind = "fromMQTT"
+	// DefaultFromMQTTDuration is how long mqtt.from listens when duration isn't set.
+	DefaultFromMQTTDuration = 10 * time.Second
+)
+
+// FromMQTTDecoders are the decoders that mqtt.from can use to read the payloads.
+// lp is line protocol, json is the schema written by mqtt.to with format: "JSON"
+// and raw keeps every payload as a string.
+var FromMQTTDecoders = []string{"lp", "json", "raw"}
+
+type FromMQTTOpSpec struct {
+	Broker   string        `json:"broker"`
+	Topic    string        `json:"topic"`
+	QoS      int           `json:"qos"`
+	ClientID string        `json:"clientid"`
+	Username string        `json:"username"`
+	Password string        `json:"password"`
+	Decoder  string        `json:"decoder"`
+	Duration time.Duration `json:"duration"`
+	Count    int           `json:"count"`
+	Timeout  time.Duration `json:"timeout"`
+}
+
+func init() {
+	fromMQTTSignature := semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			"broker":   semantic.String,
+			"topic":    semantic.String,
+			"qos":      semantic.Int,
+			"clientid": semantic.String,
+			"username": semantic.String,
+			"password": semantic.String,
+			"decoder":  semantic.String,
+			"duration": semantic.Duration,
+			"count":    semantic.Int,
+			"timeout":  semantic.Duration,
+		},
+		Required: semantic.LabelSet{"broker", "topic"},
+		Return:   flux.TableObjectType,
+	}
+	flux.RegisterPackageValue("mqtt", "from", flux.FunctionValue(FromMQTTKind, createFromMQTTOpSpec, fromMQTTSignature))
+	flux.RegisterOpSpec(FromMQTTKind, func() flux.OperationSpec { return &FromMQTTOpSpec{} })
+	plan.RegisterProcedureSpec(FromMQTTKind, newFromMQTTProcedure, FromMQTTKind)
+	execute.RegisterSource(FromMQTTKind, createFromMQTTSource)
+}
+
+// MQTTClient is what mqtt.from and mqtt.to need from a client, it is injectable for testing.
+type MQTTClient interface {
+	Connect() error
+	// Subscribe calls handler for every message published on the topic until Disconnect is called.
+	Subscribe(topic string, qos byte, handler func(topic string, payload []byte)) error
+	Publish(topic string, qos byte, payload string) error
+	Disconnect()
+}
+
+// DefaultMQTTClientFactory makes the MQTTClient used by mqtt.from and mqtt.to.
+var DefaultMQTTClientFactory = func(opts *MQTT.ClientOptions) MQTTClient {
+	return &pahoClient{client: MQTT.NewClient(opts)}
+}
+
+type pahoClient struct {
+	client MQTT.Client
+}
+
+func (c *pahoClient) Connect() error {
+	token := c.client.Connect()
+	token.Wait()
+	return token.Error()
+}
+
+func (c *pahoClient) Subscribe(topic string, qos byte, handler func(topic string, payload []byte)) error {
+	token := c.client.Subscribe(topic, qos, func(_ MQTT.Client, msg MQTT.Message) {
+		handler(msg.Topic(), msg.Payload())
+	})
+	token.Wait()
+	return token.Error()
+}
+
+func (c *pahoClient) Publish(topic string, qos byte, payload string) error {
+	token := c.client.Publish(topic, qos, false, payload)
+	token.Wait()
+	return token.Error()
+}
+
+func (c *pahoClient) Disconnect() {
+	c.client.Disconnect(250)
+}
+
+// ReadArgs loads a flux.Arguments into FromMQTTOpSpec.  It sets several default values.
+// If duration isn't set, it defaults to DefaultFromMQTTDuration, also when count is set,
+// so that reading always ends. Reading stops at whichever of duration and count comes first.
+// If the client id isn't set, a unique one is generated when the source connects.
+// If the decoder isn't set, it defaults to lp.
+func (o *FromMQTTOpSpec) ReadArgs(args flux.Arguments) error {
+	var err error
+	o.Broker, err = args.GetRequiredString("broker")
+	if err != nil {
+		return err
+	}
+	o.Topic, err = args.GetRequiredString("topic")
+	if err != nil {
+		return err
+	}
+	if len(o.Topic) == 0 {
+		return errors.New("invalid topic")
+	}
+
+	q, ok, err := args.GetInt("qos")
+	if err != nil {
+		return err
+	}
+	if ok {
+		if q < 0 || q > 2 {
+			return fmt.Errorf("qos must be 0, 1 or 2, not %d", q)
+		}
+		o.QoS = int(q)
+	}
+
+	o.ClientID, _, err = args.GetString("clientid")
+	if err != nil {
+		return err
+	}
+	o.Username, _, err = args.GetString("username")
+	if err != nil {
+		return err
+	}
+	o.Password, _, err = args.GetString("password")
+	if err != nil {
+		return err
+	}
+	if len(o.Username) > 0 && len(o.Password) <= 0 {
+		return fmt.Errorf("Password required with username %s", o.Username)
+	}
+
+	o.Decoder, ok, err = args.GetString("decoder")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		o.Decoder = FromMQTTDecoders[0]
+	}
+	if !contains(FromMQTTDecoders, o.Decoder) {
+		return fmt.Errorf("invalid decoder %s, must be one of %v", o.Decoder, FromMQTTDecoders)
+	}
+
+	duration, durationSet, err := args.GetDuration("duration")
+	if err != nil {
+		return err
+	}
+	if durationSet {
+		if duration <= 0 {
+			return errors.New("duration must be greater than zero")
+		}
+		o.Duration = time.Duration(duration)
+	}
+	count, countSet, err := args.GetInt("count")
+	if err != nil {
+		return err
+	}
+	if countSet {
+		if count <= 0 {
+			return errors.New("count must be greater than zero")
+		}
+		o.Count = int(count)
+	}
+	if !durationSet {
+		o.Duration = DefaultFromMQTTDuration
+	}
+
+	timeout, ok, err := args.GetDuration("timeout")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		o.Timeout = DefaultToMQTTTimeout
+	} else {
+		o.Timeout = time.Duration(timeout)
+	}
+	return nil
+}
+
+// newClientID generates a client id, so that the brokers don't disconnect
+// a client because another one connected with the same id.
+func newClientID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "failed to generate mqtt client id")
+	}
+	return "flux-mqtt-" + hex.EncodeToString(b), nil
+}
+
+func contains(ss []string, s string) bool {
+	for _, st := range ss {
+		if st == s {
+			return true
+		}
+	}
+	return false
+}
+
+func createFromMQTTOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	s := new(FromMQTTOpSpec)
+	if err := s.ReadArgs(args); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (FromMQTTOpSpec) Kind() flux.OperationKind {
+	return FromMQTTKind
+}
+
+type FromMQTTProcedureSpec struct {
+	plan.DefaultCost
+	Spec *FromMQTTOpSpec
+}
+
+func (o *FromMQTTProcedureSpec) Kind() plan.ProcedureKind {
+	return FromMQTTKind
+}
+
+func (o *FromMQTTProcedureSpec) Copy() plan.ProcedureSpec {
+	s := *o.Spec
+	return &FromMQTTProcedureSpec{Spec: &s}
+}
+
+func newFromMQTTProcedure(qs flux.OperationSpec, a plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*FromMQTTOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	return &FromMQTTProcedureSpec{Spec: spec}, nil
+}
+
+func createFromMQTTSource(s plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
+	spec, ok := s.(*FromMQTTProcedureSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", s)
+	}
+	return NewMQTTSource(spec, &nowTimeProvider{}, dsid, a.Allocator()), nil
+}
+
+// nowTimeProvider provides wall clock time.
+type nowTimeProvider struct{}
+
+func (a *nowTimeProvider) CurrentTime() values.Time {
+	return values.ConvertTime(time.Now())
+}
+
+// NewMQTTSource creates a source that subscribes with a client from DefaultMQTTClientFactory.
+// The time provider gives the time that a message was received,
+// which is the time of points without a timestamp and of raw payloads.
+// The tables are built with alloc.
+func NewMQTTSource(spec *FromMQTTProcedureSpec, tp line.TimeProvider, dsid execute.DatasetID, alloc *memory.Allocator) execute.Source {
+	return &mqttSource{
+		d:     dsid,
+		spec:  spec.Spec,
+		tp:    tp,
+		alloc: alloc,
+	}
+}
+
+type mqttSource struct {
+	d     execute.DatasetID
+	spec  *FromMQTTOpSpec
+	tp    line.TimeProvider
+	alloc *memory.Allocator
+	ts    []execute.Transformation
+}
+
+// mqttMessage is a message that has been received.
+type mqttMessage struct {
+	topic   string
+	payload []byte
+	t       values.Time
+}
+
+func (ms *mqttSource) AddTransformation(t execute.Transformation) {
+	ms.ts = append(ms.ts, t)
+}
+
+func (ms *mqttSource) Run(ctx context.Context) {
+	err := ms.run(ctx)
+	for _, t := range ms.ts {
+		t.Finish(ms.d, err)
+	}
+}
+
+func (ms *mqttSource) run(ctx context.Context) error {
+	msgs, err := ms.subscribe(ctx)
+	if err != nil {
+		return err
+	}
+	return ms.decode(msgs, func(tbl flux.Table) error {
+		for _, t := range ms.ts {
+			if err := t.Process(ms.d, tbl); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// subscribe collects messages until count messages have been received or the duration has passed.
+func (ms *mqttSource) subscribe(ctx context.Context) ([]mqttMessage, error) {
+	opts := MQTT.NewClientOptions().AddBroker(ms.spec.Broker)
+	clientID := ms.spec.ClientID
+	if clientID == "" {
+		id, err := newClientID()
+		if err != nil {
+			return nil, err
+		}
+		clientID = id
+	}
+	opts.SetClientID(clientID)
+	if ms.spec.Timeout > 0 {
+		opts.SetConnectTimeout(ms.spec.Timeout)
+	}
+	if len(ms.spec.Username) > 0 {
+		opts.SetUsername(ms.spec.Username)
+		opts.SetPassword(ms.spec.Password)
+	}
+	client := DefaultMQTTClientFactory(opts)
+	if err := client.Connect(); err != nil {
+		return nil, errors.Wrap(err, "failed to connect to mqtt broker")
+	}
+	defer client.Disconnect()
+
+	var (
+		mu   sync.Mutex
+		msgs []mqttMessage
+		done bool
+		full = make(chan struct{})
+	)
+	handler := func(topic string, payload []byte) {
+		mu.Lock()
+		defer mu.Unlock()
+		if done {
+			return
+		}
+		msgs = append(msgs, mqttMessage{
+			topic:   topic,
+			payload: append([]byte(nil), payload...),
+			t:       ms.tp.CurrentTime(),
+		})
+		if ms.spec.Count > 0 && len(msgs) >= ms.spec.Count {
+			done = true
+			close(full)
+		}
+	}
+	if err := client.Subscribe(ms.spec.Topic, byte(ms.spec.QoS), handler); err != nil {
+		return nil, errors.Wrap(err, "failed to subscribe to mqtt topic")
+	}
+
+	duration := ms.spec.Duration
+	if duration <= 0 {
+		duration = DefaultFromMQTTDuration
+	}
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-full:
+	case <-timer.C:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	done = true
+	return msgs, nil
+}
+
+func (ms *mqttSource) decode(msgs []mqttMessage, f func(flux.Table) error) error {
+	switch ms.spec.Decoder {
+	case "raw":
+		return decodeRaw(msgs, ms.alloc, f)
+	case "lp", "json":
+		var points []lineprotocol.Point
+		for _, msg := range msgs {
+			var (
+				ps  []lineprotocol.Point
+				err error
+			)
+			if ms.spec.Decoder == "lp" {
+				ps, err = lineprotocol.ParsePoints(msg.payload, msg.t)
+			} else {
+				ps, err = parseJSON(msg.payload, msg.t)
+			}
+			if err != nil {
+				return errors.Wrapf(err, "failed to decode message on topic %s", msg.topic)
+			}
+			points = append(points, ps...)
+		}
+		return lineprotocol.SeriesTables(points, ms.alloc, f)
+	default:
+		return fmt.Errorf("unknown decoder type: %v", ms.spec.Decoder)
+	}
+}
+
+// decodeRaw produces a table with the `_time`, `topic` and `_value` columns
+// and a row for every message.
+func decodeRaw(msgs []mqttMessage, alloc *memory.Allocator, f func(flux.Table) error) error {
+	key := execute.NewGroupKey(nil, nil)
+	builder := execute.NewColListTableBuilder(key, alloc)
+	timeIdx, err := builder.AddCol(flux.ColMeta{Label: execute.DefaultTimeColLabel, Type: flux.TTime})
+	if err != nil {
+		return err
+	}
+	topicIdx, err := builder.AddCol(flux.ColMeta{Label: "topic", Type: flux.TString})
+	if err != nil {
+		return err
+	}
+	valueIdx, err := builder.AddCol(flux.ColMeta{Label: execute.DefaultValueColLabel, Type: flux.TString})
+	if err != nil {
+		return err
+	}
+	for _, msg := range msgs {
+		if err := builder.AppendTime(timeIdx, msg.t); err != nil {
+			return err
+		}
+		if err := builder.AppendString(topicIdx, msg.topic); err != nil {
+			return err
+		}
+		if err := builder.AppendString(valueIdx, string(msg.payload)); err != nil {
+			return err
+		}
+	}
+	tbl, err := builder.Table()
+	if err != nil {
+		return err
+	}
+	return f(tbl)
+}
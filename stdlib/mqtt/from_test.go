@@ -0,0 +1,335 @@
+package mqtt_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	MQTT "github.com/eclipse/paho.mqtt.golang"
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	_ "github.com/influxdata/flux/builtin" // We need to import the builtins for the tests to work.
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/mock"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/querytest"
+	fmqtt "github.com/influxdata/flux/stdlib/mqtt"
+)
+
+func TestFromMQTT_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "from with defaults",
+			Raw:  `import "mqtt" mqtt.from(broker: "tcp://localhost:1883", topic: "sensors/#")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "fromMQTT0",
+						Spec: &fmqtt.FromMQTTOpSpec{
+							Broker:   "tcp://localhost:1883",
+							Topic:    "sensors/#",
+							Decoder:  "lp",
+							Duration: fmqtt.DefaultFromMQTTDuration,
+							Timeout:  fmqtt.DefaultToMQTTTimeout,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "from with options",
+			Raw:  `import "mqtt" mqtt.from(broker: "tcp://localhost:1883", topic: "sensors/+/temp", qos: 1, clientid: "reader", username: "u", password: "p", decoder: "json", count: 5, timeout: 2s)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "fromMQTT0",
+						Spec: &fmqtt.FromMQTTOpSpec{
+							Broker:   "tcp://localhost:1883",
+							Topic:    "sensors/+/temp",
+							QoS:      1,
+							ClientID: "reader",
+							Username: "u",
+							Password: "p",
+							Decoder:  "json",
+							Duration: fmqtt.DefaultFromMQTTDuration,
+							Count:    5,
+							Timeout:  2 * time.Second,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:    "invalid qos",
+			Raw:     `import "mqtt" mqtt.from(broker: "tcp://localhost:1883", topic: "a", qos: 3)`,
+			WantErr: true,
+		},
+		{
+			Name:    "invalid decoder",
+			Raw:     `import "mqtt" mqtt.from(broker: "tcp://localhost:1883", topic: "a", decoder: "avro")`,
+			WantErr: true,
+		},
+		{
+			Name:    "invalid count",
+			Raw:     `import "mqtt" mqtt.from(broker: "tcp://localhost:1883", topic: "a", count: 0)`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+// mqttBrokerMock delivers its messages to subscribers of a matching topic
+// and records the messages that are published to it.
+type mqttBrokerMock struct {
+	opts         *MQTT.ClientOptions
+	msgs         map[string][]string
+	published    []mqttMessage
+	subscribed   string
+	qos          byte
+	disconnected bool
+}
+
+type mqttMessage struct {
+	Topic   string
+	Payload string
+}
+
+func (b *mqttBrokerMock) Connect() error {
+	return nil
+}
+
+func (b *mqttBrokerMock) Subscribe(topic string, qos byte, handler func(topic string, payload []byte)) error {
+	b.subscribed = topic
+	b.qos = qos
+	prefix := strings.TrimSuffix(topic, "#")
+	for _, t := range []string{"sensors/a", "sensors/b"} {
+		if !strings.HasPrefix(t, prefix) {
+			continue
+		}
+		for _, m := range b.msgs[t] {
+			handler(t, []byte(m))
+		}
+	}
+	return nil
+}
+
+func (b *mqttBrokerMock) Publish(topic string, qos byte, payload string) error {
+	b.published = append(b.published, mqttMessage{Topic: topic, Payload: payload})
+	return nil
+}
+
+func (b *mqttBrokerMock) Disconnect() {
+	b.disconnected = true
+}
+
+func TestFromMQTT_Run(t *testing.T) {
+	testCases := []struct {
+		name    string
+		spec    *fmqtt.FromMQTTOpSpec
+		msgs    map[string][]string
+		want    []*executetest.Table
+		wantErr bool
+	}{
+		{
+			name: "line protocol stops at count",
+			spec: &fmqtt.FromMQTTOpSpec{Topic: "sensors/#", Decoder: "lp", Count: 2},
+			msgs: map[string][]string{
+				"sensors/a": {
+					"cpu,host=a usage=1.5,state=\"idle\" 10\ncpu,host=a usage=2.5,state=\"busy\" 20",
+					`cpu,host=b usage=3i`,
+				},
+				"sensors/b": {
+					`cpu,host=c usage=4 40`,
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"_measurement", "host", "_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TString},
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(10), "idle", "cpu", "a", "state"},
+						{execute.Time(20), "busy", "cpu", "a", "state"},
+					},
+				},
+				{
+					KeyCols: []string{"_measurement", "host", "_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(10), 1.5, "cpu", "a", "usage"},
+						{execute.Time(20), 2.5, "cpu", "a", "usage"},
+					},
+				},
+				{
+					KeyCols: []string{"_measurement", "host", "_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TInt},
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "host", Type: flux.TString},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), int64(3), "cpu", "b", "usage"},
+					},
+				},
+			},
+		},
+		{
+			name: "json stops at duration",
+			spec: &fmqtt.FromMQTTOpSpec{Topic: "sensors/b", Decoder: "json", Duration: 10 * time.Millisecond},
+			msgs: map[string][]string{
+				"sensors/b": {
+					`{"measurement": "temp", "tags": {"room": "kitchen"}, "values": {"degrees": 21}, "time": 10}`,
+					`[{"measurement": "temp", "tags": {"room": "kitchen"}, "values": {"degrees": 22.5}, "time": "1970-01-01T00:00:00.00000002Z"}]`,
+				},
+			},
+			want: []*executetest.Table{{
+				KeyCols: []string{"_measurement", "room", "_field"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "room", Type: flux.TString},
+					{Label: "_field", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(10), 21.0, "temp", "kitchen", "degrees"},
+					{execute.Time(20), 22.5, "temp", "kitchen", "degrees"},
+				},
+			}},
+		},
+		{
+			name: "raw",
+			spec: &fmqtt.FromMQTTOpSpec{Topic: "sensors/#", Decoder: "raw", Count: 2},
+			msgs: map[string][]string{
+				"sensors/a": {"on"},
+				"sensors/b": {"off"},
+			},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "topic", Type: flux.TString},
+					{Label: "_value", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), "sensors/a", "on"},
+					{execute.Time(1), "sensors/b", "off"},
+				},
+			}},
+		},
+		{
+			name: "conflicting field types",
+			spec: &fmqtt.FromMQTTOpSpec{Topic: "sensors/a", Decoder: "lp", Count: 2},
+			msgs: map[string][]string{
+				"sensors/a": {`cpu usage=1 10`, `cpu usage="high" 20`},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid line protocol",
+			spec: &fmqtt.FromMQTTOpSpec{Topic: "sensors/a", Decoder: "lp", Count: 1},
+			msgs: map[string][]string{
+				"sensors/a": {`cpu`},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.spec.Broker = "tcp://localhost:1883"
+			tc.spec.ClientID = "flux"
+			tc.spec.QoS = 1
+			broker := &mqttBrokerMock{msgs: tc.msgs}
+			fmqtt.DefaultMQTTClientFactory = func(opts *MQTT.ClientOptions) fmqtt.MQTTClient {
+				broker.opts = opts
+				return broker
+			}
+
+			id := executetest.RandomDatasetID()
+			d := executetest.NewDataset(id)
+			c := execute.NewTableBuilderCache(executetest.UnlimitedAllocator)
+			c.SetTriggerSpec(plan.DefaultTriggerSpec)
+			src := fmqtt.NewMQTTSource(&fmqtt.FromMQTTProcedureSpec{Spec: tc.spec}, &mock.AscendingTimeProvider{}, id, executetest.UnlimitedAllocator)
+			src.AddTransformation(executetest.NewYieldTransformation(d, c))
+			src.Run(context.Background())
+
+			if !broker.disconnected {
+				t.Error("client was not disconnected")
+			}
+			if broker.subscribed != tc.spec.Topic || broker.qos != 1 {
+				t.Errorf("unexpected subscription to %s with qos %d", broker.subscribed, broker.qos)
+			}
+			if broker.opts.ClientID != "flux" {
+				t.Errorf("unexpected client id %s", broker.opts.ClientID)
+			}
+			if tc.wantErr {
+				if d.FinishedErr == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if d.FinishedErr != nil {
+				t.Fatal(d.FinishedErr)
+			}
+			got, err := executetest.TablesFromCache(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			executetest.NormalizeTables(got)
+			executetest.NormalizeTables(tc.want)
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestFromMQTT_ClientID(t *testing.T) {
+	var ids []string
+	for i := 0; i < 2; i++ {
+		broker := &mqttBrokerMock{msgs: map[string][]string{"sensors/a": {"on"}}}
+		fmqtt.DefaultMQTTClientFactory = func(opts *MQTT.ClientOptions) fmqtt.MQTTClient {
+			broker.opts = opts
+			return broker
+		}
+		spec := &fmqtt.FromMQTTOpSpec{Broker: "tcp://localhost:1883", Topic: "sensors/a", Decoder: "raw", Count: 1}
+		id := executetest.RandomDatasetID()
+		d := executetest.NewDataset(id)
+		c := execute.NewTableBuilderCache(executetest.UnlimitedAllocator)
+		c.SetTriggerSpec(plan.DefaultTriggerSpec)
+		src := fmqtt.NewMQTTSource(&fmqtt.FromMQTTProcedureSpec{Spec: spec}, &mock.AscendingTimeProvider{}, id, executetest.UnlimitedAllocator)
+		src.AddTransformation(executetest.NewYieldTransformation(d, c))
+		src.Run(context.Background())
+		if d.FinishedErr != nil {
+			t.Fatal(d.FinishedErr)
+		}
+		if !strings.HasPrefix(broker.opts.ClientID, "flux-mqtt-") {
+			t.Errorf("unexpected client id %s", broker.opts.ClientID)
+		}
+		ids = append(ids, broker.opts.ClientID)
+	}
+	if ids[0] == ids[1] {
+		t.Errorf("expected unique client ids, got %s twice", ids[0])
+	}
+}
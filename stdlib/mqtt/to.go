@@ -3,7 +3,6 @@ package mqtt
 import (
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/url"
 	"sort"
 	"time"
@@ -14,12 +13,9 @@ import (
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/execute"
-	"github.com/influxdata/flux/internal/pkg/syncutil"
+	"github.com/influxdata/flux/lineprotocol"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/semantic"
-	"github.com/influxdata/flux/values"
-	protocol "github.com/influxdata/line-protocol"
-	"github.com/pkg/errors"
 )
 
 const (
@@ -308,38 +304,6 @@ func NewToMQTTTransformation(d execute.Dataset, cache execute.TableBuilderCache,
 	}
 }
 
-type toMqttMetric struct {
-	tags   []*protocol.Tag
-	fields []*protocol.Field
-	name   string
-	t      time.Time
-}
-
-func (m *toMqttMetric) TagList() []*protocol.Tag {
-	return m.tags
-}
-func (m *toMqttMetric) FieldList() []*protocol.Field {
-	return m.fields
-}
-
-func (m *toMqttMetric) truncateTagsAndFields() {
-	m.fields = m.fields[:0]
-	m.tags = m.tags[:0]
-}
-
-func (m *toMqttMetric) Name() string {
-	return m.name
-}
-
-func (m *toMqttMetric) Time() time.Time {
-	return m.t
-}
-
-type idxType struct {
-	Idx  int
-	Type flux.ColType
-}
-
 func (t *ToMQTTTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
 	// set up the MQTT options.
 	opts := MQTT.NewClientOptions().AddBroker(t.spec.Spec.Broker)
@@ -359,55 +323,25 @@ func (t *ToMQTTTransformation) Process(id execute.DatasetID, tbl flux.Table) err
 	}
 	mqttTopic := t.spec.Spec.Topic
 
-	client := MQTT.NewClient(opts)
+	client := DefaultMQTTClientFactory(opts)
 	if len(t.spec.Spec.Message) > 0 {
 		
 		//create and start a client using the above ClientOptions
-		if token := client.Connect(); token.Wait() && token.Error() != nil {
-			return token.Error()
+		if err := client.Connect(); err != nil {
+			return err
 		}
-  		token := client.Publish(t.spec.Spec.Topic, 0, false, t.spec.Spec.Message)
-  		token.Wait()
-		client.Disconnect(250)
-		return nil
-	}
-	pr, pw := io.Pipe() // TODO: replce the pipe with something faster
-	m := &toMqttMetric{}
-	e := protocol.NewEncoder(pw)
-	e.FailOnFieldErr(true)
-	e.SetFieldSortOrder(protocol.SortFields)
-	cols := tbl.Cols()
-	labels := make(map[string]idxType, len(cols))
-	for i, col := range cols {
-		labels[col.Label] = idxType{Idx: i, Type: col.Type}
-	}
-
-	// do time
-	timeColLabel := t.spec.Spec.TimeColumn
-	timeColIdx, ok := labels[timeColLabel]
-
-	if !ok {
-		return errors.New("Could not get time column")
-	}
-	if timeColIdx.Type != flux.TTime {
-		return fmt.Errorf("column %s is not of type %s", timeColLabel, timeColIdx.Type)
-	}
-	var measurementNameCol string
-	if t.spec.Spec.Name == "" {
-		measurementNameCol = t.spec.Spec.NameColumn
-	}
-
-	// check if each col is a tag or value and cache this value for the loop
-	colMetadatas := tbl.Cols()
-	isTag := make([]bool, len(colMetadatas))
-	isValue := make([]bool, len(colMetadatas))
-
-	for i, col := range colMetadatas {
-		valIdx := sort.SearchStrings(t.spec.Spec.ValueColumns, col.Label)
-		isValue[i] = valIdx < len(t.spec.Spec.ValueColumns) && t.spec.Spec.ValueColumns[valIdx] == col.Label
-
-		tagIdx := sort.SearchStrings(t.spec.Spec.TagColumns, col.Label)
-		isTag[i] = tagIdx < len(t.spec.Spec.TagColumns) && t.spec.Spec.TagColumns[tagIdx] == col.Label
+		defer client.Disconnect()
+		return client.Publish(t.spec.Spec.Topic, 0, t.spec.Spec.Message)
+	}
+	enc, err := lineprotocol.NewRowEncoder(tbl.Cols(), lineprotocol.EncoderConfig{
+		TimeColumn:        t.spec.Spec.TimeColumn,
+		Measurement:       t.spec.Spec.Name,
+		MeasurementColumn: t.spec.Spec.NameColumn,
+		TagColumns:        t.spec.Spec.TagColumns,
+		FieldColumns:      t.spec.Spec.ValueColumns,
+	})
+	if err != nil {
+		return err
 	}
 
 	builder, new := t.cache.TableBuilder(tbl.Key())
@@ -417,115 +351,45 @@ func (t *ToMQTTTransformation) Process(id execute.DatasetID, tbl flux.Table) err
 		}
 	}
 
-	var wg syncutil.WaitGroup
-	wg.Do(func() error {
-		m.name = t.spec.Spec.Name
-		err := tbl.Do(func(er flux.ColReader) error {
-			l := er.Len()
-			for i := 0; i < l; i++ {
-				m.truncateTagsAndFields()
-				for j, col := range er.Cols() {
-					switch {
-					case col.Label == timeColLabel:
-						m.t = values.Time(er.Times(j).Value(i)).Time()
-					case measurementNameCol != "" && measurementNameCol == col.Label:
-						if col.Type != flux.TString {
-							return errors.New("invalid type for measurement column")
-						}
-						m.name = er.Strings(j).ValueString(i)
-					case isTag[j]:
-						if col.Type != flux.TString {
-							return errors.New("invalid type for tag column")
-						}
-						m.tags = append(m.tags, &protocol.Tag{Key: col.Label, Value: er.Strings(j).ValueString(i)})
-
-					case isValue[j]:
-						switch col.Type {
-						case flux.TFloat:
-							m.fields = append(m.fields, &protocol.Field{Key: col.Label, Value: er.Floats(j).Value(i)})
-						case flux.TInt:
-							m.fields = append(m.fields, &protocol.Field{Key: col.Label, Value: er.Ints(j).Value(i)})
-						case flux.TUInt:
-							m.fields = append(m.fields, &protocol.Field{Key: col.Label, Value: er.UInts(j).Value(i)})
-						case flux.TString:
-							m.fields = append(m.fields, &protocol.Field{Key: col.Label, Value: er.Strings(j).ValueString(i)})
-						case flux.TTime:
-							m.fields = append(m.fields, &protocol.Field{Key: col.Label, Value: values.Time(er.Times(j).Value(i))})
-						case flux.TBool:
-							m.fields = append(m.fields, &protocol.Field{Key: col.Label, Value: er.Bools(j).Value(i)})
-						default:
-							return fmt.Errorf("invalid type for column %s", col.Label)
-						}
-					}
+	//start a client using the above ClientOptions
+	if err := client.Connect(); err != nil {
+		return err
+	}
+	defer client.Disconnect()
+
+	// publish a message for every line of line protocol
+	var line []byte
+	return tbl.Do(func(er flux.ColReader) error {
+		for i := 0; i < er.Len(); i++ {
+			line, err = enc.AppendRow(line[:0], er, i)
+			if err != nil {
+				return err
+			}
+			if len(line) > 0 {
+				// every message is a single point, without the trailing newline
+				message := string(line[:len(line)-1])
+				topic := mqttTopic
+				if len(topic) <= 0 { // No topic set? Create topic out of tags
+					topic = createTopic(message)
 				}
-				_, err := e.Encode(m)
-				if err != nil {
-					return err
+				if t.spec.Spec.Format == "JSON" { // format message as a JSON
+					b := formatJSON(message)
+					message = b.String()
 				}
-
-				if err := execute.AppendRecord(i, er, builder); err != nil {
+				if err := client.Publish(topic, 0, message); err != nil {
 					return err
 				}
 			}
-			return nil
-		})
-		if e := pw.Close(); e != nil && err == nil {
-			err = e
-		}
-		return err
-	})
-	
-//start a client using the above ClientOptions
-	if token := client.Connect(); token.Wait() && token.Error() != nil {
-		return token.Error()
-	}
-	p := make([]byte, 2024) 
-	var message strings.Builder
-	lines := 0
-	// messages come in as triples: measurement & tags, values, timestamp
-	// put them all together and you have line-protocol! 
-	for {
-		n, err := pr.Read(p)
-		if err != nil{
-		    if err == io.EOF {
-				message.WriteString(string(p[:n]))
-				break
-			}
-			client.Disconnect(250)
-			return err
-		}
-		message.WriteString(string(p[:n])) // handle leftovers
-		lines += 1
-		if lines > 2 { // post message after full row is read.
-			if len(mqttTopic) <= 0 {// No topic set? Create topic out of tags
-				mqttTopic = m.createTopic(message.String())				
-			}
-			if t.spec.Spec.Format == "JSON" { // format message as a JSON
-				message = m.formatJSON(message.String())
+			if err := execute.AppendRecord(i, er, builder); err != nil {
+				return err
 			}
-			token := client.Publish(mqttTopic, 0, false, message.String())
-			token.Wait()
-			lines = 0
-			message.Reset()
 		}
-	}	
-	if len(message.String()) > 0 { // if any leftover messages are there, write them out. 
-		if len(mqttTopic) <= 0 {// create topic out of tags
-			mqttTopic = m.createTopic(message.String())				
-		}
-		token := client.Publish(mqttTopic, 0, false, message.String())
-	  	token.Wait()
-	}
-	if err := wg.Wait(); err != nil {
-		client.Disconnect(250)
-		return err
-	}
-	client.Disconnect(250)
-	return nil
-
+		return nil
+	})
 }
+
 // format the message as json
-func (t *toMqttMetric) formatJSON(message string) strings.Builder {
+func formatJSON(message string) strings.Builder {
 	var b strings.Builder
 	b.WriteString("{ \"measurement\": \"")
 	at := strings.Split(message, " ")
@@ -533,7 +397,7 @@ func (t *toMqttMetric) formatJSON(message string) strings.Builder {
 	if len(as) > 1 {
 		b.WriteString(as[0])
 		b.WriteString("\"")
-		b.WriteString(t.parseTags(as[1]))
+		b.WriteString(parseTags(as[1]))
 	} else {
 		b.WriteString(at[0])
 		b.WriteString("\"")
@@ -593,7 +457,7 @@ func (t *toMqttMetric) formatJSON(message string) strings.Builder {
 
 }
 // add all tags to the JSON
-func (t *toMqttMetric) parseTags(tags string) string{
+func parseTags(tags string) string{
 	var mess strings.Builder
 	mess.WriteString("\", \"tags\": { ")
 	as := strings.Split(tags, ",")
@@ -622,7 +486,7 @@ func (t *toMqttMetric) parseTags(tags string) string{
 	return mess.String()
 }
  // creates a topic consisting of measurement/tagname/tagvalue for all tags
-func (t *toMqttMetric) createTopic(topicString string) string {
+func createTopic(topicString string) string {
 	var top strings.Builder
 	tt := strings.Split(topicString, " ") 
 	tt = strings.Split(tt[0], ",")
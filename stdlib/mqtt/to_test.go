@@ -1,21 +1,18 @@
 package mqtt_test
 
 import (
-	"io/ioutil"
-	
-	"net/http/httptest"
-	"sync"
 	"testing"
 	"time"
 
 	MQTT "github.com/eclipse/paho.mqtt.golang"
+	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/flux"
 	_ "github.com/influxdata/flux/builtin" // We need to import the builtins for the tests to work.
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/execute/executetest"
 	"github.com/influxdata/flux/querytest"
-	fmqtt "github.com/influxdata/flux/stdlib/mqtt"
 	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
+	fmqtt "github.com/influxdata/flux/stdlib/mqtt"
 )
 
 func TestToMQTT_NewQuery(t *testing.T) {
@@ -24,7 +21,7 @@ func TestToMQTT_NewQuery(t *testing.T) {
 			Name: "from with database with range",
 			Raw: `
 import "mqtt"
-from(bucket:"mybucket") |> mqtt.to(url: "https://localhost:8081", name:"series1", method:"POST",  timeout: 50s)`,
+from(bucket:"mybucket") |> mqtt.to(broker: "tcp://localhost:1883", topic: "sensors", name:"series1", timeout: 50s)`,
 			Want: &flux.Spec{
 				Operations: []*flux.Operation{
 					{
@@ -34,23 +31,20 @@ from(bucket:"mybucket") |> mqtt.to(url: "https://localhost:8081", name:"series1"
 						},
 					},
 					{
-						ID: "toHTTP1",
-						Spec: &fhttp.ToMQTTOpSpec{
-							URL:          "https://localhost:8081",
+						ID: "toMQTT1",
+						Spec: &fmqtt.ToMQTTOpSpec{
+							Broker:       "tcp://localhost:1883",
+							Topic:        "sensors",
 							Name:         "series1",
-							Method:       "POST",
+							ClientID:     "flux-mqtt",
 							Timeout:      50 * time.Second,
 							TimeColumn:   execute.DefaultTimeColLabel,
 							ValueColumns: []string{execute.DefaultValueColLabel},
-							Headers: map[string]string{
-								"Content-Type": "application/vnd.influx",
-								"User-Agent":   "fluxd/dev",
-							},
 						},
 					},
 				},
 				Edges: []flux.Edge{
-					{Parent: "from0", Child: "toHTTP1"},
+					{Parent: "from0", Child: "toMQTT1"},
 				},
 			},
 		},
@@ -66,12 +60,9 @@ from(bucket:"mybucket") |> mqtt.to(url: "https://localhost:8081", name:"series1"
 
 func TestToMQTTOpSpec_UnmarshalJSON(t *testing.T) {
 	type fields struct {
-		URL         string
-		Method      string
-		Headers     map[string]string
-		URLParams   map[string]string
-		Timeout     time.Duration
-		NoKeepAlive bool
+		Broker  string
+		Topic   string
+		Timeout time.Duration
 	}
 	tests := []struct {
 		name    string
@@ -83,43 +74,56 @@ func TestToMQTTOpSpec_UnmarshalJSON(t *testing.T) {
 			name: "happy path",
 			bytes: []byte(`
 			{
-				"id": "toHTTP",
-				"kind": "toHTTP",
+				"id": "toMQTT",
+				"kind": "toMQTT",
 				"spec": {
-				  "url": "https://localhost:8081",
-				  "method" :"POST"
+				  "broker": "tcp://localhost:1883",
+				  "topic": "sensors"
 				}
 			}`),
 			fields: fields{
-				URL:    "https://localhost:8081",
-				Method: "POST",
+				Broker: "tcp://localhost:1883",
+				Topic:  "sensors",
 			},
+		}, {
+			name: "bad scheme",
+			bytes: []byte(`
+		{
+			"id": "toMQTT",
+			"kind": "toMQTT",
+			"spec": {
+			  "broker": "https://localhost:1883",
+			  "topic": "sensors"
+			}
+		}`),
+			fields: fields{
+				Broker: "https://localhost:1883",
+				Topic:  "sensors",
+			},
+			wantErr: true,
 		}, {
 			name: "bad address",
 			bytes: []byte(`
 		{
-			"id": "toHTTP",
-			"kind": "toHTTP",
+			"id": "toMQTT",
+			"kind": "toMQTT",
 			"spec": {
-			  "url": "https://loc	alhost:8081",
-			  "method" :"POST"
+			  "broker": "localhost",
+			  "topic": "sensors"
 			}
 		}`),
 			fields: fields{
-				URL:    "https://localhost:8081",
-				Method: "POST",
+				Broker: "localhost",
+				Topic:  "sensors",
 			},
 			wantErr: true,
 		}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			o := &fmqtt.ToMQTTOpSpec{
-				URL:         tt.fields.URL,
-				Method:      tt.fields.Method,
-				Headers:     tt.fields.Headers,
-				URLParams:   tt.fields.URLParams,
-				Timeout:     tt.fields.Timeout,
-				NoKeepAlive: tt.fields.NoKeepAlive,
+				Broker:  tt.fields.Broker,
+				Topic:   tt.fields.Topic,
+				Timeout: tt.fields.Timeout,
 			}
 			op := &flux.Operation{
 				ID:   "toMQTT",
@@ -134,124 +138,23 @@ func TestToMQTTOpSpec_UnmarshalJSON(t *testing.T) {
 	}
 }
 
-func TestToHTTP_Process(t *testing.T) {
-	data := []byte{}
-	wg := sync.WaitGroup{}
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		defer wg.Done()
-		serverData, err := ioutil.ReadAll(r.Body)
-		if err != nil {
-			t.Log(err)
-			t.FailNow()
-		}
-		data = append(data, serverData...)
-	}))
+func TestToMQTT_Process(t *testing.T) {
 	type wanted struct {
 		Table  []*executetest.Table
-		Result []byte
+		Result []mqttMessage
 	}
 	testCases := []struct {
 		name string
-		spec *fhttp.ToHTTPProcedureSpec
+		spec *fmqtt.ToMQTTProcedureSpec
 		data []flux.Table
 		want wanted
 	}{
-		{
-			name: "coltable with name in _measurement",
-			spec: &fhttp.ToHTTPProcedureSpec{
-				Spec: &fhttp.ToHTTPOpSpec{
-					URL:          server.URL,
-					Method:       "GET",
-					Timeout:      50 * time.Second,
-					TimeColumn:   execute.DefaultTimeColLabel,
-					ValueColumns: []string{"_value"},
-					NameColumn:   "_measurement",
-				},
-			},
-			data: []flux.Table{executetest.MustCopyTable(&executetest.Table{
-				ColMeta: []flux.ColMeta{
-					{Label: "_time", Type: flux.TTime},
-					{Label: "_measurement", Type: flux.TString},
-					{Label: "_value", Type: flux.TFloat},
-					{Label: "fred", Type: flux.TString},
-				},
-				Data: [][]interface{}{
-					{execute.Time(11), "a", 2.0, "one"},
-					{execute.Time(21), "a", 2.0, "one"},
-					{execute.Time(21), "b", 1.0, "seven"},
-					{execute.Time(31), "a", 3.0, "nine"},
-					{execute.Time(41), "c", 4.0, "elevendyone"},
-				},
-			})},
-			want: wanted{
-				Table: []*executetest.Table{{
-					ColMeta: []flux.ColMeta{
-						{Label: "_time", Type: flux.TTime},
-						{Label: "_measurement", Type: flux.TString},
-						{Label: "_value", Type: flux.TFloat},
-						{Label: "fred", Type: flux.TString},
-					},
-					Data: [][]interface{}{
-						{execute.Time(11), "a", 2.0, "one"},
-						{execute.Time(21), "a", 2.0, "one"},
-						{execute.Time(21), "b", 1.0, "seven"},
-						{execute.Time(31), "a", 3.0, "nine"},
-						{execute.Time(41), "c", 4.0, "elevendyone"},
-					},
-				}},
-				Result: []byte("a _value=2 11\na _value=2 21\nb _value=1 21\na _value=3 31\nc _value=4 41\n")},
-		},
-		{
-			name: "one table with measurement name in _measurement",
-			spec: &fhttp.ToHTTPProcedureSpec{
-				Spec: &fhttp.ToHTTPOpSpec{
-					URL:          server.URL,
-					Method:       "GET",
-					Timeout:      50 * time.Second,
-					TimeColumn:   execute.DefaultTimeColLabel,
-					ValueColumns: []string{"_value"},
-					NameColumn:   "_measurement",
-				},
-			},
-			data: []flux.Table{&executetest.Table{
-				ColMeta: []flux.ColMeta{
-					{Label: "_time", Type: flux.TTime},
-					{Label: "_measurement", Type: flux.TString},
-					{Label: "_value", Type: flux.TFloat},
-					{Label: "fred", Type: flux.TString},
-				},
-				Data: [][]interface{}{
-					{execute.Time(11), "a", 2.0, "one"},
-					{execute.Time(21), "a", 2.0, "one"},
-					{execute.Time(21), "b", 1.0, "seven"},
-					{execute.Time(31), "a", 3.0, "nine"},
-					{execute.Time(41), "c", 4.0, "elevendyone"},
-				},
-			}},
-			want: wanted{
-				Table: []*executetest.Table{{
-					ColMeta: []flux.ColMeta{
-						{Label: "_time", Type: flux.TTime},
-						{Label: "_measurement", Type: flux.TString},
-						{Label: "_value", Type: flux.TFloat},
-						{Label: "fred", Type: flux.TString},
-					},
-					Data: [][]interface{}{
-						{execute.Time(11), "a", 2.0, "one"},
-						{execute.Time(21), "a", 2.0, "one"},
-						{execute.Time(21), "b", 1.0, "seven"},
-						{execute.Time(31), "a", 3.0, "nine"},
-						{execute.Time(41), "c", 4.0, "elevendyone"},
-					},
-				}},
-				Result: []byte("a _value=2 11\na _value=2 21\nb _value=1 21\na _value=3 31\nc _value=4 41\n")},
-		},
 		{
 			name: "one table with measurement name in _measurement and tag",
-			spec: &fhttp.ToHTTPProcedureSpec{
-				Spec: &fhttp.ToHTTPOpSpec{
-					URL:          server.URL,
-					Method:       "GET",
+			spec: &fmqtt.ToMQTTProcedureSpec{
+				Spec: &fmqtt.ToMQTTOpSpec{
+					Broker:       "tcp://localhost:1883",
+					Topic:        "sensors",
 					Timeout:      50 * time.Second,
 					TimeColumn:   execute.DefaultTimeColLabel,
 					ValueColumns: []string{"_value"},
@@ -268,10 +171,8 @@ func TestToHTTP_Process(t *testing.T) {
 				},
 				Data: [][]interface{}{
 					{execute.Time(11), "a", 2.0, "one"},
-					{execute.Time(21), "a", 2.0, "one"},
 					{execute.Time(21), "b", 1.0, "seven"},
 					{execute.Time(31), "a", 3.0, "nine"},
-					{execute.Time(41), "c", 4.0, "elevendyone"},
 				},
 			}},
 			want: wanted{
@@ -284,124 +185,76 @@ func TestToHTTP_Process(t *testing.T) {
 					},
 					Data: [][]interface{}{
 						{execute.Time(11), "a", 2.0, "one"},
-						{execute.Time(21), "a", 2.0, "one"},
 						{execute.Time(21), "b", 1.0, "seven"},
 						{execute.Time(31), "a", 3.0, "nine"},
-						{execute.Time(41), "c", 4.0, "elevendyone"},
 					},
 				}},
-				Result: []byte("a,fred=one _value=2 11\na,fred=one _value=2 21\nb,fred=seven _value=1 21\na,fred=nine _value=3 31\nc,fred=elevendyone _value=4 41\n")},
-		},
-		{
-			name: "one table",
-			spec: &fhttp.ToHTTPProcedureSpec{
-				Spec: &fhttp.ToHTTPOpSpec{
-					URL:          server.URL,
-					Method:       "POST",
-					Timeout:      50 * time.Second,
-					TimeColumn:   execute.DefaultTimeColLabel,
-					ValueColumns: []string{"_value"},
-					Name:         "one_table",
-				},
-			},
-			data: []flux.Table{&executetest.Table{
-				ColMeta: []flux.ColMeta{
-					{Label: "_time", Type: flux.TTime},
-					{Label: "_value", Type: flux.TFloat},
-				},
-				Data: [][]interface{}{
-					{execute.Time(11), 2.0},
-					{execute.Time(21), 1.0},
-					{execute.Time(31), 3.0},
-					{execute.Time(41), 4.0},
+				Result: []mqttMessage{
+					{Topic: "sensors", Payload: "a,fred=one _value=2 11"},
+					{Topic: "sensors", Payload: "b,fred=seven _value=1 21"},
+					{Topic: "sensors", Payload: "a,fred=nine _value=3 31"},
 				},
-			}},
-			want: wanted{
-				Table: []*executetest.Table{{
-					ColMeta: []flux.ColMeta{
-						{Label: "_time", Type: flux.TTime},
-						{Label: "_value", Type: flux.TFloat},
-					},
-					Data: [][]interface{}{
-						{execute.Time(11), 2.0},
-						{execute.Time(21), 1.0},
-						{execute.Time(31), 3.0},
-						{execute.Time(41), 4.0},
-					},
-				}},
-				Result: []byte("one_table _value=2 11\none_table _value=1 21\none_table _value=3 31\none_table _value=4 41\n"),
 			},
 		},
 		{
-			name: "one table with unused tag",
-			spec: &fhttp.ToHTTPProcedureSpec{
-				Spec: &fhttp.ToHTTPOpSpec{
-					URL:          server.URL,
-					Method:       "GET",
+			name: "one table with multiple fields",
+			spec: &fmqtt.ToMQTTProcedureSpec{
+				Spec: &fmqtt.ToMQTTOpSpec{
+					Broker:       "tcp://localhost:1883",
+					Topic:        "sensors",
+					Name:         "one_table",
 					Timeout:      50 * time.Second,
 					TimeColumn:   execute.DefaultTimeColLabel,
-					ValueColumns: []string{"_value"},
-					Name:         "one_table_w_unused_tag",
+					ValueColumns: []string{"humidity", "temp"},
 				},
 			},
 			data: []flux.Table{&executetest.Table{
 				ColMeta: []flux.ColMeta{
 					{Label: "_time", Type: flux.TTime},
-					{Label: "_value", Type: flux.TFloat},
-					{Label: "fred", Type: flux.TString},
+					{Label: "humidity", Type: flux.TInt},
+					{Label: "temp", Type: flux.TFloat},
 				},
 				Data: [][]interface{}{
-					{execute.Time(11), 2.0, "one"},
-					{execute.Time(21), 1.0, "seven"},
-					{execute.Time(31), 3.0, "nine"},
-					{execute.Time(41), 4.0, "elevendyone"},
+					{execute.Time(11), int64(40), 2.5},
+					{execute.Time(21), int64(41), 1.0},
 				},
 			}},
 			want: wanted{
 				Table: []*executetest.Table{{
 					ColMeta: []flux.ColMeta{
 						{Label: "_time", Type: flux.TTime},
-						{Label: "_value", Type: flux.TFloat},
-						{Label: "fred", Type: flux.TString},
+						{Label: "humidity", Type: flux.TInt},
+						{Label: "temp", Type: flux.TFloat},
 					},
 					Data: [][]interface{}{
-						{execute.Time(11), 2.0, "one"},
-						{execute.Time(21), 1.0, "seven"},
-						{execute.Time(31), 3.0, "nine"},
-						{execute.Time(41), 4.0, "elevendyone"},
+						{execute.Time(11), int64(40), 2.5},
+						{execute.Time(21), int64(41), 1.0},
 					},
 				}},
-				Result: []byte(`one_table_w_unused_tag _value=2 11
-one_table_w_unused_tag _value=1 21
-one_table_w_unused_tag _value=3 31
-one_table_w_unused_tag _value=4 41
-`),
+				Result: []mqttMessage{
+					{Topic: "sensors", Payload: "one_table humidity=40i,temp=2.5 11"},
+					{Topic: "sensors", Payload: "one_table humidity=41i,temp=1 21"},
+				},
 			},
 		},
 		{
-			name: "one table with tag",
-			spec: &fhttp.ToHTTPProcedureSpec{
-				Spec: &fhttp.ToHTTPOpSpec{
-					URL:          server.URL,
-					Method:       "GET",
+			name: "one table without topic",
+			spec: &fmqtt.ToMQTTProcedureSpec{
+				Spec: &fmqtt.ToMQTTOpSpec{
+					Broker:       "tcp://localhost:1883",
+					Name:         "one_table",
 					Timeout:      50 * time.Second,
 					TimeColumn:   execute.DefaultTimeColLabel,
 					ValueColumns: []string{"_value"},
-					TagColumns:   []string{"fred"},
-					Name:         "one_table_w_tag",
 				},
 			},
 			data: []flux.Table{&executetest.Table{
 				ColMeta: []flux.ColMeta{
 					{Label: "_time", Type: flux.TTime},
 					{Label: "_value", Type: flux.TFloat},
-					{Label: "fred", Type: flux.TString},
 				},
 				Data: [][]interface{}{
-					{execute.Time(11), 2.0, "one"},
-					{execute.Time(21), 1.0, "seven"},
-					{execute.Time(31), 3.0, "nine"},
-					{execute.Time(41), 4.0, "elevendyone"},
+					{execute.Time(11), 2.0},
 				},
 			}},
 			want: wanted{
@@ -409,167 +262,41 @@ one_table_w_unused_tag _value=4 41
 					ColMeta: []flux.ColMeta{
 						{Label: "_time", Type: flux.TTime},
 						{Label: "_value", Type: flux.TFloat},
-						{Label: "fred", Type: flux.TString},
 					},
 					Data: [][]interface{}{
-						{execute.Time(11), 2.0, "one"},
-						{execute.Time(21), 1.0, "seven"},
-						{execute.Time(31), 3.0, "nine"},
-						{execute.Time(41), 4.0, "elevendyone"},
+						{execute.Time(11), 2.0},
 					},
 				}},
-				Result: []byte(`one_table_w_tag,fred=one _value=2 11
-one_table_w_tag,fred=seven _value=1 21
-one_table_w_tag,fred=nine _value=3 31
-one_table_w_tag,fred=elevendyone _value=4 41
-`),
-			},
-		},
-		{
-			name: "multi table",
-			spec: &fhttp.ToHTTPProcedureSpec{
-				Spec: &fhttp.ToHTTPOpSpec{
-					URL:          server.URL,
-					Method:       "GET",
-					Timeout:      50 * time.Second,
-					TimeColumn:   execute.DefaultTimeColLabel,
-					ValueColumns: []string{"_value"},
-					TagColumns:   []string{"fred"},
-					Name:         "multi_table",
-				},
-			},
-			data: []flux.Table{
-				&executetest.Table{
-					ColMeta: []flux.ColMeta{
-						{Label: "_time", Type: flux.TTime},
-						{Label: "_value", Type: flux.TFloat},
-						{Label: "fred", Type: flux.TString},
-					},
-					Data: [][]interface{}{
-						{execute.Time(11), 2.0, "one"},
-						{execute.Time(21), 1.0, "seven"},
-						{execute.Time(31), 3.0, "nine"},
-					},
-				},
-				&executetest.Table{
-					ColMeta: []flux.ColMeta{
-						{Label: "_time", Type: flux.TTime},
-						{Label: "_value", Type: flux.TFloat},
-						{Label: "fred", Type: flux.TString},
-					},
-					Data: [][]interface{}{
-						{execute.Time(51), 2.0, "one"},
-						{execute.Time(61), 1.0, "seven"},
-						{execute.Time(71), 3.0, "nine"},
-					},
-				},
-			},
-			want: wanted{
-				Table: []*executetest.Table{
-					&executetest.Table{
-						ColMeta: []flux.ColMeta{
-							{Label: "_time", Type: flux.TTime},
-							{Label: "_value", Type: flux.TFloat},
-							{Label: "fred", Type: flux.TString},
-						},
-						Data: [][]interface{}{
-							{execute.Time(11), 2.0, "one"},
-							{execute.Time(21), 1.0, "seven"},
-							{execute.Time(31), 3.0, "nine"},
-							{execute.Time(51), 2.0, "one"},
-							{execute.Time(61), 1.0, "seven"},
-							{execute.Time(71), 3.0, "nine"},
-						},
-					},
-				},
-				Result: []byte("multi_table,fred=one _value=2 11\nmulti_table,fred=seven _value=1 21\nmulti_table,fred=nine _value=3 31\n" +
-					"multi_table,fred=one _value=2 51\nmulti_table,fred=seven _value=1 61\nmulti_table,fred=nine _value=3 71\n"),
-			},
-		},
-		{
-			name: "multi collist tables",
-			spec: &fhttp.ToHTTPProcedureSpec{
-				Spec: &fhttp.ToHTTPOpSpec{
-					URL:          server.URL,
-					Method:       "GET",
-					Timeout:      50 * time.Second,
-					TimeColumn:   execute.DefaultTimeColLabel,
-					ValueColumns: []string{"_value"},
-					TagColumns:   []string{"fred"},
-					Name:         "multi_collist_tables",
-				},
-			},
-			data: []flux.Table{
-				executetest.MustCopyTable(
-					&executetest.Table{
-						ColMeta: []flux.ColMeta{
-							{Label: "_time", Type: flux.TTime},
-							{Label: "_value", Type: flux.TFloat},
-							{Label: "fred", Type: flux.TString},
-						},
-						Data: [][]interface{}{
-							{execute.Time(11), 2.0, "one"},
-							{execute.Time(21), 1.0, "seven"},
-							{execute.Time(31), 3.0, "nine"},
-						},
-					}),
-				&executetest.Table{
-					ColMeta: []flux.ColMeta{
-						{Label: "_time", Type: flux.TTime},
-						{Label: "_value", Type: flux.TFloat},
-						{Label: "fred", Type: flux.TString},
-					},
-					Data: [][]interface{}{
-						{execute.Time(51), 2.0, "one"},
-						{execute.Time(61), 1.0, "seven"},
-						{execute.Time(71), 3.0, "nine"},
-					},
+				Result: []mqttMessage{
+					{Topic: "/one_table", Payload: "one_table _value=2 11"},
 				},
 			},
-			want: wanted{
-				Table: []*executetest.Table{
-					&executetest.Table{
-						ColMeta: []flux.ColMeta{
-							{Label: "_time", Type: flux.TTime},
-							{Label: "_value", Type: flux.TFloat},
-							{Label: "fred", Type: flux.TString},
-						},
-						Data: [][]interface{}{
-							{execute.Time(11), 2.0, "one"},
-							{execute.Time(21), 1.0, "seven"},
-							{execute.Time(31), 3.0, "nine"},
-							{execute.Time(51), 2.0, "one"},
-							{execute.Time(61), 1.0, "seven"},
-							{execute.Time(71), 3.0, "nine"},
-						},
-					},
-				},
-				Result: []byte("multi_collist_tables,fred=one _value=2 11\nmulti_collist_tables,fred=seven _value=1 21\nmulti_collist_tables,fred=nine _value=3 31\n" +
-					"multi_collist_tables,fred=one _value=2 51\nmulti_collist_tables,fred=seven _value=1 61\nmulti_collist_tables,fred=nine _value=3 71\n"),
-			},
 		},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			wg.Add(len(tc.data))
-
+			broker := &mqttBrokerMock{}
+			fmqtt.DefaultMQTTClientFactory = func(opts *MQTT.ClientOptions) fmqtt.MQTTClient {
+				broker.opts = opts
+				return broker
+			}
 			executetest.ProcessTestHelper(
 				t,
 				tc.data,
 				tc.want.Table,
 				nil,
 				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
-					return fhttp.NewToHTTPTransformation(d, c, tc.spec)
+					return fmqtt.NewToMQTTTransformation(d, c, tc.spec)
 				},
 			)
-			wg.Wait() // wait till we are done getting the data back
-			if string(data) != string(tc.want.Result) {
-				t.Logf("expected %s, got %s", tc.want.Result, data)
-				t.Fail()
+			if !broker.disconnected {
+				t.Error("client was not disconnected")
+			}
+			if !cmp.Equal(tc.want.Result, broker.published) {
+				t.Errorf("unexpected messages -want/+got\n%s", cmp.Diff(tc.want.Result, broker.published))
 			}
-			data = data[:0]
 		})
 	}
 }
@@ -3,14 +3,19 @@
 package stdlib
 
 import (
+	_ "github.com/influxdata/flux/stdlib/aggregate"
 	_ "github.com/influxdata/flux/stdlib/csv"
 	_ "github.com/influxdata/flux/stdlib/date"
 	_ "github.com/influxdata/flux/stdlib/generate"
 	_ "github.com/influxdata/flux/stdlib/http"
 	_ "github.com/influxdata/flux/stdlib/influxdata/influxdb"
 	_ "github.com/influxdata/flux/stdlib/influxdata/influxdb/v1"
+	_ "github.com/influxdata/flux/stdlib/interpolate"
+	_ "github.com/influxdata/flux/stdlib/json"
 	_ "github.com/influxdata/flux/stdlib/kafka"
 	_ "github.com/influxdata/flux/stdlib/math"
+	_ "github.com/influxdata/flux/stdlib/mqtt"
+	_ "github.com/influxdata/flux/stdlib/parquet"
 	_ "github.com/influxdata/flux/stdlib/regexp"
 	_ "github.com/influxdata/flux/stdlib/runtime"
 	_ "github.com/influxdata/flux/stdlib/socket"
@@ -0,0 +1,289 @@
+package parquet
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/parquet"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+)
+
+const FromParquetKind = "fromParquet"
+
+type FromParquetOpSpec struct {
+	File    string   `json:"file"`
+	Columns []string `json:"columns,omitempty"`
+	GroupBy []string `json:"groupBy,omitempty"`
+}
+
+func init() {
+	fromParquetSignature := semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			"file":    semantic.String,
+			"columns": semantic.NewArrayPolyType(semantic.String),
+			"groupBy": semantic.NewArrayPolyType(semantic.String),
+		},
+		Required: semantic.LabelSet{"file"},
+		Return:   flux.TableObjectType,
+	}
+	flux.RegisterPackageValue("parquet", "from", flux.FunctionValue(FromParquetKind, createFromParquetOpSpec, fromParquetSignature))
+	flux.RegisterOpSpec(FromParquetKind, newFromParquetOp)
+	plan.RegisterProcedureSpec(FromParquetKind, newFromParquetProcedure, FromParquetKind)
+	execute.RegisterSource(FromParquetKind, createFromParquetSource)
+}
+
+func createFromParquetOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	spec := new(FromParquetOpSpec)
+
+	if file, err := args.GetRequiredString("file"); err != nil {
+		return nil, err
+	} else {
+		spec.File = file
+	}
+	if _, err := os.Stat(spec.File); err != nil {
+		return nil, errors.Wrap(err, codes.Invalid, "failed to stat parquet file")
+	}
+
+	if columns, ok, err := args.GetArray("columns", semantic.String); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Columns, err = interpreter.ToStringArray(columns)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if groupBy, ok, err := args.GetArray("groupBy", semantic.String); err != nil {
+		return nil, err
+	} else if ok {
+		spec.GroupBy, err = interpreter.ToStringArray(groupBy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return spec, nil
+}
+
+func newFromParquetOp() flux.OperationSpec {
+	return new(FromParquetOpSpec)
+}
+
+func (s *FromParquetOpSpec) Kind() flux.OperationKind {
+	return FromParquetKind
+}
+
+type FromParquetProcedureSpec struct {
+	plan.DefaultCost
+	File    string
+	Columns []string
+	GroupBy []string
+}
+
+func newFromParquetProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*FromParquetOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+
+	return &FromParquetProcedureSpec{
+		File:    spec.File,
+		Columns: spec.Columns,
+		GroupBy: spec.GroupBy,
+	}, nil
+}
+
+func (s *FromParquetProcedureSpec) Kind() plan.ProcedureKind {
+	return FromParquetKind
+}
+
+func (s *FromParquetProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(FromParquetProcedureSpec)
+	ns.File = s.File
+	if len(s.Columns) > 0 {
+		ns.Columns = make([]string, len(s.Columns))
+		copy(ns.Columns, s.Columns)
+	}
+	if len(s.GroupBy) > 0 {
+		ns.GroupBy = make([]string, len(s.GroupBy))
+		copy(ns.GroupBy, s.GroupBy)
+	}
+	return ns
+}
+
+func createFromParquetSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
+	spec, ok := prSpec.(*FromParquetProcedureSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", prSpec)
+	}
+	return execute.CreateSourceFromDecoder(&ParquetIterator{spec: spec, alloc: a.Allocator()}, dsid, a)
+}
+
+// ParquetIterator reads the row groups of a Parquet file as flux tables.
+// Without groupBy all rows are a single table with an empty group key.
+// With groupBy the rows are grouped by the values of the group columns,
+// which must be part of the columns that are read, and the tables are
+// returned in the order of their group keys.
+type ParquetIterator struct {
+	spec  *FromParquetProcedureSpec
+	alloc *memory.Allocator
+
+	file   *os.File
+	reader *parquet.Reader
+	// cols are the indexes of the columns of the file that are read.
+	cols []int
+
+	tables []flux.Table
+	loaded bool
+}
+
+var _ execute.SourceDecoder = (*ParquetIterator)(nil)
+
+func (p *ParquetIterator) Connect(ctx context.Context) error {
+	f, err := os.Open(p.spec.File)
+	if err != nil {
+		return errors.Wrap(err, codes.Invalid, "failed to open parquet file")
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	if p.reader, err = parquet.NewReader(f, info.Size()); err != nil {
+		_ = f.Close()
+		return errors.Wrap(err, codes.Invalid, "failed to read parquet file")
+	}
+	// The source iterator only closes the decoder once it is connected.
+	p.file = f
+	if err := p.selectColumns(); err != nil {
+		_ = p.Close()
+		return err
+	}
+	return nil
+}
+
+// selectColumns finds the columns to read. Without columns, all columns
+// that have a supported type are read.
+func (p *ParquetIterator) selectColumns() error {
+	cols := p.reader.Cols()
+	if len(p.spec.Columns) == 0 {
+		for j, c := range cols {
+			if c.Type != flux.TInvalid {
+				p.cols = append(p.cols, j)
+			}
+		}
+	}
+	for _, label := range p.spec.Columns {
+		j := execute.ColIdx(label, cols)
+		if j < 0 {
+			return errors.Newf(codes.Invalid, "column %q does not exist in the parquet file", label)
+		}
+		if cols[j].Type == flux.TInvalid {
+			return errors.Newf(codes.Invalid, "column %q has a type that is not supported", label)
+		}
+		p.cols = append(p.cols, j)
+	}
+	for _, label := range p.spec.GroupBy {
+		if !p.selected(label) {
+			return errors.Newf(codes.Invalid, "group column %q is not one of the columns that are read", label)
+		}
+	}
+	return nil
+}
+
+func (p *ParquetIterator) selected(label string) bool {
+	cols := p.reader.Cols()
+	for _, j := range p.cols {
+		if cols[j].Label == label {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *ParquetIterator) Fetch(ctx context.Context) (bool, error) {
+	if !p.loaded {
+		p.loaded = true
+		if err := p.load(); err != nil {
+			return false, err
+		}
+	}
+	return len(p.tables) > 0, nil
+}
+
+// load reads all row groups and groups their rows into tables.
+func (p *ParquetIterator) load() error {
+	on := make(map[string]bool, len(p.spec.GroupBy))
+	for _, label := range p.spec.GroupBy {
+		on[label] = true
+	}
+	groups := execute.NewGroupLookup()
+	for rg := 0; rg < p.reader.NumRowGroups(); rg++ {
+		tbl, err := p.reader.ReadRowGroup(rg, p.cols, p.alloc)
+		if err != nil {
+			return errors.Wrap(err, codes.Invalid, "failed to read parquet file")
+		}
+		if err := tbl.Do(func(cr flux.ColReader) error {
+			for i := 0; i < cr.Len(); i++ {
+				key := execute.GroupKeyForRowOn(i, cr, on)
+				builder, ok := groups.Lookup(key)
+				if !ok {
+					b := execute.NewColListTableBuilder(key, p.alloc)
+					if err := execute.AddTableCols(tbl, b); err != nil {
+						return err
+					}
+					groups.Set(key, b)
+					builder = b
+				}
+				if err := execute.AppendRecord(i, cr, builder.(execute.TableBuilder)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	var err error
+	groups.Range(func(key flux.GroupKey, value interface{}) {
+		if err != nil {
+			return
+		}
+		var tbl flux.Table
+		tbl, err = value.(execute.TableBuilder).Table()
+		p.tables = append(p.tables, tbl)
+	})
+	return err
+}
+
+func (p *ParquetIterator) Decode(ctx context.Context) (flux.Table, error) {
+	if len(p.tables) == 0 {
+		// An empty file is a single empty table.
+		b := execute.NewColListTableBuilder(execute.NewGroupKey(nil, nil), p.alloc)
+		cols := p.reader.Cols()
+		for _, j := range p.cols {
+			if _, err := b.AddCol(cols[j]); err != nil {
+				return nil, err
+			}
+		}
+		return b.Table()
+	}
+	tbl := p.tables[0]
+	p.tables = p.tables[1:]
+	return tbl, nil
+}
+
+func (p *ParquetIterator) Close() error {
+	if p.file == nil {
+		return nil
+	}
+	return p.file.Close()
+}
@@ -0,0 +1,299 @@
+package parquet_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	_ "github.com/influxdata/flux/builtin" // We need to import the builtins for the tests to work.
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/parquet"
+)
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "parquet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestFromParquet_NewQuery(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "data.parquet")
+	if err := ioutil.WriteFile(file, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name:    "from no args",
+			Raw:     `import "parquet" parquet.from()`,
+			WantErr: true,
+		},
+		{
+			Name:    "from missing file",
+			Raw:     `import "parquet" parquet.from(file: "` + filepath.Join(dir, "missing.parquet") + `")`,
+			WantErr: true,
+		},
+		{
+			Name: "from",
+			Raw:  `import "parquet" parquet.from(file: "` + file + `", columns: ["_time", "_value", "host"], groupBy: ["host"])`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "fromParquet0",
+						Spec: &parquet.FromParquetOpSpec{
+							File:    file,
+							Columns: []string{"_time", "_value", "host"},
+							GroupBy: []string{"host"},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestToParquet_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name:    "to no file",
+			Raw:     `import "csv" import "parquet" csv.from(csv: "a") |> parquet.to()`,
+			WantErr: true,
+		},
+		{
+			Name:    "to empty file",
+			Raw:     `import "csv" import "parquet" csv.from(csv: "a") |> parquet.to(file: "")`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestToParquet_Process(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	spec := &parquet.ToParquetProcedureSpec{
+		Spec: &parquet.ToParquetOpSpec{File: filepath.Join(dir, "out.parquet")},
+	}
+	newTable := func() *executetest.Table {
+		return &executetest.Table{
+			KeyCols: []string{"host"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "host", Type: flux.TString},
+				{Label: "_value", Type: flux.TFloat},
+			},
+			Data: [][]interface{}{
+				{execute.Time(10), "a", 1.0},
+				{execute.Time(20), "a", nil},
+			},
+		}
+	}
+	executetest.ProcessTestHelper(
+		t,
+		[]flux.Table{newTable()},
+		[]*executetest.Table{newTable()},
+		nil,
+		func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+			tr, err := parquet.NewToParquetTransformation(d, c, spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return tr
+		},
+	)
+	if _, err := os.Stat(spec.Spec.File); err != nil {
+		t.Errorf("expected the parquet file to be written: %v", err)
+	}
+}
+
+func runQuery(t *testing.T, script string) []*executetest.Table {
+	t.Helper()
+	program, err := lang.Compile(script, time.Unix(0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := program.Start(context.Background(), &memory.Allocator{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.Done()
+	var tables []*executetest.Table
+	for res := range q.Results() {
+		if err := res.Tables().Do(func(tbl flux.Table) error {
+			et, err := executetest.ConvertTable(tbl)
+			if err != nil {
+				return err
+			}
+			tables = append(tables, et)
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := q.Err(); err != nil {
+		t.Fatal(err)
+	}
+	executetest.NormalizeTables(tables)
+	return tables
+}
+
+func TestParquet_RoundTrip(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "cpu.parquet")
+
+	runQuery(t, `
+import "csv"
+import "parquet"
+
+data = "
+#datatype,string,long,dateTime:RFC3339,string,double,long,boolean
+#group,false,false,false,true,false,false,false
+#default,_result,,,,,,
+,result,table,_time,host,_value,count,ok
+,,0,2019-05-01T00:00:00Z,a,1.5,1,true
+,,0,2019-05-01T00:00:10Z,a,,2,false
+,,1,2019-05-01T00:00:00Z,b,3.5,3,
+"
+
+csv.from(csv: data) |> parquet.to(file: "`+file+`")`)
+
+	got := runQuery(t, `
+import "parquet"
+
+parquet.from(file: "`+file+`", columns: ["host", "_time", "_value"], groupBy: ["host"])`)
+	t0 := execute.Time(time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC).UnixNano())
+	cols := []flux.ColMeta{
+		{Label: "host", Type: flux.TString},
+		{Label: "_time", Type: flux.TTime},
+		{Label: "_value", Type: flux.TFloat},
+	}
+	want := []*executetest.Table{
+		{
+			KeyCols: []string{"host"},
+			ColMeta: cols,
+			Data: [][]interface{}{
+				{"a", t0, 1.5},
+				{"a", t0 + execute.Time(10*time.Second), nil},
+			},
+		},
+		{
+			KeyCols: []string{"host"},
+			ColMeta: cols,
+			Data: [][]interface{}{
+				{"b", t0, 3.5},
+			},
+		},
+	}
+	executetest.NormalizeTables(want)
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(want, got))
+	}
+
+	got = runQuery(t, `
+import "parquet"
+
+parquet.from(file: "`+file+`") |> drop(columns: ["_time", "_value"])`)
+	want = []*executetest.Table{{
+		ColMeta: []flux.ColMeta{
+			{Label: "host", Type: flux.TString},
+			{Label: "count", Type: flux.TInt},
+			{Label: "ok", Type: flux.TBool},
+		},
+		Data: [][]interface{}{
+			{"a", int64(1), true},
+			{"a", int64(2), false},
+			{"b", int64(3), nil},
+		},
+	}}
+	executetest.NormalizeTables(want)
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestFromParquet_Errors(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "data.parquet")
+	runQuery(t, `
+import "csv"
+import "parquet"
+
+csv.from(csv: "
+#datatype,string,long,string,double
+#group,false,false,true,false
+#default,_result,,,
+,result,table,host,_value
+,,0,a,1.0
+") |> parquet.to(file: "`+file+`")`)
+
+	notParquet := filepath.Join(dir, "data.csv")
+	if err := ioutil.WriteFile(notParquet, []byte("host,_value\na,1.0\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		script string
+		err    string
+	}{
+		{
+			script: `parquet.from(file: "` + file + `", columns: ["missing"])`,
+			err:    `column "missing" does not exist`,
+		},
+		{
+			script: `parquet.from(file: "` + file + `", columns: ["_value"], groupBy: ["host"])`,
+			err:    `group column "host" is not one of the columns that are read`,
+		},
+		{
+			script: `parquet.from(file: "` + notParquet + `")`,
+			err:    "not a parquet file",
+		},
+	} {
+		program, err := lang.Compile(`import "parquet"`+"\n"+tc.script, time.Unix(0, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		q, err := program.Start(context.Background(), &memory.Allocator{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for res := range q.Results() {
+			if resErr := res.Tables().Do(func(flux.Table) error { return nil }); resErr != nil {
+				err = resErr
+			}
+		}
+		q.Done()
+		if qErr := q.Err(); qErr != nil {
+			err = qErr
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("expected error containing %q, got %v", tc.err, err)
+		}
+	}
+}
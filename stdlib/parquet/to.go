@@ -0,0 +1,169 @@
+package parquet
+
+import (
+	"bufio"
+	"os"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/parquet"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+)
+
+const ToParquetKind = "toParquet"
+
+type ToParquetOpSpec struct {
+	File string `json:"file"`
+}
+
+func init() {
+	toParquetSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"file": semantic.String,
+		},
+		[]string{"file"},
+	)
+	flux.RegisterPackageValue("parquet", "to", flux.FunctionValueWithSideEffect(ToParquetKind, createToParquetOpSpec, toParquetSignature))
+	flux.RegisterOpSpec(ToParquetKind, func() flux.OperationSpec { return &ToParquetOpSpec{} })
+	plan.RegisterProcedureSpecWithSideEffect(ToParquetKind, newToParquetProcedure, ToParquetKind)
+	execute.RegisterTransformation(ToParquetKind, createToParquetTransformation)
+}
+
+func (o *ToParquetOpSpec) ReadArgs(args flux.Arguments) error {
+	var err error
+	o.File, err = args.GetRequiredString("file")
+	if err != nil {
+		return err
+	}
+	if len(o.File) == 0 {
+		return errors.New(codes.Invalid, "invalid file name")
+	}
+	return nil
+}
+
+func createToParquetOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+	s := new(ToParquetOpSpec)
+	if err := s.ReadArgs(args); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (ToParquetOpSpec) Kind() flux.OperationKind {
+	return ToParquetKind
+}
+
+type ToParquetProcedureSpec struct {
+	plan.DefaultCost
+	Spec *ToParquetOpSpec
+}
+
+func (o *ToParquetProcedureSpec) Kind() plan.ProcedureKind {
+	return ToParquetKind
+}
+
+func (o *ToParquetProcedureSpec) Copy() plan.ProcedureSpec {
+	return &ToParquetProcedureSpec{
+		Spec: &ToParquetOpSpec{
+			File: o.Spec.File,
+		},
+	}
+}
+
+func newToParquetProcedure(qs flux.OperationSpec, a plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*ToParquetOpSpec)
+	if !ok && spec != nil {
+		return nil, errors.Newf(codes.Internal, "invalid spec type %T", qs)
+	}
+	return &ToParquetProcedureSpec{Spec: spec}, nil
+}
+
+func createToParquetTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*ToParquetProcedureSpec)
+	if !ok {
+		return nil, nil, errors.Newf(codes.Internal, "invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t, err := NewToParquetTransformation(d, cache, s)
+	if err != nil {
+		return nil, nil, err
+	}
+	return t, d, nil
+}
+
+// ToParquetTransformation writes the tables to a Parquet file and passes them on unchanged.
+// The file is created when the transformation is and it is removed if the query fails.
+type ToParquetTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+	spec  *ToParquetProcedureSpec
+	file  *os.File
+	buf   *bufio.Writer
+	w     *parquet.Writer
+}
+
+func NewToParquetTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *ToParquetProcedureSpec) (*ToParquetTransformation, error) {
+	f, err := os.Create(spec.Spec.File)
+	if err != nil {
+		return nil, errors.Wrap(err, codes.Invalid, "failed to create parquet file")
+	}
+	buf := bufio.NewWriter(f)
+	return &ToParquetTransformation{
+		d:     d,
+		cache: cache,
+		spec:  spec,
+		file:  f,
+		buf:   buf,
+		w:     parquet.NewWriter(buf),
+	}, nil
+}
+
+func (t *ToParquetTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *ToParquetTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	builder, new := t.cache.TableBuilder(tbl.Key())
+	if new {
+		if err := execute.AddTableCols(tbl, builder); err != nil {
+			return err
+		}
+	}
+	return tbl.Do(func(cr flux.ColReader) error {
+		if err := t.w.Write(cr); err != nil {
+			return errors.Wrap(err, codes.Invalid, "failed to write parquet file")
+		}
+		return execute.AppendCols(cr, builder)
+	})
+}
+
+func (t *ToParquetTransformation) UpdateWatermark(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateWatermark(pt)
+}
+
+func (t *ToParquetTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+
+func (t *ToParquetTransformation) Finish(id execute.DatasetID, err error) {
+	if err == nil {
+		err = t.w.Close()
+	}
+	if err == nil {
+		err = t.buf.Flush()
+	}
+	if closeErr := t.file.Close(); closeErr != nil && err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(t.spec.Spec.File)
+	}
+	t.d.Finish(err)
+}
@@ -15,7 +15,9 @@ import (
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/csv"
 	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/json"
 	"github.com/influxdata/flux/line"
+	"github.com/influxdata/flux/lineprotocol"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/semantic"
 	"github.com/influxdata/flux/values"
@@ -53,7 +55,7 @@ func (a *nowTimeProvider) CurrentTime() values.Time {
 }
 
 var (
-	decoders = []string{"csv", "line"}
+	decoders = []string{"csv", "line", "lp", "json"}
 	schemes  = []string{"tcp", "unix"}
 )
 
@@ -170,6 +172,12 @@ func NewSocketSource(spec *FromSocketProcedureSpec, rc io.ReadCloser, tp line.Ti
 			Separator:    '\n',
 			TimeProvider: tp,
 		})
+	case "lp":
+		decoder = lineprotocol.NewResultDecoder(lineprotocol.ResultDecoderConfig{
+			TimeProvider: tp,
+		})
+	case "json":
+		decoder = json.NewResultDecoder(json.ResultDecoderConfig{Lines: true})
 	}
 
 	if decoder == nil {
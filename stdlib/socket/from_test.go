@@ -128,6 +128,45 @@ source
 				},
 			}},
 		},
+		{
+			name: "line protocol",
+			spec: &socket.FromSocketProcedureSpec{Decoder: "lp"},
+			input: `cpu,host=a usage=1.5 10
+cpu,host=a usage=2.5
+`,
+			want: []*executetest.Table{{
+				KeyCols: []string{"_measurement", "host", "_field"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "host", Type: flux.TString},
+					{Label: "_field", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(10), 1.5, "cpu", "a", "usage"},
+					{execute.Time(0), 2.5, "cpu", "a", "usage"},
+				},
+			}},
+		},
+		{
+			name: "json lines",
+			spec: &socket.FromSocketProcedureSpec{Decoder: "json"},
+			input: `{"_time": "1970-01-01T00:00:00.00000001Z", "host": "a", "_value": 1.5}
+{"_time": "1970-01-01T00:00:00.00000002Z", "host": "b", "_value": 2}
+`,
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "host", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(10), "a", 1.5},
+					{execute.Time(20), "b", 2.0},
+				},
+			}},
+		},
 		{
 			name: "csv",
 			spec: &socket.FromSocketProcedureSpec{Decoder: "csv"},
@@ -0,0 +1,293 @@
+package sql
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+// RowReaderFactory creates the RowReader that converts the rows of a query result to flux values.
+type RowReaderFactory func(rows *sql.Rows) (execute.RowReader, error)
+
+// Dialect describes the SQL that sql.to generates for a driver.
+type Dialect interface {
+	// Placeholder returns the placeholder for the i-th bind parameter of a statement, starting at 1.
+	Placeholder(i int) string
+	// DataType returns the column type used to create a column that stores values of type t.
+	DataType(t flux.ColType) (string, error)
+	// QuoteIdentifier quotes a single table or column name.
+	QuoteIdentifier(name string) string
+	// InsertStatement returns the statement that inserts the values into the columns of the table
+	// and resolves conflicts with existing rows as described by conflict.
+	// The table and column names are not quoted, values is the list of placeholder tuples.
+	InsertStatement(table string, columns []string, values string, conflict Conflict) (string, error)
+	// CreateTableStatement returns the statement that creates the table with the columns, unless it already exists.
+	// The key columns, if any, become the primary key. The table and column names are not quoted.
+	// An empty statement means that the table is not created by sql.to.
+	CreateTableStatement(table string, cols []flux.ColMeta, keyColumns []string) (string, error)
+}
+
+const (
+	// OnConflictError fails the insert when a row conflicts with an existing row.
+	OnConflictError = ""
+	// OnConflictIgnore keeps the existing row and drops the new one.
+	OnConflictIgnore = "ignore"
+	// OnConflictUpdate replaces the values of the existing row with the new ones.
+	OnConflictUpdate = "update"
+)
+
+// Conflict describes how an insert resolves rows that conflict with existing rows.
+type Conflict struct {
+	// Action is one of OnConflictError, OnConflictIgnore or OnConflictUpdate.
+	Action string
+	// KeyColumns are the columns of the unique key that identifies conflicting rows.
+	KeyColumns []string
+}
+
+// QuoteTable quotes a table name with the dialect.
+// Every part of a qualified name such as schema.table is quoted on its own.
+func QuoteTable(d Dialect, table string) string {
+	parts := strings.Split(table, ".")
+	for i, p := range parts {
+		parts[i] = d.QuoteIdentifier(p)
+	}
+	return strings.Join(parts, ".")
+}
+
+func quoteColumns(d Dialect, columns []string) []string {
+	quoted := make([]string, len(columns))
+	for i, c := range columns {
+		quoted[i] = d.QuoteIdentifier(c)
+	}
+	return quoted
+}
+
+// updateColumns returns the columns that are not part of the key.
+func updateColumns(columns, keyColumns []string) []string {
+	var update []string
+	for _, c := range columns {
+		if !execute.ContainsStr(keyColumns, c) {
+			update = append(update, c)
+		}
+	}
+	return update
+}
+
+// onConflictInsertStatement creates an insert statement with the ON CONFLICT clause
+// that PostgreSQL and SQLite share.
+func onConflictInsertStatement(d Dialect, table string, columns []string, values string, conflict Conflict) (string, error) {
+	stmt := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s", QuoteTable(d, table), strings.Join(quoteColumns(d, columns), ","), values)
+	var target string
+	if len(conflict.KeyColumns) > 0 {
+		target = fmt.Sprintf(" (%s)", strings.Join(quoteColumns(d, conflict.KeyColumns), ","))
+	}
+	switch conflict.Action {
+	case OnConflictError:
+		return stmt, nil
+	case OnConflictIgnore:
+		return stmt + " ON CONFLICT" + target + " DO NOTHING", nil
+	case OnConflictUpdate:
+		if len(conflict.KeyColumns) == 0 {
+			return "", errors.New(codes.Invalid, "updating conflicting rows requires key columns")
+		}
+		update := updateColumns(columns, conflict.KeyColumns)
+		if len(update) == 0 {
+			return stmt + " ON CONFLICT" + target + " DO NOTHING", nil
+		}
+		set := make([]string, len(update))
+		for i, c := range update {
+			q := d.QuoteIdentifier(c)
+			set[i] = fmt.Sprintf("%s = excluded.%s", q, q)
+		}
+		return stmt + " ON CONFLICT" + target + " DO UPDATE SET " + strings.Join(set, ","), nil
+	default:
+		return "", errors.Newf(codes.Invalid, "unknown conflict action %q", conflict.Action)
+	}
+}
+
+// createTableStatement creates a CREATE TABLE IF NOT EXISTS statement with the data types of the dialect.
+func createTableStatement(d Dialect, table string, cols []flux.ColMeta, keyColumns []string) (string, error) {
+	defs := make([]string, 0, len(cols)+1)
+	for _, col := range cols {
+		dataType, err := d.DataType(col.Type)
+		if err != nil {
+			return "", err
+		}
+		defs = append(defs, fmt.Sprintf("%s %s", d.QuoteIdentifier(col.Label), dataType))
+	}
+	if len(keyColumns) > 0 {
+		defs = append(defs, fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(quoteColumns(d, keyColumns), ",")))
+	}
+	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s)", QuoteTable(d, table), strings.Join(defs, ",")), nil
+}
+
+type driver struct {
+	newRowReader RowReaderFactory
+	dialect      Dialect
+}
+
+var drivers = make(map[string]driver)
+
+func init() {
+	RegisterDriver("mysql", NewMySQLRowReader, mysqlDialect{})
+	RegisterDriver("postgres", NewPostgresRowReader, postgresDialect{})
+	RegisterDriver("sqlite3", NewSQLiteRowReader, sqliteDialect{})
+}
+
+// RegisterDriver makes a database/sql driver available to sql.from and sql.to.
+// The name must match the name the driver was registered with in the database/sql package.
+// RegisterDriver panics if a driver with the same name has already been registered,
+// so it should be called from an init function.
+func RegisterDriver(name string, newRowReader RowReaderFactory, dialect Dialect) {
+	if _, ok := drivers[name]; ok {
+		panic(fmt.Errorf("duplicate registration for sql driver %q", name))
+	}
+	drivers[name] = driver{
+		newRowReader: newRowReader,
+		dialect:      dialect,
+	}
+}
+
+func getDriver(name string) (driver, error) {
+	d, ok := drivers[name]
+	if !ok {
+		return driver{}, errors.Newf(codes.Invalid, "sql driver %s not supported", name)
+	}
+	return d, nil
+}
+
+type mysqlDialect struct{}
+
+func (mysqlDialect) Placeholder(i int) string {
+	return "?"
+}
+
+func (mysqlDialect) QuoteIdentifier(name string) string {
+	return "`" + strings.Replace(name, "`", "``", -1) + "`"
+}
+
+func (d mysqlDialect) InsertStatement(table string, columns []string, values string, conflict Conflict) (string, error) {
+	insert := "INSERT"
+	var suffix string
+	switch conflict.Action {
+	case OnConflictError:
+	case OnConflictIgnore:
+		insert = "INSERT IGNORE"
+	case OnConflictUpdate:
+		if len(conflict.KeyColumns) == 0 {
+			return "", errors.New(codes.Invalid, "updating conflicting rows requires key columns")
+		}
+		update := updateColumns(columns, conflict.KeyColumns)
+		if len(update) == 0 {
+			insert = "INSERT IGNORE"
+			break
+		}
+		set := make([]string, len(update))
+		for i, c := range update {
+			q := d.QuoteIdentifier(c)
+			set[i] = fmt.Sprintf("%s = VALUES(%s)", q, q)
+		}
+		suffix = " ON DUPLICATE KEY UPDATE " + strings.Join(set, ",")
+	default:
+		return "", errors.Newf(codes.Invalid, "unknown conflict action %q", conflict.Action)
+	}
+	return fmt.Sprintf("%s INTO %s (%s) VALUES %s%s", insert, QuoteTable(d, table), strings.Join(quoteColumns(d, columns), ","), values, suffix), nil
+}
+
+func (d mysqlDialect) CreateTableStatement(table string, cols []flux.ColMeta, keyColumns []string) (string, error) {
+	return createTableStatement(d, table, cols, keyColumns)
+}
+
+func (mysqlDialect) DataType(t flux.ColType) (string, error) {
+	switch t {
+	case flux.TFloat:
+		return "FLOAT", nil
+	case flux.TInt, flux.TUInt:
+		return "BIGINT", nil
+	case flux.TString:
+		return "TEXT(16383)", nil
+	case flux.TTime:
+		return "DATETIME", nil
+	case flux.TBool:
+		return "BOOL", nil
+	default:
+		return "", errors.Newf(codes.Invalid, "mysql does not support column type %v", t)
+	}
+}
+
+type postgresDialect struct{}
+
+// Placeholder returns $n since PostgreSQL uses $n instead of ? for placeholders.
+func (postgresDialect) Placeholder(i int) string {
+	return fmt.Sprintf("$%d", i)
+}
+
+// QuoteIdentifier uses double quotes, so quoted names are case sensitive.
+func (postgresDialect) QuoteIdentifier(name string) string {
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
+
+func (d postgresDialect) InsertStatement(table string, columns []string, values string, conflict Conflict) (string, error) {
+	return onConflictInsertStatement(d, table, columns, values, conflict)
+}
+
+func (d postgresDialect) CreateTableStatement(table string, cols []flux.ColMeta, keyColumns []string) (string, error) {
+	return createTableStatement(d, table, cols, keyColumns)
+}
+
+func (postgresDialect) DataType(t flux.ColType) (string, error) {
+	switch t {
+	case flux.TFloat:
+		return "FLOAT", nil
+	case flux.TInt, flux.TUInt:
+		return "BIGINT", nil
+	case flux.TString:
+		return "text", nil
+	case flux.TTime:
+		return "TIMESTAMP", nil
+	case flux.TBool:
+		return "BOOL", nil
+	default:
+		return "", errors.Newf(codes.Invalid, "postgres does not support column type %v", t)
+	}
+}
+
+type sqliteDialect struct{}
+
+func (sqliteDialect) Placeholder(i int) string {
+	return "?"
+}
+
+func (sqliteDialect) QuoteIdentifier(name string) string {
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
+
+func (d sqliteDialect) InsertStatement(table string, columns []string, values string, conflict Conflict) (string, error) {
+	return onConflictInsertStatement(d, table, columns, values, conflict)
+}
+
+func (d sqliteDialect) CreateTableStatement(table string, cols []flux.ColMeta, keyColumns []string) (string, error) {
+	return createTableStatement(d, table, cols, keyColumns)
+}
+
+func (sqliteDialect) DataType(t flux.ColType) (string, error) {
+	switch t {
+	case flux.TFloat:
+		return "FLOAT", nil
+	case flux.TInt, flux.TUInt:
+		return "BIGINT", nil
+	case flux.TString:
+		return "TEXT", nil
+	case flux.TTime:
+		return "DATETIME", nil
+	case flux.TBool:
+		return "BOOL", nil
+	default:
+		return "", errors.Newf(codes.Invalid, "sqlite3 does not support column type %v", t)
+	}
+}
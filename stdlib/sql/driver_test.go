@@ -0,0 +1,274 @@
+package sql
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/mattn/go-sqlite3"
+)
+
+func init() {
+	// sqlmock is the driver the tests of sql.to write to.
+	RegisterDriver("sqlmock", NewPostgresRowReader, sqlmockDialect{})
+}
+
+// sqlmockDialect writes the statements of mysql, but does not create tables
+// since the tests of sql.to have no transaction to create them in.
+type sqlmockDialect struct {
+	mysqlDialect
+}
+
+func (sqlmockDialect) CreateTableStatement(table string, cols []flux.ColMeta, keyColumns []string) (string, error) {
+	return "", nil
+}
+
+func TestRegisterDriver(t *testing.T) {
+	// Register SQLite under another name, the same way an embedder adds a driver.
+	if !contains(sql.Drivers(), "fluxtest") {
+		sql.Register("fluxtest", &sqlite3.SQLiteDriver{})
+	}
+	RegisterDriver("fluxtest", NewSQLiteRowReader, sqliteDialect{})
+	defer delete(drivers, "fluxtest")
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected duplicate registration to panic")
+			}
+		}()
+		RegisterDriver("fluxtest", NewSQLiteRowReader, sqliteDialect{})
+	}()
+
+	d, err := getDriver("fluxtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := d.dialect.Placeholder(1), "?"; got != want {
+		t.Errorf("unexpected placeholder: want %q, got %q", want, got)
+	}
+	if _, err := getDriver("unknown"); err == nil {
+		t.Error("expected error for an unregistered driver")
+	}
+}
+
+func TestReplacePlaceholders(t *testing.T) {
+	for _, tc := range []struct {
+		dialect Dialect
+		want    string
+	}{
+		{dialect: mysqlDialect{}, want: "(?,?),(?,?)"},
+		{dialect: postgresDialect{}, want: "($1,$2),($3,$4)"},
+	} {
+		if got := replacePlaceholders("(?,?),(?,?)", tc.dialect); got != tc.want {
+			t.Errorf("unexpected placeholders: want %q, got %q", tc.want, got)
+		}
+	}
+}
+
+func TestSQLite_FromParams(t *testing.T) {
+	dsn, cleanup := newSQLiteDB(t)
+	defer cleanup()
+
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	for _, q := range []string{
+		`CREATE TABLE metrics (host TEXT, count INTEGER)`,
+		`INSERT INTO metrics VALUES ('a', 1), ('b', 2), ('c', 3)`,
+	} {
+		if _, err := db.Exec(q); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := []*executetest.Table{{
+		ColMeta: []flux.ColMeta{
+			{Label: "host", Type: flux.TString},
+			{Label: "count", Type: flux.TInt},
+		},
+		Data: [][]interface{}{
+			{"a", int64(1)},
+			{"c", int64(3)},
+		},
+	}}
+	got := readSQLite(t, dsn, `SELECT host, count FROM metrics WHERE host = $1 OR count > $2 ORDER BY host`, "a", int64(2))
+	executetest.NormalizeTables(want)
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestInsertStatement(t *testing.T) {
+	columns := []string{"_time", "host", "_value"}
+	values := "(?,?,?)"
+	keys := []string{"_time", "host"}
+	testCases := []struct {
+		name     string
+		dialect  Dialect
+		table    string
+		columns  []string
+		conflict Conflict
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:    "mysql",
+			dialect: mysqlDialect{},
+			table:   "db.metrics",
+			columns: columns,
+			want:    "INSERT INTO `db`.`metrics` (`_time`,`host`,`_value`) VALUES (?,?,?)",
+		},
+		{
+			name:     "mysql ignore",
+			dialect:  mysqlDialect{},
+			table:    "metrics",
+			columns:  columns,
+			conflict: Conflict{Action: OnConflictIgnore},
+			want:     "INSERT IGNORE INTO `metrics` (`_time`,`host`,`_value`) VALUES (?,?,?)",
+		},
+		{
+			name:     "mysql update",
+			dialect:  mysqlDialect{},
+			table:    "metrics",
+			columns:  columns,
+			conflict: Conflict{Action: OnConflictUpdate, KeyColumns: keys},
+			want:     "INSERT INTO `metrics` (`_time`,`host`,`_value`) VALUES (?,?,?) ON DUPLICATE KEY UPDATE `_value` = VALUES(`_value`)",
+		},
+		{
+			name:     "postgres ignore",
+			dialect:  postgresDialect{},
+			table:    "metrics",
+			columns:  columns,
+			conflict: Conflict{Action: OnConflictIgnore, KeyColumns: keys},
+			want:     `INSERT INTO "metrics" ("_time","host","_value") VALUES (?,?,?) ON CONFLICT ("_time","host") DO NOTHING`,
+		},
+		{
+			name:     "postgres update",
+			dialect:  postgresDialect{},
+			table:    "public.metrics",
+			columns:  columns,
+			conflict: Conflict{Action: OnConflictUpdate, KeyColumns: keys},
+			want:     `INSERT INTO "public"."metrics" ("_time","host","_value") VALUES (?,?,?) ON CONFLICT ("_time","host") DO UPDATE SET "_value" = excluded."_value"`,
+		},
+		{
+			name:     "sqlite update with only key columns",
+			dialect:  sqliteDialect{},
+			table:    "metrics",
+			columns:  keys,
+			conflict: Conflict{Action: OnConflictUpdate, KeyColumns: keys},
+			want:     `INSERT INTO "metrics" ("_time","host") VALUES (?,?,?) ON CONFLICT ("_time","host") DO NOTHING`,
+		},
+		{
+			name:    "quoted identifiers are escaped",
+			dialect: sqliteDialect{},
+			table:   `my"table`,
+			columns: []string{`a"b`},
+			want:    `INSERT INTO "my""table" ("a""b") VALUES (?,?,?)`,
+		},
+		{
+			name:     "update without key columns",
+			dialect:  sqliteDialect{},
+			table:    "metrics",
+			columns:  columns,
+			conflict: Conflict{Action: OnConflictUpdate},
+			wantErr:  true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.dialect.InsertStatement(tc.table, tc.columns, values, tc.conflict)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("unexpected statement:\nwant: %s\ngot:  %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func TestCreateTableStatement(t *testing.T) {
+	cols := []flux.ColMeta{
+		{Label: "_time", Type: flux.TTime},
+		{Label: "host", Type: flux.TString},
+		{Label: "_value", Type: flux.TFloat},
+	}
+	testCases := []struct {
+		name       string
+		dialect    Dialect
+		table      string
+		cols       []flux.ColMeta
+		keyColumns []string
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:    "mysql",
+			dialect: mysqlDialect{},
+			table:   "db.metrics",
+			cols:    cols,
+			want:    "CREATE TABLE IF NOT EXISTS `db`.`metrics` (`_time` DATETIME,`host` TEXT(16383),`_value` FLOAT)",
+		},
+		{
+			name:       "postgres with key",
+			dialect:    postgresDialect{},
+			table:      "metrics",
+			cols:       cols,
+			keyColumns: []string{"_time", "host"},
+			want:       `CREATE TABLE IF NOT EXISTS "metrics" ("_time" TIMESTAMP,"host" text,"_value" FLOAT,PRIMARY KEY ("_time","host"))`,
+		},
+		{
+			name:    "sqlite",
+			dialect: sqliteDialect{},
+			table:   "metrics",
+			cols:    cols,
+			want:    `CREATE TABLE IF NOT EXISTS "metrics" ("_time" DATETIME,"host" TEXT,"_value" FLOAT)`,
+		},
+		{
+			name:    "unsupported type",
+			dialect: mysqlDialect{},
+			table:   "metrics",
+			cols:    []flux.ColMeta{{Label: "v", Type: flux.TInvalid}},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.dialect.CreateTableStatement(tc.table, tc.cols, tc.keyColumns)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("unexpected statement:\nwant: %s\ngot:  %s", tc.want, got)
+			}
+		})
+	}
+}
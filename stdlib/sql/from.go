@@ -4,23 +4,36 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sort"
+
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
 	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/interpreter"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
 	_ "github.com/lib/pq"
 )
 
-const FromSQLKind = "fromSQL"
+const (
+	FromSQLKind = "fromSQL"
+	// FromBatchSize is the maximum number of rows that sql.from
+	// buffers before passing them on to the next transformation.
+	FromBatchSize = 10000
+)
 
 // For SQL DATETIME parsing
 const layout = "2006-01-02 15:04:05.999999999"
 
 type FromSQLOpSpec struct {
-	DriverName     string `json:"driverName,omitempty"`
-	DataSourceName string `json:"dataSourceName,omitempty"`
-	Query          string `json:"query,omitempty"`
+	DriverName     string        `json:"driverName,omitempty"`
+	DataSourceName string        `json:"dataSourceName,omitempty"`
+	Query          string        `json:"query,omitempty"`
+	Params         []interface{} `json:"params,omitempty"`
+	GroupBy        []string      `json:"groupBy,omitempty"`
 }
 
 func init() {
@@ -29,6 +42,8 @@ func init() {
 			"driverName":     semantic.String,
 			"dataSourceName": semantic.String,
 			"query":          semantic.String,
+			"params":         semantic.Tvar(1),
+			"groupBy":        semantic.NewArrayPolyType(semantic.String),
 		},
 		Required: semantic.LabelSet{"driverName", "dataSourceName", "query"},
 		Return:   flux.TableObjectType,
@@ -60,9 +75,81 @@ func createFromSQLOpSpec(args flux.Arguments, administration *flux.Administratio
 		spec.Query = query
 	}
 
+	if params, ok := args.Get("params"); ok {
+		var err error
+		if spec.Params, err = toParams(params); err != nil {
+			return nil, err
+		}
+	}
+
+	if groupBy, ok, err := args.GetArray("groupBy", semantic.String); err != nil {
+		return nil, err
+	} else if ok {
+		spec.GroupBy, err = interpreter.ToStringArray(groupBy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return spec, nil
 }
 
+// toParams converts the params argument into the bind parameters of the query.
+// Flux arrays hold values of a single type, so params may also be a record,
+// whose values are bound in the order the properties are written, which lets
+// a query bind values of different types, e.g. params: {host: "a", limit: 10}.
+func toParams(v values.Value) ([]interface{}, error) {
+	var (
+		params []interface{}
+		err    error
+	)
+	add := func(v values.Value) {
+		if err != nil {
+			return
+		}
+		var p interface{}
+		if p, err = toParam(v); err == nil {
+			params = append(params, p)
+		}
+	}
+	switch v.Type().Nature() {
+	case semantic.Array:
+		v.Array().Range(func(i int, v values.Value) {
+			add(v)
+		})
+	case semantic.Object:
+		v.Object().Range(func(name string, v values.Value) {
+			add(v)
+		})
+	default:
+		return nil, errors.Newf(codes.Invalid, "keyword argument %q should be an array or a record, but got %v", "params", v.Type().Nature())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+// toParam converts a flux value into a bind parameter.
+func toParam(v values.Value) (interface{}, error) {
+	switch v.Type().Nature() {
+	case semantic.String:
+		return v.Str(), nil
+	case semantic.Int:
+		return v.Int(), nil
+	case semantic.UInt:
+		return v.UInt(), nil
+	case semantic.Float:
+		return v.Float(), nil
+	case semantic.Bool:
+		return v.Bool(), nil
+	case semantic.Time:
+		return v.Time().Time(), nil
+	default:
+		return nil, errors.Newf(codes.Invalid, "unsupported query parameter type %v", v.Type())
+	}
+}
+
 func newFromSQLOp() flux.OperationSpec {
 	return new(FromSQLOpSpec)
 }
@@ -76,6 +163,8 @@ type FromSQLProcedureSpec struct {
 	DriverName     string
 	DataSourceName string
 	Query          string
+	Params         []interface{}
+	GroupBy        []string
 }
 
 func newFromSQLProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -88,6 +177,8 @@ func newFromSQLProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.Pr
 		DriverName:     spec.DriverName,
 		DataSourceName: spec.DataSourceName,
 		Query:          spec.Query,
+		Params:         spec.Params,
+		GroupBy:        spec.GroupBy,
 	}, nil
 }
 
@@ -100,6 +191,14 @@ func (s *FromSQLProcedureSpec) Copy() plan.ProcedureSpec {
 	ns.DriverName = s.DriverName
 	ns.DataSourceName = s.DataSourceName
 	ns.Query = s.Query
+	if len(s.Params) > 0 {
+		ns.Params = make([]interface{}, len(s.Params))
+		copy(ns.Params, s.Params)
+	}
+	if len(s.GroupBy) > 0 {
+		ns.GroupBy = make([]string, len(s.GroupBy))
+		copy(ns.GroupBy, s.GroupBy)
+	}
 	return ns
 }
 
@@ -109,22 +208,38 @@ func createFromSQLSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a ex
 		return nil, fmt.Errorf("invalid spec type %T", prSpec)
 	}
 
-	// Allow for "sqlmock" for testing purposes in "sql_test.go"
-	if spec.DriverName != "postgres" && spec.DriverName != "mysql" && spec.DriverName != "sqlmock" {
-		return nil, fmt.Errorf("sql driver %s not supported", spec.DriverName)
+	driver, err := getDriver(spec.DriverName)
+	if err != nil {
+		return nil, err
 	}
 
-	SQLIterator := SQLIterator{id: dsid, spec: spec, administration: a}
+	SQLIterator := SQLIterator{id: dsid, spec: spec, administration: a, newRowReader: driver.newRowReader, batchSize: FromBatchSize}
 
 	return execute.CreateSourceFromDecoder(&SQLIterator, dsid, a)
 }
 
+// SQLIterator streams the result set of a query as flux tables.
+// Without groupBy the whole result set is a single table with an empty group key.
+// With groupBy a new table starts every time the values of the group columns change,
+// so the query must order its rows by those columns: a group key that appears again
+// after the rows of another key is an error, because every key has a single table.
+// The rows of each table are read from the cursor in batches of at most batchSize rows.
 type SQLIterator struct {
 	id             execute.DatasetID
 	administration execute.Administration
 	spec           *FromSQLProcedureSpec
 	db             *sql.DB
 	reader         *execute.RowReader
+	newRowReader   RowReaderFactory
+	batchSize      int
+
+	// row is the next row of the result set, nil once all rows have been read.
+	row    []values.Value
+	peeked bool
+	// current is the table returned by the last call to Decode.
+	current *sqlTable
+	// keys holds the group keys of the tables that have been decoded.
+	keys *execute.GroupLookup
 }
 
 var _ execute.SourceDecoder = (*SQLIterator)(nil)
@@ -144,58 +259,208 @@ func (c *SQLIterator) Connect(ctx context.Context) error {
 }
 
 func (c *SQLIterator) Fetch(ctx context.Context) (bool, error) {
-	rows, err := c.db.Query(c.spec.Query)
-	if err != nil {
-		return false, err
+	if c.reader != nil {
+		// Skip whatever is left of the previous table.
+		if c.current != nil {
+			if err := c.current.drain(); err != nil {
+				return false, err
+			}
+		}
+		return c.row != nil, nil
 	}
 
-	var reader execute.RowReader
-	switch c.spec.DriverName {
-	case "mysql":
-		reader, err = NewMySQLRowReader(rows)
-	case "postgres", "sqlmock":
-		reader, err = NewPostgresRowReader(rows)
-	default:
-		return false, fmt.Errorf("unsupported driver %s", c.spec.DriverName)
+	rows, err := c.db.QueryContext(ctx, c.spec.Query, c.spec.Params...)
+	if err != nil {
+		return false, err
 	}
 
+	reader, err := c.newRowReader(rows)
 	if err != nil {
 		return false, err
 	}
 	c.reader = &reader
 
-	return false, nil
+	if err := c.advance(); err != nil {
+		return false, err
+	}
+	return c.row != nil, nil
 }
 
-func (c *SQLIterator) Decode(ctx context.Context) (flux.Table, error) {
-	groupKey := execute.NewGroupKey(nil, nil)
-	builder := execute.NewColListTableBuilder(groupKey, c.administration.Allocator())
-
+// advance reads the next row of the result set.
+func (c *SQLIterator) advance() error {
+	c.peeked = true
 	reader := *c.reader
+	if !reader.Next() {
+		c.row = nil
+		return nil
+	}
+	row, err := reader.GetNextRow()
+	if err != nil {
+		return err
+	}
+	c.row = row
+	return nil
+}
 
-	for i, dataType := range reader.ColumnTypes() {
-		_, err := builder.AddCol(flux.ColMeta{Label: reader.ColumnNames()[i], Type: dataType})
-		if err != nil {
+func (c *SQLIterator) Decode(ctx context.Context) (flux.Table, error) {
+	if !c.peeked {
+		if err := c.advance(); err != nil {
 			return nil, err
 		}
 	}
 
-	for reader.Next() {
-		row, err := reader.GetNextRow()
-		if err != nil {
-			return nil, err
+	reader := *c.reader
+	names := reader.ColumnNames()
+	cols := make([]flux.ColMeta, len(names))
+	for i, dataType := range reader.ColumnTypes() {
+		cols[i] = flux.ColMeta{Label: names[i], Type: dataType}
+	}
+
+	var keyIdx []int
+	for _, label := range c.groupBy() {
+		idx := execute.ColIdx(label, cols)
+		if idx < 0 {
+			return nil, errors.Newf(codes.Invalid, "group column %q is not part of the query result", label)
+		}
+		keyIdx = append(keyIdx, idx)
+	}
+	// Keep the group columns in the order of the result set.
+	sort.Ints(keyIdx)
+
+	var keyCols []flux.ColMeta
+	var keyValues []values.Value
+	if c.row != nil {
+		for _, idx := range keyIdx {
+			keyCols = append(keyCols, cols[idx])
+			keyValues = append(keyValues, c.row[idx])
 		}
+	}
 
-		for i, col := range row {
-			if err := builder.AppendValue(i, col); err != nil {
-				return nil, err
-			}
+	key := execute.NewGroupKey(keyCols, keyValues)
+	if len(keyIdx) > 0 && c.row != nil {
+		if c.keys == nil {
+			c.keys = execute.NewGroupLookup()
+		}
+		if _, ok := c.keys.Lookup(key); ok {
+			return nil, errors.Newf(codes.Invalid, "group key %v appears again after the rows of other keys, the query must order its rows by the groupBy columns", key)
 		}
+		c.keys.Set(key, true)
 	}
 
-	return builder.Table()
+	c.current = &sqlTable{
+		iter:   c,
+		key:    key,
+		keyIdx: keyIdx,
+		cols:   cols,
+		empty:  c.row == nil,
+	}
+	return c.current, nil
+}
+
+func (c *SQLIterator) groupBy() []string {
+	if c.spec == nil {
+		return nil
+	}
+	return c.spec.GroupBy
 }
 
 func (c *SQLIterator) Close() error {
 	return c.db.Close()
 }
+
+// sqlTable is a table that reads its rows from the cursor of a SQLIterator
+// for as long as they belong to its group key.
+type sqlTable struct {
+	iter   *SQLIterator
+	key    flux.GroupKey
+	keyIdx []int
+	cols   []flux.ColMeta
+	empty  bool
+	used   bool
+}
+
+func (t *sqlTable) Key() flux.GroupKey {
+	return t.key
+}
+
+func (t *sqlTable) Cols() []flux.ColMeta {
+	return t.cols
+}
+
+func (t *sqlTable) Empty() bool {
+	return t.empty
+}
+
+func (t *sqlTable) Done() {
+	_ = t.drain()
+}
+
+func (t *sqlTable) Do(f func(flux.ColReader) error) error {
+	if t.used {
+		return errors.New(codes.Internal, "table already read")
+	}
+	t.used = true
+
+	batchSize := t.iter.batchSize
+	if batchSize <= 0 {
+		batchSize = FromBatchSize
+	}
+	for t.inGroup() {
+		builder := execute.NewColListTableBuilder(t.key, t.iter.administration.Allocator())
+		for _, c := range t.cols {
+			if _, err := builder.AddCol(c); err != nil {
+				return err
+			}
+		}
+		for t.inGroup() && builder.NRows() < batchSize {
+			for j, v := range t.iter.row {
+				if err := builder.AppendValue(j, v); err != nil {
+					return err
+				}
+			}
+			if err := t.iter.advance(); err != nil {
+				return err
+			}
+		}
+		tbl, err := builder.Table()
+		if err != nil {
+			return err
+		}
+		if err := tbl.Do(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// drain skips the rows of the table that have not been read.
+func (t *sqlTable) drain() error {
+	t.used = true
+	for t.inGroup() {
+		if err := t.iter.advance(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// inGroup reports whether the next row of the result set belongs to the table.
+func (t *sqlTable) inGroup() bool {
+	row := t.iter.row
+	if row == nil {
+		return false
+	}
+	for i, idx := range t.keyIdx {
+		v, kv := row[idx], t.key.Value(i)
+		if v.IsNull() || kv.IsNull() {
+			if v.IsNull() != kv.IsNull() {
+				return false
+			}
+			continue
+		}
+		if !v.Equal(kv) {
+			return false
+		}
+	}
+	return true
+}
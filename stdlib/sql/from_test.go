@@ -0,0 +1,98 @@
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/querytest"
+	fsql "github.com/influxdata/flux/stdlib/sql"
+)
+
+func TestFromSQL_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "from with query",
+			Raw:  `import "sql" sql.from(driverName: "postgres", dataSourceName: "postgres://localhost", query: "SELECT * FROM t")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "fromSQL0",
+						Spec: &fsql.FromSQLOpSpec{
+							DriverName:     "postgres",
+							DataSourceName: "postgres://localhost",
+							Query:          "SELECT * FROM t",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "from with group by",
+			Raw:  `import "sql" sql.from(driverName: "postgres", dataSourceName: "postgres://localhost", query: "SELECT * FROM t ORDER BY host", groupBy: ["host"])`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "fromSQL0",
+						Spec: &fsql.FromSQLOpSpec{
+							DriverName:     "postgres",
+							DataSourceName: "postgres://localhost",
+							Query:          "SELECT * FROM t ORDER BY host",
+							GroupBy:        []string{"host"},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "from with params",
+			Raw:  `import "sql" sql.from(driverName: "postgres", dataSourceName: "postgres://localhost", query: "SELECT * FROM t WHERE host = $1 OR host = $2", params: ["a", "b"])`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "fromSQL0",
+						Spec: &fsql.FromSQLOpSpec{
+							DriverName:     "postgres",
+							DataSourceName: "postgres://localhost",
+							Query:          "SELECT * FROM t WHERE host = $1 OR host = $2",
+							Params:         []interface{}{"a", "b"},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "from with mixed params",
+			Raw:  `import "sql" sql.from(driverName: "postgres", dataSourceName: "postgres://localhost", query: "SELECT * FROM t WHERE host = $1 AND cpu > $2 AND up = $3", params: {host: "a", cpu: 1.5, up: true})`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "fromSQL0",
+						Spec: &fsql.FromSQLOpSpec{
+							DriverName:     "postgres",
+							DataSourceName: "postgres://localhost",
+							Query:          "SELECT * FROM t WHERE host = $1 AND cpu > $2 AND up = $3",
+							Params:         []interface{}{"a", 1.5, true},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:    "params must be an array or a record",
+			Raw:     `import "sql" sql.from(driverName: "postgres", dataSourceName: "postgres://localhost", query: "SELECT * FROM t", params: "a")`,
+			WantErr: true,
+		},
+		{
+			Name:    "group by must be strings",
+			Raw:     `import "sql" sql.from(driverName: "postgres", dataSourceName: "postgres://localhost", query: "SELECT * FROM t", groupBy: [1])`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/influxdata/flux/execute"
 	"github.com/stretchr/testify/assert"
+	"strings"
 	"testing"
 	"time"
 
@@ -165,6 +166,146 @@ func TestFromRowReader(t *testing.T) {
 	})
 }
 
+// sliceRowReader returns the rows of a slice.
+type sliceRowReader struct {
+	names []string
+	types []flux.ColType
+	rows  [][]values.Value
+	row   int
+}
+
+func (m *sliceRowReader) Next() bool {
+	m.row++
+	return m.row <= len(m.rows)
+}
+
+func (m *sliceRowReader) GetNextRow() ([]values.Value, error) {
+	return m.rows[m.row-1], nil
+}
+
+func (m *sliceRowReader) InitColumnNames(s []string)          {}
+func (m *sliceRowReader) InitColumnTypes(c []*sql.ColumnType) {}
+func (m *sliceRowReader) ColumnNames() []string               { return m.names }
+func (m *sliceRowReader) ColumnTypes() []flux.ColType         { return m.types }
+func (m *sliceRowReader) SetColumns(i []interface{})          {}
+
+func TestFromRowReader_GroupBy(t *testing.T) {
+	row := func(host string, v int64) []values.Value {
+		return []values.Value{values.NewString(host), values.NewInt(v)}
+	}
+	var rr execute.RowReader = &sliceRowReader{
+		names: []string{"host", "value"},
+		types: []flux.ColType{flux.TString, flux.TInt},
+		rows: [][]values.Value{
+			row("a", 1),
+			row("a", 2),
+			row("a", 3),
+			row("b", 4),
+			row("c", 5),
+			row("c", 6),
+		},
+	}
+	sqliter := &SQLIterator{
+		spec:           &FromSQLProcedureSpec{GroupBy: []string{"host"}},
+		reader:         &rr,
+		administration: &MockAllocator{},
+		batchSize:      2,
+	}
+
+	var got []*executetest.Table
+	var batches []int
+	for more := true; more; {
+		tbl, err := sqliter.Decode(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Skip the second table without reading it.
+		if tbl.Key().ValueString(0) != "b" {
+			et := &executetest.Table{
+				KeyCols: []string{tbl.Key().Cols()[0].Label},
+				ColMeta: tbl.Cols(),
+			}
+			if err := tbl.Do(func(cr flux.ColReader) error {
+				batches = append(batches, cr.Len())
+				for i := 0; i < cr.Len(); i++ {
+					et.Data = append(et.Data, []interface{}{cr.Strings(0).ValueString(i), cr.Ints(1).Value(i)})
+				}
+				return nil
+			}); err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, et)
+		}
+		if more, err = sqliter.Fetch(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := []*executetest.Table{
+		{
+			KeyCols: []string{"host"},
+			ColMeta: []flux.ColMeta{
+				{Label: "host", Type: flux.TString},
+				{Label: "value", Type: flux.TInt},
+			},
+			Data: [][]interface{}{
+				{"a", int64(1)},
+				{"a", int64(2)},
+				{"a", int64(3)},
+			},
+		},
+		{
+			KeyCols: []string{"host"},
+			ColMeta: []flux.ColMeta{
+				{Label: "host", Type: flux.TString},
+				{Label: "value", Type: flux.TInt},
+			},
+			Data: [][]interface{}{
+				{"c", int64(5)},
+				{"c", int64(6)},
+			},
+		},
+	}
+	executetest.NormalizeTables(want)
+	executetest.NormalizeTables(got)
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(want, got))
+	}
+	if want := []int{2, 1, 2}; !cmp.Equal(want, batches) {
+		t.Errorf("unexpected batch sizes -want/+got\n%s", cmp.Diff(want, batches))
+	}
+}
+
+func TestFromRowReader_GroupByUnordered(t *testing.T) {
+	row := func(host string, v int64) []values.Value {
+		return []values.Value{values.NewString(host), values.NewInt(v)}
+	}
+	var rr execute.RowReader = &sliceRowReader{
+		names: []string{"host", "value"},
+		types: []flux.ColType{flux.TString, flux.TInt},
+		rows: [][]values.Value{
+			row("a", 1),
+			row("b", 2),
+			row("a", 3),
+		},
+	}
+	sqliter := &SQLIterator{
+		spec:           &FromSQLProcedureSpec{GroupBy: []string{"host"}},
+		reader:         &rr,
+		administration: &MockAllocator{},
+	}
+
+	var err error
+	for more := true; more && err == nil; {
+		if _, err = sqliter.Decode(context.Background()); err == nil {
+			more, err = sqliter.Fetch(context.Background())
+		}
+	}
+	if err == nil || !strings.Contains(err.Error(), "must order its rows by the groupBy columns") {
+		t.Errorf("expected an error for a group key that appears again, got %v", err)
+	}
+}
+
 func TestMySQLParsing(t *testing.T) {
 	testCases := []struct {
 		name       string
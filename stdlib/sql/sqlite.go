@@ -0,0 +1,211 @@
+package sql
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/values"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// sqliteTimeLayouts are the layouts that SQLite date and time values are commonly stored with.
+var sqliteTimeLayouts = []string{
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02T15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	"2006-01-02",
+	time.RFC3339Nano,
+}
+
+type SQLiteRowReader struct {
+	Cursor      *sql.Rows
+	columns     []interface{}
+	columnTypes []flux.ColType
+	columnNames []string
+}
+
+// Next prepares SQLiteRowReader to return rows
+func (m *SQLiteRowReader) Next() bool {
+	next := m.Cursor.Next()
+	if next {
+		m.columns = make([]interface{}, len(m.columnNames))
+		columnPointers := make([]interface{}, len(m.columnNames))
+		for i := 0; i < len(m.columnNames); i++ {
+			columnPointers[i] = &m.columns[i]
+		}
+		if err := m.Cursor.Scan(columnPointers...); err != nil {
+			return false
+		}
+	}
+	return next
+}
+
+// GetNextRow converts the current row to the column types.
+// SQLite is dynamically typed, so a value is converted whenever
+// its storage class differs from the declared type of its column.
+func (m *SQLiteRowReader) GetNextRow() ([]values.Value, error) {
+	row := make([]values.Value, len(m.columns))
+	for i, col := range m.columns {
+		if col == nil {
+			row[i] = values.NewNull(flux.SemanticType(m.columnTypes[i]))
+			continue
+		}
+		v, err := sqliteValue(col, m.columnTypes[i])
+		if err != nil {
+			return nil, fmt.Errorf("column %s: %v", m.columnNames[i], err)
+		}
+		row[i] = v
+	}
+	return row, nil
+}
+
+func sqliteValue(col interface{}, typ flux.ColType) (values.Value, error) {
+	if b, ok := col.([]byte); ok {
+		col = string(b)
+	}
+	switch typ {
+	case flux.TInt:
+		switch col := col.(type) {
+		case int64:
+			return values.NewInt(col), nil
+		case float64:
+			return values.NewInt(int64(col)), nil
+		case bool:
+			if col {
+				return values.NewInt(1), nil
+			}
+			return values.NewInt(0), nil
+		case string:
+			n, err := strconv.ParseInt(col, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			return values.NewInt(n), nil
+		}
+	case flux.TFloat:
+		switch col := col.(type) {
+		case float64:
+			return values.NewFloat(col), nil
+		case int64:
+			return values.NewFloat(float64(col)), nil
+		case string:
+			f, err := strconv.ParseFloat(col, 64)
+			if err != nil {
+				return nil, err
+			}
+			return values.NewFloat(f), nil
+		}
+	case flux.TBool:
+		switch col := col.(type) {
+		case bool:
+			return values.NewBool(col), nil
+		case int64:
+			return values.NewBool(col != 0), nil
+		case string:
+			b, err := strconv.ParseBool(col)
+			if err != nil {
+				return nil, err
+			}
+			return values.NewBool(b), nil
+		}
+	case flux.TTime:
+		switch col := col.(type) {
+		case time.Time:
+			return values.NewTime(values.ConvertTime(col)), nil
+		case int64:
+			// Integer times are seconds since the unix epoch.
+			return values.NewTime(values.ConvertTime(time.Unix(col, 0))), nil
+		case string:
+			for _, layout := range sqliteTimeLayouts {
+				if t, err := time.Parse(layout, col); err == nil {
+					return values.NewTime(values.ConvertTime(t)), nil
+				}
+			}
+			return nil, fmt.Errorf("cannot parse %q as a time", col)
+		}
+	case flux.TString:
+		switch col := col.(type) {
+		case string:
+			return values.NewString(col), nil
+		case int64:
+			return values.NewString(strconv.FormatInt(col, 10)), nil
+		case float64:
+			return values.NewString(strconv.FormatFloat(col, 'g', -1, 64)), nil
+		case bool:
+			return values.NewString(strconv.FormatBool(col)), nil
+		case time.Time:
+			return values.NewString(col.Format(time.RFC3339Nano)), nil
+		}
+	}
+	return nil, fmt.Errorf("cannot convert %T to %v", col, typ)
+}
+
+func (m *SQLiteRowReader) InitColumnNames(names []string) {
+	m.columnNames = names
+}
+
+// InitColumnTypes maps the declared column types following the SQLite type affinity rules.
+// Columns without a declared type, such as expressions, are read as strings.
+func (m *SQLiteRowReader) InitColumnTypes(types []*sql.ColumnType) {
+	fluxTypes := make([]flux.ColType, len(types))
+	for i := 0; i < len(types); i++ {
+		fluxTypes[i] = sqliteColType(types[i].DatabaseTypeName())
+	}
+	m.columnTypes = fluxTypes
+}
+
+func sqliteColType(decl string) flux.ColType {
+	decl = strings.ToUpper(decl)
+	switch {
+	case strings.Contains(decl, "INT"):
+		return flux.TInt
+	case strings.Contains(decl, "CHAR"), strings.Contains(decl, "CLOB"), strings.Contains(decl, "TEXT"):
+		return flux.TString
+	case strings.Contains(decl, "REAL"), strings.Contains(decl, "FLOA"), strings.Contains(decl, "DOUB"):
+		return flux.TFloat
+	case strings.Contains(decl, "DATE"), strings.Contains(decl, "TIME"):
+		return flux.TTime
+	case strings.Contains(decl, "BOOL"):
+		return flux.TBool
+	default:
+		return flux.TString
+	}
+}
+
+func (m *SQLiteRowReader) ColumnNames() []string {
+	return m.columnNames
+}
+
+func (m *SQLiteRowReader) ColumnTypes() []flux.ColType {
+	return m.columnTypes
+}
+
+func (m *SQLiteRowReader) SetColumns(i []interface{}) {
+	m.columns = i
+}
+
+func NewSQLiteRowReader(r *sql.Rows) (execute.RowReader, error) {
+	reader := &SQLiteRowReader{
+		Cursor: r,
+	}
+	cols, err := r.Columns()
+	if err != nil {
+		return nil, err
+	}
+	reader.InitColumnNames(cols)
+
+	types, err := r.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+	reader.InitColumnTypes(types)
+	return reader, nil
+}
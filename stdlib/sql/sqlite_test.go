@@ -0,0 +1,282 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/values"
+)
+
+func newSQLiteDB(t *testing.T) (string, func()) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "flux-sqlite")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return filepath.Join(dir, "test.db"), func() { _ = os.RemoveAll(dir) }
+}
+
+// readSQLite runs the query with sql.from and returns the decoded tables.
+func readSQLite(t *testing.T, dsn, query string, params ...interface{}) []*executetest.Table {
+	t.Helper()
+	iter := &SQLIterator{
+		spec: &FromSQLProcedureSpec{
+			DriverName:     "sqlite3",
+			DataSourceName: dsn,
+			Query:          query,
+			Params:         params,
+		},
+		administration: &MockAllocator{},
+		newRowReader:   NewSQLiteRowReader,
+		batchSize:      FromBatchSize,
+	}
+	ctx := context.Background()
+	if err := iter.Connect(ctx); err != nil {
+		t.Fatal(err)
+	}
+	defer iter.Close()
+
+	var got []*executetest.Table
+	more, err := iter.Fetch(ctx)
+	for runOnce := true; err == nil && (runOnce || more); runOnce = false {
+		var tbl flux.Table
+		if tbl, err = iter.Decode(ctx); err != nil {
+			break
+		}
+		var et *executetest.Table
+		if et, err = executetest.ConvertTable(tbl); err != nil {
+			break
+		}
+		got = append(got, et)
+		more, err = iter.Fetch(ctx)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	executetest.NormalizeTables(got)
+	return got
+}
+
+func TestSQLite_From(t *testing.T) {
+	dsn, cleanup := newSQLiteDB(t)
+	defer cleanup()
+
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	for _, q := range []string{
+		`CREATE TABLE metrics (host TEXT, count INTEGER, load REAL, up BOOLEAN, ts DATETIME)`,
+		`INSERT INTO metrics VALUES ('a', 1, 0.5, 1, '2019-06-03 13:59:01')`,
+		`INSERT INTO metrics VALUES ('b', 2, 1, 0, '2019-06-03T14:00:00Z')`,
+		`INSERT INTO metrics VALUES ('c', NULL, NULL, NULL, NULL)`,
+	} {
+		if _, err := db.Exec(q); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t1 := values.ConvertTime(time.Date(2019, 6, 3, 13, 59, 1, 0, time.UTC))
+	t2 := values.ConvertTime(time.Date(2019, 6, 3, 14, 0, 0, 0, time.UTC))
+	want := []*executetest.Table{{
+		ColMeta: []flux.ColMeta{
+			{Label: "host", Type: flux.TString},
+			{Label: "count", Type: flux.TInt},
+			{Label: "load", Type: flux.TFloat},
+			{Label: "up", Type: flux.TBool},
+			{Label: "ts", Type: flux.TTime},
+			{Label: "total", Type: flux.TString},
+		},
+		Data: [][]interface{}{
+			{"a", int64(1), 0.5, true, t1, "1"},
+			{"b", int64(2), 1.0, false, t2, "2"},
+			{"c", nil, nil, nil, nil, nil},
+		},
+	}}
+	got := readSQLite(t, dsn, `SELECT host, count, load, up, ts, count + 0 AS total FROM metrics ORDER BY host`)
+	executetest.NormalizeTables(want)
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestSQLite_To(t *testing.T) {
+	dsn, cleanup := newSQLiteDB(t)
+	defer cleanup()
+
+	data := &executetest.Table{
+		ColMeta: []flux.ColMeta{
+			{Label: "_time", Type: flux.TTime},
+			{Label: "_measurement", Type: flux.TString},
+			{Label: "_value", Type: flux.TFloat},
+			{Label: "ok", Type: flux.TBool},
+		},
+		Data: [][]interface{}{
+			{execute.Time(time.Second), "cpu", 1.5, true},
+			{execute.Time(2 * time.Second), "cpu", nil, false},
+		},
+	}
+
+	d := executetest.NewDataset(executetest.RandomDatasetID())
+	c := execute.NewTableBuilderCache(executetest.UnlimitedAllocator)
+	c.SetTriggerSpec(plan.DefaultTriggerSpec)
+	tr := NewToSQLTransformation(d, c, &ToSQLProcedureSpec{
+		Spec: &ToSQLOpSpec{
+			DriverName:     "sqlite3",
+			DataSourceName: dsn,
+			Table:          "results",
+			CreateTable:    true,
+		},
+	})
+	if err := tr.Process(executetest.RandomDatasetID(), data); err != nil {
+		t.Fatal(err)
+	}
+	tr.Finish(executetest.RandomDatasetID(), nil)
+
+	want := []*executetest.Table{{
+		ColMeta: data.ColMeta,
+		Data: [][]interface{}{
+			{execute.Time(time.Second), "cpu", 1.5, true},
+			{execute.Time(2 * time.Second), "cpu", nil, false},
+		},
+	}}
+	got := readSQLite(t, dsn, `SELECT * FROM results ORDER BY _time`)
+	executetest.NormalizeTables(want)
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(want, got))
+	}
+}
+
+// writeSQLite writes the table with sql.to.
+func writeSQLite(t *testing.T, spec *ToSQLOpSpec, data *executetest.Table) error {
+	t.Helper()
+	d := executetest.NewDataset(executetest.RandomDatasetID())
+	c := execute.NewTableBuilderCache(executetest.UnlimitedAllocator)
+	c.SetTriggerSpec(plan.DefaultTriggerSpec)
+	tr := NewToSQLTransformation(d, c, &ToSQLProcedureSpec{Spec: spec})
+	err := tr.Process(executetest.RandomDatasetID(), data)
+	tr.Finish(executetest.RandomDatasetID(), err)
+	return err
+}
+
+func TestSQLite_ToOnConflict(t *testing.T) {
+	cols := []flux.ColMeta{
+		{Label: "_time", Type: flux.TTime},
+		{Label: "host", Type: flux.TString},
+		{Label: "_value", Type: flux.TFloat},
+	}
+	// Tables can only be read once, so each write gets a new one.
+	first := func() *executetest.Table {
+		return &executetest.Table{
+			ColMeta: cols,
+			Data: [][]interface{}{
+				{execute.Time(time.Second), "a", 1.0},
+				{execute.Time(time.Second), "b", 2.0},
+				{execute.Time(2 * time.Second), "a", 3.0},
+			},
+		}
+	}
+	second := func() *executetest.Table {
+		return &executetest.Table{
+			ColMeta: cols,
+			Data: [][]interface{}{
+				{execute.Time(time.Second), "a", 10.0},
+				{execute.Time(3 * time.Second), "b", 4.0},
+			},
+		}
+	}
+	testCases := []struct {
+		name       string
+		onConflict string
+		want       [][]interface{}
+		wantErr    bool
+	}{
+		{
+			name:    "error",
+			wantErr: true,
+			want: [][]interface{}{
+				{execute.Time(time.Second), "a", 1.0},
+				{execute.Time(time.Second), "b", 2.0},
+				{execute.Time(2 * time.Second), "a", 3.0},
+			},
+		},
+		{
+			name:       "ignore",
+			onConflict: OnConflictIgnore,
+			want: [][]interface{}{
+				{execute.Time(time.Second), "a", 1.0},
+				{execute.Time(time.Second), "b", 2.0},
+				{execute.Time(2 * time.Second), "a", 3.0},
+				{execute.Time(3 * time.Second), "b", 4.0},
+			},
+		},
+		{
+			name:       "update",
+			onConflict: OnConflictUpdate,
+			want: [][]interface{}{
+				{execute.Time(time.Second), "a", 10.0},
+				{execute.Time(time.Second), "b", 2.0},
+				{execute.Time(2 * time.Second), "a", 3.0},
+				{execute.Time(3 * time.Second), "b", 4.0},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			dsn, cleanup := newSQLiteDB(t)
+			defer cleanup()
+
+			spec := &ToSQLOpSpec{
+				DriverName:     "sqlite3",
+				DataSourceName: dsn,
+				Table:          "results",
+				BatchSize:      1,
+				CreateTable:    true,
+				OnConflict:     tc.onConflict,
+				KeyColumns:     []string{"_time", "host"},
+			}
+			if err := writeSQLite(t, spec, first()); err != nil {
+				t.Fatal(err)
+			}
+			if err := writeSQLite(t, spec, second()); (err != nil) != tc.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			want := []*executetest.Table{{ColMeta: cols, Data: tc.want}}
+			got := readSQLite(t, dsn, `SELECT * FROM results ORDER BY _time, host`)
+			executetest.NormalizeTables(want)
+			if !cmp.Equal(want, got) {
+				t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestSQLite_ToMissingTable(t *testing.T) {
+	dsn, cleanup := newSQLiteDB(t)
+	defer cleanup()
+
+	data := &executetest.Table{
+		ColMeta: []flux.ColMeta{{Label: "_value", Type: flux.TFloat}},
+		Data:    [][]interface{}{{1.0}},
+	}
+	if err := writeSQLite(t, &ToSQLOpSpec{
+		DriverName:     "sqlite3",
+		DataSourceName: dsn,
+		Table:          "results",
+	}, data); err == nil {
+		t.Fatal("expected error writing to a table that does not exist")
+	}
+}
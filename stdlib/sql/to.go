@@ -10,6 +10,7 @@ import (
 	"github.com/influxdata/flux/codes"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/interpreter"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/semantic"
 	"github.com/influxdata/flux/values"
@@ -24,6 +25,12 @@ type ToSQLOpSpec struct {
 	DriverName     string `json:"driverName,omitempty"`
 	DataSourceName string `json:"dataSourcename,omitempty"`
 	Table          string `json:"table,omitempty"`
+	// BatchSize is the maximum number of rows per insert statement.
+	// Zero means BatchSize.
+	BatchSize   int      `json:"batchSize,omitempty"`
+	CreateTable bool     `json:"createTable,omitempty"`
+	OnConflict  string   `json:"onConflict,omitempty"`
+	KeyColumns  []string `json:"keyColumns,omitempty"`
 }
 
 func init() {
@@ -32,6 +39,10 @@ func init() {
 			"driverName":     semantic.String,
 			"dataSourceName": semantic.String,
 			"table":          semantic.String,
+			"batchSize":      semantic.Int,
+			"createTable":    semantic.Bool,
+			"onConflict":     semantic.String,
+			"keyColumns":     semantic.NewArrayPolyType(semantic.String),
 		},
 		[]string{"driverName", "dataSourceName", "table"},
 	)
@@ -68,7 +79,54 @@ func (o *ToSQLOpSpec) ReadArgs(args flux.Arguments) error {
 		return errors.New(codes.Invalid, "invalid table name")
 	}
 
-	return err
+	if batchSize, ok, err := args.GetInt("batchSize"); err != nil {
+		return err
+	} else if ok {
+		if batchSize <= 0 {
+			return errors.New(codes.Invalid, "batchSize must be greater than zero")
+		}
+		o.BatchSize = int(batchSize)
+	}
+
+	// The table is created by default, as sql.to always did before createTable was added.
+	o.CreateTable = true
+	if createTable, ok, err := args.GetBool("createTable"); err != nil {
+		return err
+	} else if ok {
+		o.CreateTable = createTable
+	}
+
+	if keyColumns, ok, err := args.GetArray("keyColumns", semantic.String); err != nil {
+		return err
+	} else if ok {
+		o.KeyColumns, err = interpreter.ToStringArray(keyColumns)
+		if err != nil {
+			return err
+		}
+	}
+
+	if o.OnConflict, _, err = args.GetString("onConflict"); err != nil {
+		return err
+	}
+	switch o.OnConflict {
+	case OnConflictError, OnConflictIgnore:
+	case OnConflictUpdate:
+		if len(o.KeyColumns) == 0 {
+			return errors.New(codes.Invalid, `onConflict: "update" requires keyColumns`)
+		}
+	default:
+		return errors.Newf(codes.Invalid, `onConflict must be "ignore" or "update", got %q`, o.OnConflict)
+	}
+
+	return nil
+}
+
+// batchSize returns the maximum number of rows per insert statement.
+func (o *ToSQLOpSpec) batchSize() int {
+	if o.BatchSize > 0 {
+		return o.BatchSize
+	}
+	return BatchSize
 }
 
 func createToSQLOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
@@ -102,8 +160,15 @@ func (o *ToSQLProcedureSpec) Copy() plan.ProcedureSpec {
 			DriverName:     s.DriverName,
 			DataSourceName: s.DataSourceName,
 			Table:          s.Table,
+			BatchSize:      s.BatchSize,
+			CreateTable:    s.CreateTable,
+			OnConflict:     s.OnConflict,
 		},
 	}
+	if len(s.KeyColumns) > 0 {
+		res.Spec.KeyColumns = make([]string, len(s.KeyColumns))
+		copy(res.Spec.KeyColumns, s.KeyColumns)
+	}
 	return res
 }
 
@@ -120,6 +185,9 @@ func createToSQLTransformation(id execute.DatasetID, mode execute.AccumulationMo
 	if !ok {
 		return nil, nil, errors.Newf(codes.Internal, "invalid spec type %T", spec)
 	}
+	if _, err := getDriver(s.Spec.DriverName); err != nil {
+		return nil, nil, err
+	}
 	cache := execute.NewTableBuilderCache(a.Allocator())
 	d := execute.NewDataset(id, mode, cache)
 	t := NewToSQLTransformation(d, cache, s)
@@ -132,6 +200,8 @@ type ToSQLTransformation struct {
 	spec  *ToSQLProcedureSpec
 	db    *sql.DB
 	tx    *sql.Tx
+	// created records that the table has been created, which is done once for the first table.
+	created bool
 }
 
 func (t *ToSQLTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
@@ -169,11 +239,32 @@ func (t *ToSQLTransformation) Process(id execute.DatasetID, tbl flux.Table) (err
 	if err != nil {
 		return err
 	}
+	if t.spec.Spec.CreateTable && !t.created {
+		if err := t.createTable(tbl.Cols()); err != nil {
+			return err
+		}
+		t.created = true
+	}
 	for i := range valStrings {
 		if err := ExecuteQueries(t.tx, t.spec.Spec, colNames, &valStrings[i], &valArgs[i]); err != nil {
-			return nil
+			return err
 		}
 	}
+	return nil
+}
+
+// createTable creates the table with a column for every column of cols unless it already exists.
+// The key columns, if any, become the primary key so that conflicts can be detected.
+func (t *ToSQLTransformation) createTable(cols []flux.ColMeta) error {
+	driver, err := getDriver(t.spec.Spec.DriverName)
+	if err != nil {
+		return err
+	}
+	q, err := driver.dialect.CreateTableStatement(t.spec.Spec.Table, cols, t.spec.Spec.KeyColumns)
+	if err != nil || q == "" {
+		return err
+	}
+	_, err = t.tx.Exec(q)
 	return err
 }
 
@@ -193,8 +284,8 @@ func (t *ToSQLTransformation) Finish(id execute.DatasetID, err error) {
 		} else {
 			txErr = t.tx.Rollback()
 		}
-		if txErr != nil {
-			panic(txErr)
+		if txErr != nil && err == nil {
+			err = txErr
 		}
 	}
 	t.d.Finish(err)
@@ -203,34 +294,18 @@ func (t *ToSQLTransformation) Finish(id execute.DatasetID, err error) {
 func CreateInsertComponents(t *ToSQLTransformation, tbl flux.Table) (colNames []string, valStringArray [][]string, valArgsArray [][]interface{}, err error) {
 	cols := tbl.Cols()
 	labels := make(map[string]idxType, len(cols))
-	var questionMarks, newSQLTableCols []string
+	var questionMarks []string
 	for i, col := range cols {
 		labels[col.Label] = idxType{Idx: i, Type: col.Type}
 		questionMarks = append(questionMarks, "?")
 		colNames = append(colNames, col.Label)
-
-		switch col.Type {
-		case flux.TFloat:
-			newSQLTableCols = append(newSQLTableCols, fmt.Sprintf("%s FLOAT", col.Label))
-		case flux.TInt:
-			newSQLTableCols = append(newSQLTableCols, fmt.Sprintf("%s BIGINT", col.Label))
-		case flux.TUInt:
-			newSQLTableCols = append(newSQLTableCols, fmt.Sprintf("%s BIGINT", col.Label))
-		case flux.TString:
-			switch t.spec.Spec.DriverName {
-			case "mysql":
-				newSQLTableCols = append(newSQLTableCols, fmt.Sprintf("%s TEXT(16383)", col.Label))
-			case "postgres":
-				newSQLTableCols = append(newSQLTableCols, fmt.Sprintf("%s text", col.Label))
-			}
-		case flux.TTime:
-			newSQLTableCols = append(newSQLTableCols, fmt.Sprintf("%s DATETIME", col.Label))
-		case flux.TBool:
-			newSQLTableCols = append(newSQLTableCols, fmt.Sprintf("%s BOOL", col.Label))
-		default:
-			return nil, nil, nil, errors.Newf(codes.Internal, "invalid type for column %s", col.Label)
+	}
+	for _, k := range t.spec.Spec.KeyColumns {
+		if _, ok := labels[k]; !ok {
+			return nil, nil, nil, errors.Newf(codes.Invalid, "key column %q does not exist in table", k)
 		}
 	}
+	batchSize := t.spec.Spec.batchSize()
 
 	// Creates the placeholders for values in the query
 	// eg: (?,?)
@@ -251,14 +326,6 @@ func CreateInsertComponents(t *ToSQLTransformation, tbl flux.Table) (colNames []
 		// valueArgs holds all the values to pass into the query
 		valueArgs := make([]interface{}, 0, l*len(cols))
 
-		if t.spec.Spec.DriverName != "sqlmock" {
-			q := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s)", t.spec.Spec.Table, strings.Join(newSQLTableCols, ","))
-			_, err = t.tx.Exec(q)
-			if err != nil {
-				return err
-			}
-		}
-
 		for i := 0; i < l; i++ {
 			valueStrings = append(valueStrings, valuePlaceHolders)
 			for j, col := range er.Cols() {
@@ -308,7 +375,7 @@ func CreateInsertComponents(t *ToSQLTransformation, tbl flux.Table) (colNames []
 				return err
 			}
 
-			if i != 0 && i%BatchSize == 0 {
+			if len(valueStrings) == batchSize {
 				valArgsArray = append(valArgsArray, valueArgs)
 				valStringArray = append(valStringArray, valueStrings)
 				valueArgs = make([]interface{}, 0)
@@ -328,24 +395,40 @@ func CreateInsertComponents(t *ToSQLTransformation, tbl flux.Table) (colNames []
 }
 
 func ExecuteQueries(tx *sql.Tx, s *ToSQLOpSpec, colNames []string, valueStrings *[]string, valueArgs *[]interface{}) (err error) {
-	concatValueStrings := strings.Join(*valueStrings, ",")
-
-	// PostgreSQL uses $n instead of ? for placeholders
-	if s.DriverName == "postgres" {
-		for pqCounter := 1; strings.Contains(concatValueStrings, "?"); pqCounter++ {
-			concatValueStrings = strings.Replace(concatValueStrings, "?", fmt.Sprintf("$%v", pqCounter), 1)
-		}
+	driver, err := getDriver(s.DriverName)
+	if err != nil {
+		return err
 	}
+	concatValueStrings := replacePlaceholders(strings.Join(*valueStrings, ","), driver.dialect)
 
-	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s", s.Table, strings.Join(colNames, ","), concatValueStrings)
+	query, err := driver.dialect.InsertStatement(s.Table, colNames, concatValueStrings, Conflict{
+		Action:     s.OnConflict,
+		KeyColumns: s.KeyColumns,
+	})
+	if err != nil {
+		return err
+	}
 	if s.DriverName != "sqlmock" {
-		_, err := tx.Exec(query, *valueArgs...)
-		if err != nil {
-			if rbErr := tx.Rollback(); rbErr != nil {
-				return fmt.Errorf("transaction failed (%s) while recovering from %s", err, rbErr)
-			}
+		// The transaction is rolled back when the transformation finishes with the error.
+		if _, err := tx.Exec(query, *valueArgs...); err != nil {
 			return err
 		}
 	}
-	return err
+	return nil
+}
+
+// replacePlaceholders replaces every ? in the values of an insert statement
+// with the placeholder of the dialect.
+func replacePlaceholders(values string, dialect Dialect) string {
+	var b strings.Builder
+	n := 0
+	for _, r := range values {
+		if r == '?' {
+			n++
+			b.WriteString(dialect.Placeholder(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
 }
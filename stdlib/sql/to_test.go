@@ -36,6 +36,7 @@ func TestSqlTo(t *testing.T) {
 							DriverName:     "sqlmock",
 							DataSourceName: "root@/db",
 							Table:          "TestTable",
+							CreateTable:    true,
 						},
 					},
 				},
@@ -44,6 +45,75 @@ func TestSqlTo(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "to with upsert options",
+			Raw:  `import "sql" from(bucket: "mybucket") |> sql.to(driverName:"sqlmock", dataSourceName:"root@/db", table:"TestTable", batchSize: 100, createTable: true, onConflict: "update", keyColumns: ["_time", "host"])`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mybucket",
+						},
+					},
+					{
+						ID: "toSQL1",
+						Spec: &fsql.ToSQLOpSpec{
+							DriverName:     "sqlmock",
+							DataSourceName: "root@/db",
+							Table:          "TestTable",
+							BatchSize:      100,
+							CreateTable:    true,
+							OnConflict:     "update",
+							KeyColumns:     []string{"_time", "host"},
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "toSQL1"},
+				},
+			},
+		},
+		{
+			Name: "to without creating the table",
+			Raw:  `import "sql" from(bucket: "mybucket") |> sql.to(driverName:"sqlmock", dataSourceName:"root@/db", table:"TestTable", createTable: false)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mybucket",
+						},
+					},
+					{
+						ID: "toSQL1",
+						Spec: &fsql.ToSQLOpSpec{
+							DriverName:     "sqlmock",
+							DataSourceName: "root@/db",
+							Table:          "TestTable",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "toSQL1"},
+				},
+			},
+		},
+		{
+			Name:    "update without key columns",
+			Raw:     `import "sql" from(bucket: "mybucket") |> sql.to(driverName:"sqlmock", dataSourceName:"root@/db", table:"TestTable", onConflict: "update")`,
+			WantErr: true,
+		},
+		{
+			Name:    "unknown conflict action",
+			Raw:     `import "sql" from(bucket: "mybucket") |> sql.to(driverName:"sqlmock", dataSourceName:"root@/db", table:"TestTable", onConflict: "replace")`,
+			WantErr: true,
+		},
+		{
+			Name:    "zero batch size",
+			Raw:     `import "sql" from(bucket: "mybucket") |> sql.to(driverName:"sqlmock", dataSourceName:"root@/db", table:"TestTable", batchSize: 0)`,
+			WantErr: true,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -77,6 +147,7 @@ func TestToSQL_Process(t *testing.T) {
 					DriverName:     driverName,
 					DataSourceName: dsn,
 					Table:          "TestTable2",
+					CreateTable:    true,
 				},
 			},
 			data: executetest.MustCopyTable(&executetest.Table{
@@ -120,6 +191,52 @@ func TestToSQL_Process(t *testing.T) {
 					values.Time(int64(execute.Time(41))).Time(), "c", 4.0, "elevendyone"}},
 			},
 		},
+		{
+			name: "coltable split into batches",
+			spec: &fsql.ToSQLProcedureSpec{
+				Spec: &fsql.ToSQLOpSpec{
+					DriverName:     driverName,
+					DataSourceName: dsn,
+					Table:          "TestTable2",
+					BatchSize:      2,
+				},
+			},
+			data: executetest.MustCopyTable(&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(11), 2.0},
+					{execute.Time(21), 1.0},
+					{execute.Time(31), 3.0},
+				},
+			}),
+			want: wanted{
+				Table: []*executetest.Table{{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(11), 2.0},
+						{execute.Time(21), 1.0},
+						{execute.Time(31), 3.0},
+					},
+				}},
+				ColumnNames:  []string{"_time", "_value"},
+				ValueStrings: [][]string{{"(?,?)", "(?,?)"}, {"(?,?)"}},
+				ValueArgs: [][]interface{}{
+					{
+						values.Time(int64(execute.Time(11))).Time(), 2.0,
+						values.Time(int64(execute.Time(21))).Time(), 1.0,
+					},
+					{
+						values.Time(int64(execute.Time(31))).Time(), 3.0,
+					},
+				},
+			},
+		},
 		{
 			name: "coltable with ints",
 			spec: &fsql.ToSQLProcedureSpec{
@@ -0,0 +1,196 @@
+package universe
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/cespare/xxhash"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/sketch"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+)
+
+const ApproxCountDistinctKind = "approxCountDistinct"
+
+type ApproxCountDistinctOpSpec struct {
+	Precision int64 `json:"precision"`
+	execute.AggregateConfig
+}
+
+func init() {
+	approxCountDistinctSignature := execute.AggregateSignature(map[string]semantic.PolyType{
+		"precision": semantic.Int,
+	}, nil)
+	flux.RegisterPackageValue("universe", ApproxCountDistinctKind, flux.FunctionValue(ApproxCountDistinctKind, createApproxCountDistinctOpSpec, approxCountDistinctSignature))
+	flux.RegisterOpSpec(ApproxCountDistinctKind, newApproxCountDistinctOp)
+	plan.RegisterProcedureSpec(ApproxCountDistinctKind, newApproxCountDistinctProcedure, ApproxCountDistinctKind)
+	execute.RegisterTransformation(ApproxCountDistinctKind, createApproxCountDistinctTransformation)
+}
+
+func createApproxCountDistinctOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+	s := &ApproxCountDistinctOpSpec{
+		Precision: sketch.DefaultPrecision,
+	}
+	if p, ok, err := args.GetInt("precision"); err != nil {
+		return nil, err
+	} else if ok {
+		if p < sketch.MinPrecision || p > sketch.MaxPrecision {
+			return nil, fmt.Errorf("precision must be between %d and %d, got %d", sketch.MinPrecision, sketch.MaxPrecision, p)
+		}
+		s.Precision = p
+	}
+	if err := s.AggregateConfig.ReadArgs(args); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func newApproxCountDistinctOp() flux.OperationSpec {
+	return new(ApproxCountDistinctOpSpec)
+}
+
+func (s *ApproxCountDistinctOpSpec) Kind() flux.OperationKind {
+	return ApproxCountDistinctKind
+}
+
+type ApproxCountDistinctProcedureSpec struct {
+	Precision int64
+	execute.AggregateConfig
+}
+
+func newApproxCountDistinctProcedure(qs flux.OperationSpec, a plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*ApproxCountDistinctOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	return &ApproxCountDistinctProcedureSpec{
+		Precision:       spec.Precision,
+		AggregateConfig: spec.AggregateConfig,
+	}, nil
+}
+
+func (s *ApproxCountDistinctProcedureSpec) Kind() plan.ProcedureKind {
+	return ApproxCountDistinctKind
+}
+
+func (s *ApproxCountDistinctProcedureSpec) Copy() plan.ProcedureSpec {
+	return &ApproxCountDistinctProcedureSpec{
+		Precision:       s.Precision,
+		AggregateConfig: s.AggregateConfig.Copy(),
+	}
+}
+
+// TriggerSpec implements plan.TriggerAwareProcedureSpec
+func (s *ApproxCountDistinctProcedureSpec) TriggerSpec() plan.TriggerSpec {
+	return plan.NarrowTransformationTriggerSpec{}
+}
+
+func createApproxCountDistinctTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*ApproxCountDistinctProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	agg := &ApproxCountDistinctAgg{Precision: int(s.Precision)}
+	t, d := execute.NewAggregateTransformationAndDataset(id, mode, agg, s.AggregateConfig, a.Allocator())
+	return t, d, nil
+}
+
+// ApproxCountDistinctAgg estimates the number of distinct non-null values with a HyperLogLog.
+type ApproxCountDistinctAgg struct {
+	Precision int
+
+	hll *sketch.HyperLogLog
+	buf [8]byte
+}
+
+func (a *ApproxCountDistinctAgg) new() *ApproxCountDistinctAgg {
+	hll, err := sketch.NewHyperLogLog(a.Precision)
+	if err != nil {
+		// The precision is validated when the spec is created.
+		panic(err)
+	}
+	return &ApproxCountDistinctAgg{Precision: a.Precision, hll: hll}
+}
+
+func (a *ApproxCountDistinctAgg) NewBoolAgg() execute.DoBoolAgg {
+	return a.new()
+}
+func (a *ApproxCountDistinctAgg) NewIntAgg() execute.DoIntAgg {
+	return a.new()
+}
+func (a *ApproxCountDistinctAgg) NewUIntAgg() execute.DoUIntAgg {
+	return a.new()
+}
+func (a *ApproxCountDistinctAgg) NewFloatAgg() execute.DoFloatAgg {
+	return a.new()
+}
+func (a *ApproxCountDistinctAgg) NewStringAgg() execute.DoStringAgg {
+	return a.new()
+}
+
+func (a *ApproxCountDistinctAgg) addUint64(v uint64) {
+	binary.LittleEndian.PutUint64(a.buf[:], v)
+	a.hll.Add(xxhash.Sum64(a.buf[:]))
+}
+
+func (a *ApproxCountDistinctAgg) DoBool(vs *array.Boolean) {
+	for i := 0; i < vs.Len(); i++ {
+		if vs.IsValid(i) {
+			var v uint64
+			if vs.Value(i) {
+				v = 1
+			}
+			a.addUint64(v)
+		}
+	}
+}
+func (a *ApproxCountDistinctAgg) DoInt(vs *array.Int64) {
+	for i := 0; i < vs.Len(); i++ {
+		if vs.IsValid(i) {
+			a.addUint64(uint64(vs.Value(i)))
+		}
+	}
+}
+func (a *ApproxCountDistinctAgg) DoUInt(vs *array.Uint64) {
+	for i := 0; i < vs.Len(); i++ {
+		if vs.IsValid(i) {
+			a.addUint64(vs.Value(i))
+		}
+	}
+}
+func (a *ApproxCountDistinctAgg) DoFloat(vs *array.Float64) {
+	for i := 0; i < vs.Len(); i++ {
+		if vs.IsValid(i) {
+			v := vs.Value(i)
+			if v == 0 {
+				// Count 0 and -0 once.
+				v = 0
+			}
+			a.addUint64(math.Float64bits(v))
+		}
+	}
+}
+func (a *ApproxCountDistinctAgg) DoString(vs *array.Binary) {
+	for i := 0; i < vs.Len(); i++ {
+		if vs.IsValid(i) {
+			a.hll.Add(xxhash.Sum64(vs.Value(i)))
+		}
+	}
+}
+
+func (a *ApproxCountDistinctAgg) Type() flux.ColType {
+	return flux.TInt
+}
+func (a *ApproxCountDistinctAgg) ValueInt() int64 {
+	return int64(a.hll.Count())
+}
+func (a *ApproxCountDistinctAgg) IsNull() bool {
+	return false
+}
@@ -0,0 +1,107 @@
+package universe_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/arrow"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+func TestApproxCountDistinct_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "approxCountDistinct",
+			Raw:  `from(bucket:"mydb") |> approxCountDistinct(column: "host", precision: 10)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mydb",
+						},
+					},
+					{
+						ID: "approxCountDistinct1",
+						Spec: &universe.ApproxCountDistinctOpSpec{
+							Precision: 10,
+							AggregateConfig: execute.AggregateConfig{
+								Columns: []string{"host"},
+							},
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "approxCountDistinct1"},
+				},
+			},
+		},
+		{
+			Name:    "precision out of range",
+			Raw:     `from(bucket:"mydb") |> approxCountDistinct(precision: 30)`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestApproxCountDistinct_Process(t *testing.T) {
+	testCases := []struct {
+		name string
+		data func() *array.Float64
+		want int64
+	}{
+		{
+			name: "duplicates",
+			data: func() *array.Float64 {
+				return arrow.NewFloat([]float64{0, 1, 2, 1, 0, 2, 3}, nil)
+			},
+			want: 4,
+		},
+		{
+			name: "empty",
+			data: func() *array.Float64 {
+				return arrow.NewFloat(nil, nil)
+			},
+			want: 0,
+		},
+		{
+			name: "with nulls",
+			data: func() *array.Float64 {
+				b := arrow.NewFloatBuilder(nil)
+				defer b.Release()
+				b.AppendValues([]float64{0, 1}, nil)
+				b.AppendNull()
+				b.AppendValues([]float64{1, 5}, nil)
+				b.AppendNull()
+				return b.NewFloat64Array()
+			},
+			want: 3,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			data := tc.data()
+			defer data.Release()
+
+			executetest.AggFuncTestHelper(
+				t,
+				&universe.ApproxCountDistinctAgg{Precision: 14},
+				data,
+				tc.want,
+			)
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package universe
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/sketch"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const (
+	ApproxTopKKind = "approxTopK"
+	// approxTopKCountLabel is the label of the column with the estimated counts.
+	approxTopKCountLabel = "_count"
+	// approxTopKCounters is the number of counters that approxTopK keeps for every wanted value.
+	approxTopKCounters = 10
+)
+
+type ApproxTopKOpSpec struct {
+	Column string `json:"column"`
+	K      int64  `json:"k"`
+}
+
+func init() {
+	approxTopKSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"column": semantic.String,
+			"k":      semantic.Int,
+		},
+		[]string{"k"},
+	)
+	flux.RegisterPackageValue("universe", ApproxTopKKind, flux.FunctionValue(ApproxTopKKind, createApproxTopKOpSpec, approxTopKSignature))
+	flux.RegisterOpSpec(ApproxTopKKind, newApproxTopKOp)
+	plan.RegisterProcedureSpec(ApproxTopKKind, newApproxTopKProcedure, ApproxTopKKind)
+	execute.RegisterTransformation(ApproxTopKKind, createApproxTopKTransformation)
+}
+
+func createApproxTopKOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+	spec := &ApproxTopKOpSpec{
+		Column: execute.DefaultValueColLabel,
+	}
+	k, err := args.GetRequiredInt("k")
+	if err != nil {
+		return nil, err
+	}
+	if k <= 0 {
+		return nil, errors.New("k must be a positive number of values")
+	}
+	spec.K = k
+	if col, ok, err := args.GetString("column"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Column = col
+	}
+	return spec, nil
+}
+
+func newApproxTopKOp() flux.OperationSpec {
+	return new(ApproxTopKOpSpec)
+}
+
+func (s *ApproxTopKOpSpec) Kind() flux.OperationKind {
+	return ApproxTopKKind
+}
+
+type ApproxTopKProcedureSpec struct {
+	plan.DefaultCost
+	Column string
+	K      int64
+}
+
+func newApproxTopKProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*ApproxTopKOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	return &ApproxTopKProcedureSpec{
+		Column: spec.Column,
+		K:      spec.K,
+	}, nil
+}
+
+func (s *ApproxTopKProcedureSpec) Kind() plan.ProcedureKind {
+	return ApproxTopKKind
+}
+
+func (s *ApproxTopKProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	return &ns
+}
+
+// TriggerSpec implements plan.TriggerAwareProcedureSpec
+func (s *ApproxTopKProcedureSpec) TriggerSpec() plan.TriggerSpec {
+	return plan.NarrowTransformationTriggerSpec{}
+}
+
+func createApproxTopKTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*ApproxTopKProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewApproxTopKTransformation(d, cache, s)
+	return t, d, nil
+}
+
+type approxTopKTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+
+	column string
+	k      int
+}
+
+// NewApproxTopKTransformation creates a transformation that estimates the k most frequent
+// non-null values of a column of every table with a Space-Saving sketch.
+// Unlike an aggregate, it outputs up to k rows per table, with the value and its estimated count,
+// the most frequent value first.
+func NewApproxTopKTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *ApproxTopKProcedureSpec) *approxTopKTransformation {
+	return &approxTopKTransformation{
+		d:      d,
+		cache:  cache,
+		column: spec.Column,
+		k:      int(spec.K),
+	}
+}
+
+func (t *approxTopKTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *approxTopKTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	builder, created := t.cache.TableBuilder(tbl.Key())
+	if !created {
+		return fmt.Errorf("approxTopK found duplicate table with key: %v", tbl.Key())
+	}
+
+	j := execute.ColIdx(t.column, tbl.Cols())
+	if j < 0 {
+		return fmt.Errorf("column %q does not exist", t.column)
+	}
+	if tbl.Key().HasCol(t.column) {
+		return errors.New("cannot aggregate columns that are part of the group key")
+	}
+	col := tbl.Cols()[j]
+
+	if err := execute.AddTableKeyCols(tbl.Key(), builder); err != nil {
+		return err
+	}
+	valueIdx, err := builder.AddCol(col)
+	if err != nil {
+		return err
+	}
+	countIdx, err := builder.AddCol(flux.ColMeta{Label: approxTopKCountLabel, Type: flux.TInt})
+	if err != nil {
+		return err
+	}
+
+	ss := sketch.NewSpaceSaving(t.k * approxTopKCounters)
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		for i := 0; i < cr.Len(); i++ {
+			v := execute.ValueForRow(cr, i, j)
+			if v.IsNull() {
+				continue
+			}
+			switch col.Type {
+			case flux.TBool:
+				ss.Add(v.Bool())
+			case flux.TInt:
+				ss.Add(v.Int())
+			case flux.TUInt:
+				ss.Add(v.UInt())
+			case flux.TFloat:
+				ss.Add(v.Float())
+			case flux.TString:
+				ss.Add(v.Str())
+			case flux.TTime:
+				ss.Add(v.Time())
+			default:
+				return fmt.Errorf("unsupported approxTopK column type %v", col.Type)
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for _, c := range ss.Top(t.k, lessItem) {
+		if err := builder.AppendValue(valueIdx, values.New(c.Item)); err != nil {
+			return err
+		}
+		if err := builder.AppendInt(countIdx, c.Count); err != nil {
+			return err
+		}
+		if err := execute.AppendKeyValues(tbl.Key(), builder); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lessItem orders the items of a Space-Saving sketch, which all have the same type.
+func lessItem(a, b interface{}) bool {
+	switch a := a.(type) {
+	case bool:
+		return !a && b.(bool)
+	case int64:
+		return a < b.(int64)
+	case uint64:
+		return a < b.(uint64)
+	case float64:
+		return a < b.(float64)
+	case string:
+		return a < b.(string)
+	case values.Time:
+		return a < b.(values.Time)
+	default:
+		return false
+	}
+}
+
+func (t *approxTopKTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+func (t *approxTopKTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+func (t *approxTopKTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
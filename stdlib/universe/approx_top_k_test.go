@@ -0,0 +1,161 @@
+package universe_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+func TestApproxTopK_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "approxTopK",
+			Raw:  `from(bucket:"mydb") |> approxTopK(k: 3, column: "host")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mydb",
+						},
+					},
+					{
+						ID: "approxTopK1",
+						Spec: &universe.ApproxTopKOpSpec{
+							Column: "host",
+							K:      3,
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "approxTopK1"},
+				},
+			},
+		},
+		{
+			Name:    "non-positive k",
+			Raw:     `from(bucket:"mydb") |> approxTopK(k: 0)`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestApproxTopK_Process(t *testing.T) {
+	testCases := []struct {
+		name    string
+		spec    *universe.ApproxTopKProcedureSpec
+		data    []flux.Table
+		want    []*executetest.Table
+		wantErr error
+	}{
+		{
+			name: "strings",
+			spec: &universe.ApproxTopKProcedureSpec{
+				Column: "host",
+				K:      2,
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"_measurement"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "_time", Type: flux.TTime},
+					{Label: "host", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{"m", execute.Time(1), "b"},
+					{"m", execute.Time(2), "a"},
+					{"m", execute.Time(3), "c"},
+					{"m", execute.Time(4), nil},
+					{"m", execute.Time(5), "a"},
+					{"m", execute.Time(6), "c"},
+					{"m", execute.Time(7), "a"},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"_measurement"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_measurement", Type: flux.TString},
+					{Label: "host", Type: flux.TString},
+					{Label: "_count", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{"m", "a", int64(3)},
+					{"m", "c", int64(2)},
+				},
+			}},
+		},
+		{
+			name: "ties ordered by value",
+			spec: &universe.ApproxTopKProcedureSpec{
+				Column: "_value",
+				K:      5,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), int64(3)},
+					{execute.Time(2), int64(1)},
+					{execute.Time(3), int64(2)},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_value", Type: flux.TInt},
+					{Label: "_count", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{int64(1), int64(1)},
+					{int64(2), int64(1)},
+					{int64(3), int64(1)},
+				},
+			}},
+		},
+		{
+			name: "missing column",
+			spec: &universe.ApproxTopKProcedureSpec{
+				Column: "host",
+				K:      2,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), int64(3)},
+				},
+			}},
+			wantErr: errors.New(`column "host" does not exist`),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				tc.wantErr,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					return universe.NewApproxTopKTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
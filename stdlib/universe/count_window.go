@@ -0,0 +1,153 @@
+package universe
+
+import (
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/pkg/errors"
+)
+
+const CountWindowKind = "countWindow"
+
+// CountWindowOpSpec specifies windows of a fixed number of rows.
+type CountWindowOpSpec struct {
+	N           int64  `json:"n"`
+	TimeColumn  string `json:"timeColumn"`
+	StartColumn string `json:"startColumn"`
+	StopColumn  string `json:"stopColumn"`
+}
+
+func init() {
+	countWindowSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"n":           semantic.Int,
+			"timeColumn":  semantic.String,
+			"startColumn": semantic.String,
+			"stopColumn":  semantic.String,
+		},
+		[]string{"n"},
+	)
+
+	flux.RegisterPackageValue("universe", CountWindowKind, flux.FunctionValue(CountWindowKind, createCountWindowOpSpec, countWindowSignature))
+	flux.RegisterOpSpec(CountWindowKind, newCountWindowOp)
+	plan.RegisterProcedureSpec(CountWindowKind, newCountWindowProcedure, CountWindowKind)
+	execute.RegisterTransformation(CountWindowKind, createCountWindowTransformation)
+}
+
+func createCountWindowOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	spec := new(CountWindowOpSpec)
+	n, err := args.GetRequiredInt("n")
+	if err != nil {
+		return nil, err
+	}
+	if n <= 0 {
+		return nil, errors.New("n must be a positive number of rows")
+	}
+	spec.N = n
+
+	spec.TimeColumn, spec.StartColumn, spec.StopColumn, err = getWindowColumns(args)
+	if err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+func newCountWindowOp() flux.OperationSpec {
+	return new(CountWindowOpSpec)
+}
+
+func (s *CountWindowOpSpec) Kind() flux.OperationKind {
+	return CountWindowKind
+}
+
+type CountWindowProcedureSpec struct {
+	plan.DefaultCost
+	N int64
+	TimeColumn,
+	StartColumn,
+	StopColumn string
+}
+
+func newCountWindowProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	s, ok := qs.(*CountWindowOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	return &CountWindowProcedureSpec{
+		N:           s.N,
+		TimeColumn:  s.TimeColumn,
+		StartColumn: s.StartColumn,
+		StopColumn:  s.StopColumn,
+	}, nil
+}
+
+func (s *CountWindowProcedureSpec) Kind() plan.ProcedureKind {
+	return CountWindowKind
+}
+
+func (s *CountWindowProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	return &ns
+}
+
+func createCountWindowTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*CountWindowProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewCountWindowTransformation(d, cache, a.Allocator(), s)
+	return t, d, nil
+}
+
+// NewCountWindowTransformation creates a transformation that puts every n rows of a table,
+// in the order of the table, into a window. The last window of a table may have fewer rows.
+// A window starts at the earliest time of its rows and stops just after the latest time.
+// When the rows of two windows have the same earliest and latest times, the stop of the later
+// window is moved forward until its bounds are unique, so that the windows are not merged.
+func NewCountWindowTransformation(d execute.Dataset, cache execute.TableBuilderCache, alloc *memory.Allocator, spec *CountWindowProcedureSpec) execute.Transformation {
+	n := int(spec.N)
+	return &rowWindowTransformation{
+		d:        d,
+		cache:    cache,
+		alloc:    alloc,
+		timeCol:  spec.TimeColumn,
+		startCol: spec.StartColumn,
+		stopCol:  spec.StopColumn,
+		split: func(times []execute.Time) []rowWindow {
+			windows := make([]rowWindow, 0, (len(times)+n-1)/n)
+			seen := make(map[execute.Bounds]bool, cap(windows))
+			for start := 0; start < len(times); start += n {
+				stop := start + n
+				if stop > len(times) {
+					stop = len(times)
+				}
+				bounds := execute.Bounds{Start: times[start], Stop: times[start]}
+				for _, tm := range times[start:stop] {
+					if tm < bounds.Start {
+						bounds.Start = tm
+					}
+					if tm > bounds.Stop {
+						bounds.Stop = tm
+					}
+				}
+				bounds.Stop++
+				for seen[bounds] {
+					bounds.Stop++
+				}
+				seen[bounds] = true
+				windows = append(windows, rowWindow{start: start, stop: stop, bounds: bounds})
+			}
+			return windows
+		},
+	}
+}
@@ -0,0 +1,180 @@
+package universe_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+func TestCountWindow_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "from with count window",
+			Raw:  `from(bucket:"mybucket") |> countWindow(n: 100)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mybucket",
+						},
+					},
+					{
+						ID: "countWindow1",
+						Spec: &universe.CountWindowOpSpec{
+							N:           100,
+							TimeColumn:  execute.DefaultTimeColLabel,
+							StartColumn: execute.DefaultStartColLabel,
+							StopColumn:  execute.DefaultStopColLabel,
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "countWindow1"},
+				},
+			},
+		},
+		{
+			Name:    "negative n",
+			Raw:     `from(bucket:"mybucket") |> countWindow(n: -1)`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestCountWindow_Process(t *testing.T) {
+	spec := &universe.CountWindowProcedureSpec{
+		N:           2,
+		TimeColumn:  "_time",
+		StartColumn: "_start",
+		StopColumn:  "_stop",
+	}
+	data := []flux.Table{&executetest.Table{
+		ColMeta: []flux.ColMeta{
+			{Label: "_time", Type: flux.TTime},
+			{Label: "_value", Type: flux.TFloat},
+		},
+		Data: [][]interface{}{
+			{execute.Time(1), 1.0},
+			{nil, 0.0},
+			{execute.Time(2), 2.0},
+			{execute.Time(5), 3.0},
+			{execute.Time(3), 4.0},
+			{execute.Time(7), 5.0},
+		},
+	}}
+	cols := []flux.ColMeta{
+		{Label: "_time", Type: flux.TTime},
+		{Label: "_value", Type: flux.TFloat},
+		{Label: "_start", Type: flux.TTime},
+		{Label: "_stop", Type: flux.TTime},
+	}
+	want := []*executetest.Table{
+		{
+			KeyCols: []string{"_start", "_stop"},
+			ColMeta: cols,
+			Data: [][]interface{}{
+				{execute.Time(1), 1.0, execute.Time(1), execute.Time(3)},
+				{execute.Time(2), 2.0, execute.Time(1), execute.Time(3)},
+			},
+		},
+		{
+			KeyCols: []string{"_start", "_stop"},
+			ColMeta: cols,
+			Data: [][]interface{}{
+				{execute.Time(5), 3.0, execute.Time(3), execute.Time(6)},
+				{execute.Time(3), 4.0, execute.Time(3), execute.Time(6)},
+			},
+		},
+		{
+			KeyCols: []string{"_start", "_stop"},
+			ColMeta: cols,
+			Data: [][]interface{}{
+				{execute.Time(7), 5.0, execute.Time(7), execute.Time(8)},
+			},
+		},
+	}
+	executetest.ProcessTestHelper(
+		t,
+		data,
+		want,
+		nil,
+		func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+			return universe.NewCountWindowTransformation(d, c, executetest.UnlimitedAllocator, spec)
+		},
+	)
+}
+
+func TestCountWindow_ProcessSameBounds(t *testing.T) {
+	spec := &universe.CountWindowProcedureSpec{
+		N:           2,
+		TimeColumn:  "_time",
+		StartColumn: "_start",
+		StopColumn:  "_stop",
+	}
+	data := []flux.Table{&executetest.Table{
+		ColMeta: []flux.ColMeta{
+			{Label: "_time", Type: flux.TTime},
+			{Label: "_value", Type: flux.TFloat},
+		},
+		Data: [][]interface{}{
+			{execute.Time(1), 1.0},
+			{execute.Time(1), 2.0},
+			{execute.Time(1), 3.0},
+			{execute.Time(1), 4.0},
+			{execute.Time(1), 5.0},
+		},
+	}}
+	cols := []flux.ColMeta{
+		{Label: "_time", Type: flux.TTime},
+		{Label: "_value", Type: flux.TFloat},
+		{Label: "_start", Type: flux.TTime},
+		{Label: "_stop", Type: flux.TTime},
+	}
+	want := []*executetest.Table{
+		{
+			KeyCols: []string{"_start", "_stop"},
+			ColMeta: cols,
+			Data: [][]interface{}{
+				{execute.Time(1), 1.0, execute.Time(1), execute.Time(2)},
+				{execute.Time(1), 2.0, execute.Time(1), execute.Time(2)},
+			},
+		},
+		{
+			KeyCols: []string{"_start", "_stop"},
+			ColMeta: cols,
+			Data: [][]interface{}{
+				{execute.Time(1), 3.0, execute.Time(1), execute.Time(3)},
+				{execute.Time(1), 4.0, execute.Time(1), execute.Time(3)},
+			},
+		},
+		{
+			KeyCols: []string{"_start", "_stop"},
+			ColMeta: cols,
+			Data: [][]interface{}{
+				{execute.Time(1), 5.0, execute.Time(1), execute.Time(4)},
+			},
+		},
+	}
+	executetest.ProcessTestHelper(
+		t,
+		data,
+		want,
+		nil,
+		func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+			return universe.NewCountWindowTransformation(d, c, executetest.UnlimitedAllocator, spec)
+		},
+	)
+}
@@ -1,6 +1,7 @@
 package universe
 
 import (
+	"math"
 	"strconv"
 
 	"github.com/influxdata/flux"
@@ -14,11 +15,21 @@ import (
 
 const FillKind = "fill"
 
+// The methods that fill null values from the values around them.
+const (
+	// FillLinear fills null values on the line between the previous and the next
+	// non-null value, weighted by the time of the rows.
+	FillLinear = "linear"
+	// FillNext fills null values with the next non-null value.
+	FillNext = "next"
+)
+
 type FillOpSpec struct {
 	Column      string `json:"column"`
 	Type        string `json:"type"`
 	Value       string `json:"value"`
 	UsePrevious bool   `json:"use_previous"`
+	Method      string `json:"method,omitempty"`
 }
 
 func init() {
@@ -27,6 +38,7 @@ func init() {
 			"column":      semantic.String,
 			"value":       semantic.Tvar(1),
 			"usePrevious": semantic.Bool,
+			"method":      semantic.String,
 		},
 		[]string{},
 	)
@@ -79,13 +91,29 @@ func createFillOpSpec(args flux.Arguments, a *flux.Administration) (flux.Operati
 	if err != nil {
 		return nil, err
 	}
-	if prevOk == valOk {
-		return nil, errors.New(codes.Invalid, "fill requires exactly one of value or usePrevious")
+	method, methodOk, err := args.GetString("method")
+	if err != nil {
+		return nil, err
+	}
+	n := 0
+	for _, ok := range []bool{valOk, prevOk, methodOk} {
+		if ok {
+			n++
+		}
+	}
+	if n != 1 {
+		return nil, errors.New(codes.Invalid, "fill requires exactly one of value, usePrevious or method")
 	}
 
 	if prevOk {
 		spec.UsePrevious = usePrevious
 	}
+	if methodOk {
+		if method != FillLinear && method != FillNext {
+			return nil, errors.Newf(codes.Invalid, "fill method must be %q or %q, got %q", FillLinear, FillNext, method)
+		}
+		spec.Method = method
+	}
 
 	return spec, nil
 }
@@ -103,6 +131,7 @@ type FillProcedureSpec struct {
 	Column      string
 	Value       values.Value
 	UsePrevious bool
+	Method      string
 }
 
 func newFillProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -114,8 +143,9 @@ func newFillProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.Proce
 	pspec := &FillProcedureSpec{
 		Column:      spec.Column,
 		UsePrevious: spec.UsePrevious,
+		Method:      spec.Method,
 	}
-	if !spec.UsePrevious {
+	if !spec.UsePrevious && spec.Method == "" {
 		switch spec.Type {
 		case "bool":
 			v, err := strconv.ParseBool(spec.Value)
@@ -220,6 +250,9 @@ func (t *fillTransformation) Process(id execute.DatasetID, tbl flux.Table) error
 	if idx < 0 {
 		return errors.Newf(codes.FailedPrecondition, "fill column not found: %s", t.spec.Column)
 	}
+	if t.spec.Method != "" {
+		return t.fillFromNeighbors(tbl, builder, idx)
+	}
 
 	prevNonNull := t.spec.Value
 	if !t.spec.UsePrevious {
@@ -265,6 +298,104 @@ func (t *fillTransformation) Process(id execute.DatasetID, tbl flux.Table) error
 	})
 }
 
+// fillFromNeighbors fills the null values of the column with the fill method.
+// The values after a null value are needed to fill it, so the whole table is appended first
+// and the null values are set afterwards.
+func (t *fillTransformation) fillFromNeighbors(tbl flux.Table, builder execute.TableBuilder, idx int) error {
+	col := builder.Cols()[idx]
+	timeIdx := -1
+	if t.spec.Method == FillLinear {
+		switch col.Type {
+		case flux.TInt, flux.TUInt, flux.TFloat:
+		default:
+			return errors.Newf(codes.FailedPrecondition, "fill method linear requires a numeric column, %s has type %v", col.Label, col.Type)
+		}
+		timeIdx = execute.ColIdx(execute.DefaultTimeColLabel, builder.Cols())
+		if timeIdx < 0 || builder.Cols()[timeIdx].Type != flux.TTime {
+			return errors.Newf(codes.FailedPrecondition, "fill method linear requires a %s column of type time", execute.DefaultTimeColLabel)
+		}
+	}
+
+	start := builder.NRows()
+	var vs []values.Value
+	var times []execute.Time
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		if err := execute.AppendCols(cr, builder); err != nil {
+			return err
+		}
+		for i := 0; i < cr.Len(); i++ {
+			vs = append(vs, execute.ValueForRow(cr, i, idx))
+			if timeIdx >= 0 {
+				// A null time is treated as the epoch.
+				times = append(times, execute.Time(cr.Times(timeIdx).Value(i)))
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// next is the index of the next non-null value after i, or -1 if there is none.
+	next := -1
+	prev := -1
+	nexts := make([]int, len(vs))
+	for i := len(vs) - 1; i >= 0; i-- {
+		nexts[i] = next
+		if !vs[i].IsNull() {
+			next = i
+		}
+	}
+	for i, v := range vs {
+		if !v.IsNull() {
+			prev = i
+			continue
+		}
+		n := nexts[i]
+		if n < 0 {
+			// Trailing null values have nothing to be filled from.
+			break
+		}
+		fill := vs[n]
+		if t.spec.Method == FillLinear {
+			if prev < 0 {
+				// Leading null values cannot be interpolated.
+				continue
+			}
+			fill = interpolateLinear(col.Type, times[prev], vs[prev], times[n], vs[n], times[i])
+		}
+		if err := builder.SetValue(start+i, idx, fill); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// interpolateLinear computes the value at time t on the line between two values of a numeric column.
+// Integer values are rounded to the nearest integer.
+func interpolateLinear(typ flux.ColType, t0 execute.Time, v0 values.Value, t1 execute.Time, v1 values.Value, t execute.Time) values.Value {
+	var f0, f1 float64
+	switch typ {
+	case flux.TInt:
+		f0, f1 = float64(v0.Int()), float64(v1.Int())
+	case flux.TUInt:
+		f0, f1 = float64(v0.UInt()), float64(v1.UInt())
+	default:
+		f0, f1 = v0.Float(), v1.Float()
+	}
+	f := f0
+	if t1 != t0 {
+		f = f0 + (f1-f0)*float64(t-t0)/float64(t1-t0)
+	}
+	switch typ {
+	case flux.TInt:
+		return values.NewInt(int64(math.Round(f)))
+	case flux.TUInt:
+		return values.NewUInt(uint64(math.Round(f)))
+	default:
+		return values.NewFloat(f)
+	}
+}
+
 func (t *fillTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
 	return t.d.UpdateWatermark(mark)
 }
@@ -1,6 +1,7 @@
 package universe_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -111,6 +112,40 @@ func TestFill_NewQuery(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "fill with method",
+			Raw:  `from(bucket:"mydb") |> fill(method: "linear")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mydb",
+						},
+					},
+					{
+						ID: "fill1",
+						Spec: &universe.FillOpSpec{
+							Column: "_value",
+							Method: universe.FillLinear,
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "fill1"},
+				},
+			},
+		},
+		{
+			Name:    "fill with unknown method",
+			Raw:     `from(bucket:"mydb") |> fill(method: "cubic")`,
+			WantErr: true,
+		},
+		{
+			Name:    "fill with method and value",
+			Raw:     `from(bucket:"mydb") |> fill(method: "next", value: 1.0)`,
+			WantErr: true,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -123,10 +158,11 @@ func TestFill_NewQuery(t *testing.T) {
 
 func TestFill_Process(t *testing.T) {
 	testCases := []struct {
-		name string
-		spec *universe.FillProcedureSpec
-		data []flux.Table
-		want []*executetest.Table
+		name    string
+		spec    *universe.FillProcedureSpec
+		data    []flux.Table
+		want    []*executetest.Table
+		wantErr error
 	}{
 		{
 			name: "nothing to fill",
@@ -427,6 +463,128 @@ func TestFill_Process(t *testing.T) {
 				Data: [][]interface{}(nil),
 			}},
 		},
+		{
+			name: "fill next",
+			spec: &universe.FillProcedureSpec{
+				DefaultCost: plan.DefaultCost{},
+				Column:      "_value",
+				Method:      universe.FillNext,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil},
+					{execute.Time(2), "A"},
+					{execute.Time(3), nil},
+					{execute.Time(4), nil},
+					{execute.Time(5), "B"},
+					{execute.Time(6), nil},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "A"},
+					{execute.Time(2), "A"},
+					{execute.Time(3), "B"},
+					{execute.Time(4), "B"},
+					{execute.Time(5), "B"},
+					{execute.Time(6), nil},
+				},
+			}},
+		},
+		{
+			name: "fill linear float",
+			spec: &universe.FillProcedureSpec{
+				DefaultCost: plan.DefaultCost{},
+				Column:      "_value",
+				Method:      universe.FillLinear,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil},
+					{execute.Time(2), 1.0},
+					{execute.Time(3), nil},
+					{execute.Time(6), nil},
+					{execute.Time(7), 6.0},
+					{execute.Time(8), nil},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil},
+					{execute.Time(2), 1.0},
+					{execute.Time(3), 2.0},
+					{execute.Time(6), 5.0},
+					{execute.Time(7), 6.0},
+					{execute.Time(8), nil},
+				},
+			}},
+		},
+		{
+			name: "fill linear int",
+			spec: &universe.FillProcedureSpec{
+				DefaultCost: plan.DefaultCost{},
+				Column:      "_value",
+				Method:      universe.FillLinear,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), int64(0)},
+					{execute.Time(1), nil},
+					{execute.Time(2), nil},
+					{execute.Time(3), int64(10)},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), int64(0)},
+					{execute.Time(1), int64(3)},
+					{execute.Time(2), int64(7)},
+					{execute.Time(3), int64(10)},
+				},
+			}},
+		},
+		{
+			name: "fill linear string",
+			spec: &universe.FillProcedureSpec{
+				DefaultCost: plan.DefaultCost{},
+				Column:      "_value",
+				Method:      universe.FillLinear,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "A"},
+				},
+			}},
+			wantErr: errors.New("fill method linear requires a numeric column, _value has type string"),
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -435,7 +593,7 @@ func TestFill_Process(t *testing.T) {
 				t,
 				tc.data,
 				tc.want,
-				nil,
+				tc.wantErr,
 				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
 					return universe.NewFillTransformation(d, c, tc.spec)
 				},
@@ -135,7 +135,7 @@ func createGroupTransformation(id execute.DatasetID, mode execute.AccumulationMo
 	if !ok {
 		return nil, nil, errors.Newf(codes.Internal, "invalid spec type %T", spec)
 	}
-	cache := execute.NewTableBuilderCache(a.Allocator())
+	cache := execute.NewSpillableTableBuilderCache(a)
 	d := execute.NewDataset(id, mode, cache)
 	t := NewGroupTransformation(d, cache, s)
 	return t, d, nil
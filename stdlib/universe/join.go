@@ -39,6 +39,10 @@ func init() {
 // All supported join types in Flux
 var methods = map[string]bool{
 	"inner": true,
+	"left":  true,
+	"right": true,
+	"full":  true,
+	"cross": true,
 }
 
 // JoinOpSpec specifies a particular join operation
@@ -83,18 +87,6 @@ func (params *joinParams) Less(i, j int) bool {
 func createJoinOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
 	spec := new(JoinOpSpec)
 
-	// On specifies the columns to join on, and is required.
-	if array, err := args.GetRequiredArray("on", semantic.String); err != nil {
-		return nil, err
-	} else if array.Len() == 0 {
-		return nil, errors.New("at least one column in 'on' column list is required")
-	} else {
-		spec.On, err = interpreter.ToStringArray(array)
-		if err != nil {
-			return nil, err
-		}
-	}
-
 	// Method is an optional parameter that when not specified defaults to
 	// the inner join type.
 	if joinType, ok, err := args.GetString("method"); err != nil {
@@ -107,18 +99,42 @@ func createJoinOpSpec(args flux.Arguments, a *flux.Administration) (flux.Operati
 		spec.Method = "inner"
 	}
 
-	// It is not valid to specify a list of 'on' columns for a cross product
-	if spec.Method == "cross" && spec.On != nil {
+	// On specifies the columns to join on, and is required for
+	// every join type except the cross product.
+	if array, ok, err := args.GetArray("on", semantic.String); err != nil {
+		return nil, err
+	} else if ok && spec.Method == "cross" {
+		// It is not valid to specify a list of 'on' columns for a cross product
 		return nil, errors.New("cross product and 'on' are mutually exclusive")
+	} else if !ok && spec.Method != "cross" {
+		return nil, fmt.Errorf("missing required keyword argument %q", "on")
+	} else if ok && array.Len() == 0 {
+		return nil, errors.New("at least one column in 'on' column list is required")
+	} else if ok {
+		spec.On, err = interpreter.ToStringArray(array)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	tables, err := args.GetRequiredObject("tables")
+	var err error
+	spec.TableNames, spec.params, err = readJoinTables(args, a)
 	if err != nil {
 		return nil, err
 	}
+	return spec, nil
+}
+
+// readJoinTables reads the streams to join from the tables argument
+// and adds them as parents in a consistent order.
+func readJoinTables(args flux.Arguments, a *flux.Administration) (map[flux.OperationID]string, *joinParams, error) {
+	tables, err := args.GetRequiredObject("tables")
+	if err != nil {
+		return nil, nil, err
+	}
 
-	spec.TableNames = make(map[flux.OperationID]string, tables.Len())
-	spec.params = newJoinParams(tables.Len())
+	tableNames := make(map[flux.OperationID]string, tables.Len())
+	params := newJoinParams(tables.Len())
 	tables.Range(func(name string, operation values.Value) {
 		if err != nil {
 			return
@@ -133,30 +149,35 @@ func createJoinOpSpec(args flux.Arguments, a *flux.Administration) (flux.Operati
 			err = fmt.Errorf("expected %q to be TableObject type, instead got %v", name, operation.Type())
 			return
 		}
-		spec.params.names = append(spec.params.names, name)
-		spec.params.operations = append(spec.params.operations, table)
+		params.names = append(params.names, name)
+		params.operations = append(params.operations, table)
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Add parents in a consistent manner by sorting
 	// based on their corresponding function parameter.
-	sort.Sort(spec.params)
-	for _, op := range spec.params.operations {
+	sort.Sort(params)
+	for _, op := range params.operations {
 		a.AddParent(op)
 	}
 
-	return spec, nil
+	return tableNames, params, nil
 }
 
-func (t *JoinOpSpec) IDer(ider flux.IDer) {
-	for i, name := range t.params.names {
-		operation := t.params.operations[i]
-		t.TableNames[ider.ID(operation)] = name
+// setTableNames maps the ID of every stream to its name.
+func (params *joinParams) setTableNames(ider flux.IDer, tableNames map[flux.OperationID]string) {
+	for i, name := range params.names {
+		operation := params.operations[i]
+		tableNames[ider.ID(operation)] = name
 	}
 }
 
+func (t *JoinOpSpec) IDer(ider flux.IDer) {
+	t.params.setTableNames(ider, t.TableNames)
+}
+
 func newJoinOp() flux.OperationSpec {
 	return new(JoinOpSpec)
 }
@@ -169,6 +190,9 @@ type MergeJoinProcedureSpec struct {
 	plan.DefaultCost
 	TableNames []string `json:"table_names"`
 	On         []string `json:"keys"`
+	// Method is one of inner, left, right, full or cross.
+	// An empty method is an inner join.
+	Method string `json:"method"`
 }
 
 func newMergeJoinProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -195,6 +219,7 @@ func newMergeJoinProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.
 	return &MergeJoinProcedureSpec{
 		On:         on,
 		TableNames: tableNames,
+		Method:     spec.Method,
 	}, nil
 }
 
@@ -207,6 +232,10 @@ func (s *MergeJoinProcedureSpec) Copy() plan.ProcedureSpec {
 	ns.On = make([]string, len(s.On))
 	copy(ns.On, s.On)
 
+	ns.TableNames = make([]string, len(s.TableNames))
+	copy(ns.TableNames, s.TableNames)
+
+	ns.Method = s.Method
 	return ns
 }
 
@@ -226,7 +255,10 @@ func createMergeJoinTransformation(id execute.DatasetID, mode execute.Accumulati
 		tableNames[parents[i]] = name
 	}
 
-	cache := NewMergeJoinCache(a.Allocator(), parents, tableNames, s.On)
+	// The buffered tables of both streams are spilled to disk under memory pressure.
+	cache := newMergeJoinCache(a.Allocator(), func() bufferCache {
+		return execute.NewSpillableTableBuilderCache(a)
+	}, parents, tableNames, s.On, s.Method)
 	d := execute.NewDataset(id, mode, cache)
 	t := NewMergeJoinTransformation(d, cache, s, parents, tableNames)
 	return t, d, nil
@@ -341,6 +373,11 @@ func (t *mergeJoinTransformation) Finish(id execute.DatasetID, err error) {
 	}
 
 	if finished {
+		// Tables without a match on the other side can only be
+		// known once both sides have finished.
+		if t.err == nil {
+			t.cache.registerUnmatchedKeys()
+		}
 		t.d.Finish(t.err)
 	}
 }
@@ -362,9 +399,18 @@ func (t *mergeJoinTransformation) Finish(id execute.DatasetID, err error) {
 //
 // tables:          All output tables are materialized and stored in this
 //                  map before being sent to downstream operators.
+//
+// method:          The join type. Outer joins keep the rows of the left,
+//                  the right or both sides that have no match on the other
+//                  side, filling the missing columns with nulls.
+//
+// asOf:            Set for an as-of join, which pairs a row with the
+//                  closest row in time instead of every equal row.
 type MergeJoinCache struct {
 	leftID  execute.DatasetID
 	rightID execute.DatasetID
+	method  string
+	asOf    *asOfJoin
 
 	names   map[execute.DatasetID]string
 	schemas map[execute.DatasetID]schema
@@ -373,6 +419,9 @@ type MergeJoinCache struct {
 	on           map[string]bool
 	order        []string
 	intersection map[string]bool
+	// joined are the columns that appear once in the output
+	// instead of once per stream.
+	joined map[string]bool
 
 	schema    schema
 	colIndex  map[flux.ColMeta]int
@@ -386,8 +435,18 @@ type MergeJoinCache struct {
 	triggerSpec plan.TriggerSpec
 }
 
+// bufferCache is a cache that buffers tables until they are read back.
+// The cache of execute.NewSpillableTableBuilderCache is one,
+// which spills the buffered tables to disk under memory pressure.
+type bufferCache interface {
+	execute.TableBuilderCache
+	execute.DataCache
+}
+
 type streamBuffer struct {
-	data     map[flux.GroupKey]*execute.ColListTableBuilder
+	cache    bufferCache
+	nrows    map[flux.GroupKey]int
+	matched  map[flux.GroupKey]bool
 	consumed map[values.Value]int
 	ready    map[values.Value]bool
 	stale    map[flux.GroupKey]bool
@@ -395,9 +454,12 @@ type streamBuffer struct {
 	alloc    *memory.Allocator
 }
 
-func newStreamBuffer(alloc *memory.Allocator) *streamBuffer {
+func newStreamBuffer(cache bufferCache, alloc *memory.Allocator) *streamBuffer {
+	cache.SetTriggerSpec(plan.DefaultTriggerSpec)
 	return &streamBuffer{
-		data:     make(map[flux.GroupKey]*execute.ColListTableBuilder),
+		cache:    cache,
+		nrows:    make(map[flux.GroupKey]int),
+		matched:  make(map[flux.GroupKey]bool),
 		consumed: make(map[values.Value]int),
 		ready:    make(map[values.Value]bool),
 		stale:    make(map[flux.GroupKey]bool),
@@ -405,13 +467,43 @@ func newStreamBuffer(alloc *memory.Allocator) *streamBuffer {
 	}
 }
 
-func (buf *streamBuffer) table(key flux.GroupKey) *execute.ColListTableBuilder {
-	return buf.data[key]
+// table reads the buffered table with key into a builder that can be sorted,
+// or returns nil if there is no such table. The rows are read back from disk
+// if they have been spilled, and the builder must be released with ClearData.
+func (buf *streamBuffer) table(key flux.GroupKey) (*execute.ColListTableBuilder, error) {
+	if !buf.has(key) {
+		return nil, nil
+	}
+	tbl, err := buf.cache.Table(key)
+	if err != nil {
+		return nil, err
+	}
+	defer tbl.Done()
+	builder := execute.NewColListTableBuilder(key, buf.alloc)
+	if err := execute.AddTableCols(tbl, builder); err != nil {
+		return nil, err
+	}
+	if err := execute.AppendTable(tbl, builder); err != nil {
+		return nil, err
+	}
+	return builder, nil
+}
+
+// has reports whether a table with key is buffered.
+func (buf *streamBuffer) has(key flux.GroupKey) bool {
+	_, ok := buf.nrows[key]
+	return ok
+}
+
+// size returns the number of rows of the buffered table with key.
+func (buf *streamBuffer) size(key flux.GroupKey) int {
+	return buf.nrows[key]
 }
 
 func (buf *streamBuffer) insert(table flux.Table) error {
-	// Construct a new table builder with same schema as input table
-	builder := execute.NewColListTableBuilder(table.Key(), buf.alloc)
+	// A table replaces the table with the same key, if any.
+	buf.cache.ExpireTable(table.Key())
+	builder, _ := buf.cache.TableBuilder(table.Key())
 	// this will only error if we try to add a duplicate column to the builder.
 	// since this is a new table, that won't happen.
 	if err := execute.AddTableCols(table, builder); err != nil {
@@ -424,7 +516,7 @@ func (buf *streamBuffer) insert(table flux.Table) error {
 	}
 
 	// Insert this table into the buffer
-	buf.data[table.Key()] = builder
+	buf.nrows[table.Key()] = builder.NRows()
 
 	if len(table.Key().Cols()) > 0 {
 		leftKeyValue := table.Key().Value(0)
@@ -454,9 +546,10 @@ func (buf *streamBuffer) expire(key flux.GroupKey) {
 }
 
 func (buf *streamBuffer) evict(key flux.GroupKey) {
-	if builder, ok := buf.data[key]; ok {
-		builder.ClearData()
-		delete(buf.data, key)
+	if buf.has(key) {
+		buf.cache.ExpireTable(key)
+		delete(buf.nrows, key)
+		delete(buf.matched, key)
 	}
 }
 
@@ -470,7 +563,7 @@ func (buf *streamBuffer) clear(f func(flux.GroupKey) bool) {
 }
 
 func (buf *streamBuffer) iterate(f func(flux.GroupKey)) {
-	for key := range buf.data {
+	for key := range buf.nrows {
 		f(key)
 	}
 }
@@ -501,7 +594,14 @@ func (s schema) Swap(i int, j int) {
 }
 
 // NewMergeJoinCache constructs a new instance of a MergeJoinCache
-func NewMergeJoinCache(alloc *memory.Allocator, datasetIDs []execute.DatasetID, tableNames map[execute.DatasetID]string, key []string) *MergeJoinCache {
+func NewMergeJoinCache(alloc *memory.Allocator, datasetIDs []execute.DatasetID, tableNames map[execute.DatasetID]string, key []string, method string) *MergeJoinCache {
+	return newMergeJoinCache(alloc, func() bufferCache {
+		return execute.NewTableBuilderCache(alloc)
+	}, datasetIDs, tableNames, key, method)
+}
+
+// newMergeJoinCache constructs a MergeJoinCache whose buffers hold the tables of each stream in a cache from newCache.
+func newMergeJoinCache(alloc *memory.Allocator, newCache func() bufferCache, datasetIDs []execute.DatasetID, tableNames map[execute.DatasetID]string, key []string, method string) *MergeJoinCache {
 	// Join currently only accepts two data sources(streams) as input
 	if len(datasetIDs) != 2 {
 		panic("Join only accepts two data sources")
@@ -513,23 +613,27 @@ func NewMergeJoinCache(alloc *memory.Allocator, datasetIDs []execute.DatasetID,
 
 	for _, datasetID := range datasetIDs {
 		names[datasetID] = tableNames[datasetID]
-		buffers[datasetID] = newStreamBuffer(alloc)
+		buffers[datasetID] = newStreamBuffer(newCache(), alloc)
 	}
 
 	on := make(map[string]bool, len(key))
 	intersection := make(map[string]bool, len(key))
+	joined := make(map[string]bool, len(key))
 
 	for _, k := range key {
 		on[k] = true
 		intersection[k] = true
+		joined[k] = true
 	}
 
 	return &MergeJoinCache{
 		on:            on,
 		order:         key,
 		intersection:  intersection,
+		joined:        joined,
 		leftID:        datasetIDs[0],
 		rightID:       datasetIDs[1],
+		method:        method,
 		names:         names,
 		schemas:       schemas,
 		buffers:       buffers,
@@ -550,17 +654,12 @@ func (c *MergeJoinCache) Table(key flux.GroupKey) (flux.Table, error) {
 
 	if _, ok := c.tables[key]; !ok {
 
-		left := c.buffers[c.leftID].table(preJoinGroupKeys.left)
-		if left == nil {
-			return nil, fmt.Errorf("no table in left join buffer with key: %v", key)
-		}
-
-		right := c.buffers[c.rightID].table(preJoinGroupKeys.right)
-		if left == nil {
-			return nil, fmt.Errorf("no table in right join buffer with key: %v", key)
+		// One side is missing for tables without a match in an outer join.
+		if !c.buffers[c.leftID].has(preJoinGroupKeys.left) && !c.buffers[c.rightID].has(preJoinGroupKeys.right) {
+			return nil, fmt.Errorf("no table in either join buffer with key: %v", key)
 		}
 
-		table, err := c.join(left, right)
+		table, err := c.joinBuffered(preJoinGroupKeys)
 		if err != nil {
 			return nil, fmt.Errorf("table with group key (%v) could not be fetched", key)
 		}
@@ -576,15 +675,7 @@ func (c *MergeJoinCache) ForEach(f func(flux.GroupKey)) {
 
 		if _, ok := c.tables[key]; !ok {
 
-			preJoinGroupKeys := c.reverseLookup[key]
-
-			leftKey := preJoinGroupKeys.left
-			rightKey := preJoinGroupKeys.right
-
-			leftBuilder := c.buffers[c.leftID].table(leftKey)
-			rightBuilder := c.buffers[c.rightID].table(rightKey)
-
-			table, err := c.join(leftBuilder, rightBuilder)
+			table, err := c.joinBuffered(c.reverseLookup[key])
 			if err != nil || table.Empty() {
 				c.DiscardTable(key)
 				return
@@ -604,15 +695,9 @@ func (c *MergeJoinCache) ForEachWithContext(f func(flux.GroupKey, execute.Trigge
 
 		preJoinGroupKeys := c.reverseLookup[key]
 
-		leftKey := preJoinGroupKeys.left
-		rightKey := preJoinGroupKeys.right
-
-		leftBuilder := c.buffers[c.leftID].table(leftKey)
-		rightBuilder := c.buffers[c.rightID].table(rightKey)
-
 		if _, ok := c.tables[key]; !ok {
 
-			table, err := c.join(leftBuilder, rightBuilder)
+			table, err := c.joinBuffered(preJoinGroupKeys)
 
 			if err != nil || table.Empty() {
 				c.DiscardTable(key)
@@ -622,12 +707,9 @@ func (c *MergeJoinCache) ForEachWithContext(f func(flux.GroupKey, execute.Trigge
 			c.tables[key] = table
 		}
 
-		leftsize := leftBuilder.NRows()
-		rightsize := rightBuilder.NRows()
-
 		ctx := execute.TableContext{
 			Key:   key,
-			Count: leftsize + rightsize,
+			Count: c.buffers[c.leftID].size(preJoinGroupKeys.left) + c.buffers[c.rightID].size(preJoinGroupKeys.right),
 		}
 
 		f(key, trigger, ctx)
@@ -654,8 +736,12 @@ func (c *MergeJoinCache) ExpireTable(key flux.GroupKey) {
 	leftBuffer := c.buffers[c.leftID]
 	rightBuffer := c.buffers[c.rightID]
 
-	leftBuffer.expire(preJoinGroupKeys.left)
-	rightBuffer.expire(preJoinGroupKeys.right)
+	if preJoinGroupKeys.left != nil {
+		leftBuffer.expire(preJoinGroupKeys.left)
+	}
+	if preJoinGroupKeys.right != nil {
+		rightBuffer.expire(preJoinGroupKeys.right)
+	}
 
 	if c.canEvictTables() {
 
@@ -676,6 +762,21 @@ func (c *MergeJoinCache) SetTriggerSpec(spec plan.TriggerSpec) {
 	c.triggerSpec = spec
 }
 
+// keeps reports whether rows of the stream associated with id are
+// kept when there is no match for them on the other side.
+func (c *MergeJoinCache) keeps(id execute.DatasetID) bool {
+	switch c.method {
+	case "full":
+		return true
+	case "left":
+		return id == c.leftID
+	case "right":
+		return id == c.rightID
+	default:
+		return false
+	}
+}
+
 // Currently tables are the smallest unit of data that can be evicted from the join's internal
 // buffers. This is the rule that specifies whether a data cache can early evict tables.
 func (c *MergeJoinCache) canEvictTables() bool {
@@ -697,6 +798,12 @@ func (c *MergeJoinCache) insertIntoBuffer(id execute.DatasetID, tbl flux.Table)
 
 		copy(c.schemas[id].columns, tbl.Cols())
 
+		if c.asOf != nil {
+			if err := c.asOf.validate(c.names[id], tbl.Cols()); err != nil {
+				return err
+			}
+		}
+
 		intersection := make(map[string]bool, len(c.intersection))
 
 		for j, column := range tbl.Key().Cols() {
@@ -713,9 +820,10 @@ func (c *MergeJoinCache) insertIntoBuffer(id execute.DatasetID, tbl flux.Table)
 	// Optimization: if any group key columns overlap join key columns,
 	// and there are any nulls in those columns, we can discard this table,
 	// since null != null for joining purposes.
+	// Outer joins have to keep such a table since its rows are part of the output.
 	k := tbl.Key()
 	for j, col := range k.Cols() {
-		if c.on[col.Label] {
+		if c.on[col.Label] && !c.keeps(id) {
 			if k.IsNull(j) {
 				// Discard the table and return.  Note: we need to iterate over the
 				// table at least once:
@@ -759,6 +867,8 @@ func (c *MergeJoinCache) registerKey(id execute.DatasetID, key flux.GroupKey) {
 				left:  key,
 				right: groupKey,
 			}
+			c.buffers[c.leftID].matched[key] = true
+			c.buffers[c.rightID].matched[groupKey] = true
 		})
 
 	case c.rightID:
@@ -783,12 +893,46 @@ func (c *MergeJoinCache) registerKey(id execute.DatasetID, key flux.GroupKey) {
 				left:  groupKey,
 				right: key,
 			}
+			c.buffers[c.leftID].matched[groupKey] = true
+			c.buffers[c.rightID].matched[key] = true
+		})
+	}
+}
+
+// registerUnmatchedKeys registers an output group key for every buffered table
+// that was not matched with a table from the other stream and whose rows are
+// kept by the join method. It must only be called once both streams have finished.
+func (c *MergeJoinCache) registerUnmatchedKeys() {
+	var empty struct{}
+	for _, id := range []execute.DatasetID{c.leftID, c.rightID} {
+		if !c.keeps(id) || c.isBufferEmpty(id) {
+			continue
+		}
+		// The other stream may not have produced any tables.
+		if !c.postJoinSchemaBuilt() {
+			c.buildPostJoinSchema()
+		}
+		buf := c.buffers[id]
+		buf.iterate(func(key flux.GroupKey) {
+			if buf.matched[key] {
+				return
+			}
+			outputGroupKey := c.postJoinGroupKey(map[execute.DatasetID]flux.GroupKey{id: key})
+			c.postJoinKeys.Set(outputGroupKey, empty)
+
+			var pre preJoinGroupKeys
+			if id == c.leftID {
+				pre.left = key
+			} else {
+				pre.right = key
+			}
+			c.reverseLookup[outputGroupKey] = pre
 		})
 	}
 }
 
 func (c *MergeJoinCache) isBufferEmpty(id execute.DatasetID) bool {
-	return len(c.buffers[id].data) == 0
+	return len(c.buffers[id].nrows) == 0
 }
 
 func (c *MergeJoinCache) postJoinSchemaBuilt() bool {
@@ -823,8 +967,8 @@ func (c *MergeJoinCache) buildPostJoinSchema() {
 	added := make(map[string]bool, ncols-len(c.on))
 
 	// Build schema for output table
-	addColumnsToSchema(c.names[c.leftID], left, added, shared, c.on, &c.schema, c.schemaMap)
-	addColumnsToSchema(c.names[c.rightID], right, added, shared, c.on, &c.schema, c.schemaMap)
+	addColumnsToSchema(c.names[c.leftID], left, added, shared, c.joined, &c.schema, c.schemaMap)
+	addColumnsToSchema(c.names[c.rightID], right, added, shared, c.joined, &c.schema, c.schemaMap)
 
 	// Give schema an order
 	sort.Sort(c.schema)
@@ -846,20 +990,51 @@ func equalJoinkeys(left, right flux.GroupKey) bool {
 	return true
 }
 
-func (c *MergeJoinCache) join(left, right *execute.ColListTableBuilder) (flux.Table, error) {
-	// Sort input tables
-	left.Sort(c.order, false)
-	right.Sort(c.order, false)
+// joinBuffered joins the buffered tables with the keys in pre.
+// The tables are read from the buffers for the join and released afterwards,
+// since a buffered table may be joined with several tables of the other stream.
+func (c *MergeJoinCache) joinBuffered(pre preJoinGroupKeys) (flux.Table, error) {
+	left, err := c.buffers[c.leftID].table(pre.left)
+	if err != nil {
+		return nil, err
+	}
+	if left != nil {
+		defer left.ClearData()
+	}
+	right, err := c.buffers[c.rightID].table(pre.right)
+	if err != nil {
+		return nil, err
+	}
+	if right != nil {
+		defer right.ClearData()
+	}
+	return c.join(left, right)
+}
 
+// join joins a table from the left stream with a table from the right stream.
+// Either table may be nil when its counterpart has no match in an outer join.
+func (c *MergeJoinCache) join(left, right *execute.ColListTableBuilder) (flux.Table, error) {
 	var leftSet, rightSet subset
 	var leftKey, rightKey flux.GroupKey
 
-	leftSet, leftKey = c.advance(leftSet.Stop, left)
-	rightSet, rightKey = c.advance(rightSet.Stop, right)
+	keys := make(map[execute.DatasetID]flux.GroupKey, 2)
+	if left != nil {
+		// Sort input tables
+		left.Sort(c.order, false)
+		leftSet, leftKey = c.advance(leftSet.Stop, left)
+		keys[c.leftID] = left.Key()
+	}
+	if right != nil {
+		right.Sort(c.order, false)
+		rightSet, rightKey = c.advance(rightSet.Stop, right)
+		keys[c.rightID] = right.Key()
+	}
 
-	keys := map[execute.DatasetID]flux.GroupKey{
-		c.leftID:  left.Key(),
-		c.rightID: right.Key(),
+	if c.asOf != nil && left != nil && right != nil {
+		if err := c.asOf.read(left, right); err != nil {
+			return nil, err
+		}
+		defer c.asOf.done()
 	}
 
 	// Instantiate a builder for the output table
@@ -877,50 +1052,134 @@ func (c *MergeJoinCache) join(left, right *execute.ColListTableBuilder) (flux.Ta
 	for !leftSet.Empty() && !rightSet.Empty() {
 		if equalJoinkeys(leftKey, rightKey) {
 
-			for l := leftSet.Start; l < leftSet.Stop; l++ {
-				for r := rightSet.Start; r < rightSet.Stop; r++ {
-
-					leftRecord := left.GetRow(l)
-					rightRecord := right.GetRow(r)
-
-					leftRecord.Range(func(columnName string, columnVal values.Value) {
-						column := tableCol{
-							table: c.names[c.leftID],
-							col:   columnName,
-						}
-						newColumn := c.schemaMap[column]
-						newColumnIdx := c.colIndex[newColumn]
-						_ = builder.AppendValue(newColumnIdx, columnVal)
-					})
-
-					rightRecord.Range(func(columnName string, columnVal values.Value) {
-						column := tableCol{
-							table: c.names[c.rightID],
-							col:   columnName,
-						}
-						newColumn := c.schemaMap[column]
-						newColumnIdx := c.colIndex[newColumn]
-
-						// No need to append value if column is part of the join key.
-						// Because value already appended when iterating over left record.
-						if !c.on[newColumn.Label] {
-							_ = builder.AppendValue(newColumnIdx, columnVal)
+			if c.asOf != nil {
+				if err := c.asOf.join(c, builder, left, leftSet, right, rightSet); err != nil {
+					return nil, err
+				}
+			} else {
+				for l := leftSet.Start; l < leftSet.Stop; l++ {
+					for r := rightSet.Start; r < rightSet.Stop; r++ {
+						if err := c.appendJoined(builder, left.GetRow(l), right.GetRow(r)); err != nil {
+							return nil, err
 						}
-					})
+					}
 				}
 			}
 			leftSet, leftKey = c.advance(leftSet.Stop, left)
 			rightSet, rightKey = c.advance(rightSet.Stop, right)
 		} else if leftKey.Less(rightKey) {
+			if err := c.appendUnmatched(builder, c.leftID, left, leftSet); err != nil {
+				return nil, err
+			}
 			leftSet, leftKey = c.advance(leftSet.Stop, left)
 		} else {
+			if err := c.appendUnmatched(builder, c.rightID, right, rightSet); err != nil {
+				return nil, err
+			}
 			rightSet, rightKey = c.advance(rightSet.Stop, right)
 		}
 	}
 
+	// The rows remaining on either side have nothing left to match
+	for c.keeps(c.leftID) && !leftSet.Empty() {
+		if err := c.appendUnmatched(builder, c.leftID, left, leftSet); err != nil {
+			return nil, err
+		}
+		leftSet, _ = c.advance(leftSet.Stop, left)
+	}
+	for c.keeps(c.rightID) && !rightSet.Empty() {
+		if err := c.appendUnmatched(builder, c.rightID, right, rightSet); err != nil {
+			return nil, err
+		}
+		rightSet, _ = c.advance(rightSet.Stop, right)
+	}
+
 	return builder.Table()
 }
 
+// appendJoined appends a row made of a record from each stream.
+func (c *MergeJoinCache) appendJoined(builder *execute.ColListTableBuilder, leftRecord, rightRecord values.Object) error {
+	var err error
+	leftRecord.Range(func(columnName string, columnVal values.Value) {
+		if err != nil {
+			return
+		}
+		column := tableCol{
+			table: c.names[c.leftID],
+			col:   columnName,
+		}
+		newColumn := c.schemaMap[column]
+		newColumnIdx := c.colIndex[newColumn]
+		err = builder.AppendValue(newColumnIdx, columnVal)
+	})
+	if err != nil {
+		return err
+	}
+
+	rightRecord.Range(func(columnName string, columnVal values.Value) {
+		if err != nil {
+			return
+		}
+		column := tableCol{
+			table: c.names[c.rightID],
+			col:   columnName,
+		}
+		newColumn := c.schemaMap[column]
+		newColumnIdx := c.colIndex[newColumn]
+
+		// No need to append value if column is part of the join key.
+		// Because value already appended when iterating over left record.
+		if !c.joined[newColumn.Label] {
+			err = builder.AppendValue(newColumnIdx, columnVal)
+		}
+	})
+	return err
+}
+
+// appendUnmatched appends the rows in set from the stream associated with id
+// if the join method keeps rows without a match. The columns coming from the
+// other stream are null, except for group key columns which take the value
+// of the output group key.
+func (c *MergeJoinCache) appendUnmatched(builder *execute.ColListTableBuilder, id execute.DatasetID, table *execute.ColListTableBuilder, set subset) error {
+	if !c.keeps(id) {
+		return nil
+	}
+	key := builder.Key()
+	for i := set.Start; i < set.Stop; i++ {
+		appended := make([]bool, len(c.schema.columns))
+		var err error
+		table.GetRow(i).Range(func(columnName string, columnVal values.Value) {
+			if err != nil {
+				return
+			}
+			column := tableCol{
+				table: c.names[id],
+				col:   columnName,
+			}
+			newColumnIdx := c.colIndex[c.schemaMap[column]]
+			err = builder.AppendValue(newColumnIdx, columnVal)
+			appended[newColumnIdx] = true
+		})
+		if err != nil {
+			return err
+		}
+		for j, column := range c.schema.columns {
+			if appended[j] {
+				continue
+			}
+			if k := execute.ColIdx(column.Label, key.Cols()); k >= 0 {
+				err = builder.AppendValue(j, key.Value(k))
+			} else {
+				err = builder.AppendNil(j)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // postJoinGroupKey produces a new group key value from a left and a right group key value
 func (c *MergeJoinCache) postJoinGroupKey(keys map[execute.DatasetID]flux.GroupKey) flux.GroupKey {
 	key := groupKey{
@@ -949,6 +1208,30 @@ func (c *MergeJoinCache) postJoinGroupKey(keys map[execute.DatasetID]flux.GroupK
 		}
 	}
 
+	// A stream without a table in keys has no match in an outer join.
+	// Its group key columns are part of the output group key with null values.
+	for _, id := range []execute.DatasetID{c.leftID, c.rightID} {
+		if _, ok := keys[id]; ok {
+			continue
+		}
+		for _, column := range c.schemas[id].key {
+
+			tableAndColumn := tableCol{
+				table: c.names[id],
+				col:   column.Label,
+			}
+
+			colMeta := c.schemaMap[tableAndColumn]
+
+			if !added[colMeta.Label] {
+				key.cols = append(key.cols, colMeta)
+				key.vals = append(key.vals, values.NewNull(flux.SemanticType(colMeta.Type)))
+			}
+
+			added[colMeta.Label] = true
+		}
+	}
+
 	// Table columns are always sorted so need
 	// to sort the group key for consistency
 	sort.Sort(key)
@@ -957,9 +1240,13 @@ func (c *MergeJoinCache) postJoinGroupKey(keys map[execute.DatasetID]flux.GroupK
 
 // advance advances the row pointer of a sorted table that is being joined
 func (c *MergeJoinCache) advance(offset int, table *execute.ColListTableBuilder) (subset, flux.GroupKey) {
+	if table == nil {
+		return subset{}, nil
+	}
 	// TODO(jlapacik): this is a temporary hack
 	// remove when ColListTableBuilder implements ColReader
 	tbl, _ := table.Table()
+	defer tbl.Done()
 	cr := tbl.(flux.ColReader)
 	if n := cr.Len(); n == offset {
 		return subset{Start: n, Stop: n}, nil
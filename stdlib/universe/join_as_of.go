@@ -0,0 +1,367 @@
+package universe
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/pkg/errors"
+)
+
+const JoinAsOfKind = "joinAsOf"
+
+func init() {
+	joinAsOfSignature := semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			"tables":     semantic.NewObjectPolyType(nil, nil, semantic.AllLabels()),
+			"on":         semantic.NewArrayPolyType(semantic.String),
+			"timeColumn": semantic.String,
+			"tolerance":  semantic.Duration,
+			"direction":  semantic.String,
+		},
+		Required: semantic.LabelSet{"tables", "tolerance"},
+		Return:   flux.TableObjectType,
+	}
+	flux.RegisterPackageValue("universe", JoinAsOfKind, flux.FunctionValue(JoinAsOfKind, createJoinAsOfOpSpec, joinAsOfSignature))
+	flux.RegisterOpSpec(JoinAsOfKind, newJoinAsOfOp)
+	plan.RegisterProcedureSpec(JoinAsOfKind, newJoinAsOfProcedure, JoinAsOfKind)
+	execute.RegisterTransformation(JoinAsOfKind, createJoinAsOfTransformation)
+}
+
+// The directions in which joinAsOf looks for a matching row.
+const (
+	AsOfBackward = "backward"
+	AsOfForward  = "forward"
+	AsOfNearest  = "nearest"
+)
+
+// JoinAsOfOpSpec specifies a join that pairs every row of the first stream
+// with the row of the second stream that is closest in time.
+type JoinAsOfOpSpec struct {
+	TableNames map[flux.OperationID]string `json:"tableNames"`
+	On         []string                    `json:"on"`
+	TimeColumn string                      `json:"timeColumn"`
+	Tolerance  flux.Duration               `json:"tolerance"`
+	Direction  string                      `json:"direction"`
+
+	// Like JoinOpSpec.params, this is only populated when the spec
+	// is created from Flux text.
+	params *joinParams
+}
+
+func createJoinAsOfOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	spec := new(JoinAsOfOpSpec)
+
+	// On is optional for an as-of join, the rows are always matched on time.
+	if on, ok, err := args.GetArray("on", semantic.String); err != nil {
+		return nil, err
+	} else if ok {
+		spec.On, err = interpreter.ToStringArray(on)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if col, ok, err := args.GetString("timeColumn"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.TimeColumn = col
+	} else {
+		spec.TimeColumn = execute.DefaultTimeColLabel
+	}
+	for _, col := range spec.On {
+		if col == spec.TimeColumn {
+			return nil, fmt.Errorf("time column %q cannot be part of 'on'", col)
+		}
+	}
+
+	tolerance, err := args.GetRequiredDuration("tolerance")
+	if err != nil {
+		return nil, err
+	}
+	if tolerance < 0 {
+		return nil, errors.New("tolerance must not be negative")
+	}
+	spec.Tolerance = tolerance
+
+	if direction, ok, err := args.GetString("direction"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Direction = direction
+	} else {
+		spec.Direction = AsOfBackward
+	}
+	switch spec.Direction {
+	case AsOfBackward, AsOfForward, AsOfNearest:
+	default:
+		return nil, fmt.Errorf("%s is not a valid direction, must be one of %s, %s or %s", spec.Direction, AsOfBackward, AsOfForward, AsOfNearest)
+	}
+
+	spec.TableNames, spec.params, err = readJoinTables(args, a)
+	if err != nil {
+		return nil, err
+	}
+	if len(spec.params.names) != 2 {
+		return nil, errors.New("joinAsOf requires exactly two tables")
+	}
+	return spec, nil
+}
+
+func (t *JoinAsOfOpSpec) IDer(ider flux.IDer) {
+	t.params.setTableNames(ider, t.TableNames)
+}
+
+func newJoinAsOfOp() flux.OperationSpec {
+	return new(JoinAsOfOpSpec)
+}
+
+func (s *JoinAsOfOpSpec) Kind() flux.OperationKind {
+	return JoinAsOfKind
+}
+
+type JoinAsOfProcedureSpec struct {
+	plan.DefaultCost
+	TableNames []string      `json:"table_names"`
+	On         []string      `json:"keys"`
+	TimeColumn string        `json:"timeColumn"`
+	Tolerance  flux.Duration `json:"tolerance"`
+	Direction  string        `json:"direction"`
+}
+
+func newJoinAsOfProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*JoinAsOfOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+
+	tableNames := make([]string, 0, len(spec.TableNames))
+	for _, name := range spec.TableNames {
+		tableNames = append(tableNames, name)
+	}
+	sort.Strings(tableNames)
+
+	on := make([]string, len(spec.On))
+	copy(on, spec.On)
+	sort.Strings(on)
+
+	return &JoinAsOfProcedureSpec{
+		TableNames: tableNames,
+		On:         on,
+		TimeColumn: spec.TimeColumn,
+		Tolerance:  spec.Tolerance,
+		Direction:  spec.Direction,
+	}, nil
+}
+
+func (s *JoinAsOfProcedureSpec) Kind() plan.ProcedureKind {
+	return JoinAsOfKind
+}
+
+func (s *JoinAsOfProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+
+	ns.TableNames = make([]string, len(s.TableNames))
+	copy(ns.TableNames, s.TableNames)
+
+	ns.On = make([]string, len(s.On))
+	copy(ns.On, s.On)
+
+	return &ns
+}
+
+func createJoinAsOfTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*JoinAsOfProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	parents := a.Parents()
+	if len(parents) != 2 {
+		return nil, nil, errors.New("joinAsOf must have two parents")
+	}
+
+	tableNames := make(map[execute.DatasetID]string, len(s.TableNames))
+	for i, name := range s.TableNames {
+		tableNames[parents[i]] = name
+	}
+
+	// The buffered tables of both streams are spilled to disk under memory pressure, as in join.
+	cache := newJoinAsOfCache(a.Allocator(), func() bufferCache {
+		return execute.NewSpillableTableBuilderCache(a)
+	}, parents, tableNames, s)
+	d := execute.NewDataset(id, mode, cache)
+	t := NewJoinAsOfTransformation(d, cache, s, parents, tableNames)
+	return t, d, nil
+}
+
+// NewJoinAsOfCache constructs a MergeJoinCache that performs an as-of join.
+// Every row of the first stream is kept, and paired with the closest row
+// of the second stream that is equal on the on columns, if there is one within the tolerance.
+func NewJoinAsOfCache(alloc *memory.Allocator, datasetIDs []execute.DatasetID, tableNames map[execute.DatasetID]string, spec *JoinAsOfProcedureSpec) *MergeJoinCache {
+	return newJoinAsOfCache(alloc, func() bufferCache {
+		return execute.NewTableBuilderCache(alloc)
+	}, datasetIDs, tableNames, spec)
+}
+
+// newJoinAsOfCache constructs an as-of join cache whose buffers hold the tables of each stream in a cache from newCache.
+func newJoinAsOfCache(alloc *memory.Allocator, newCache func() bufferCache, datasetIDs []execute.DatasetID, tableNames map[execute.DatasetID]string, spec *JoinAsOfProcedureSpec) *MergeJoinCache {
+	c := newMergeJoinCache(alloc, newCache, datasetIDs, tableNames, spec.On, "left")
+	c.asOf = &asOfJoin{
+		timeColumn: spec.TimeColumn,
+		tolerance:  int64(spec.Tolerance),
+		direction:  spec.Direction,
+	}
+	// Rows are merged on the on columns, and sorted by time within those.
+	c.order = append(append(make([]string, 0, len(spec.On)+1), spec.On...), spec.TimeColumn)
+	// The time of the first stream is the time of the output.
+	c.joined[spec.TimeColumn] = true
+	return c
+}
+
+// NewJoinAsOfTransformation constructs the transformation for an as-of join with a cache from NewJoinAsOfCache.
+func NewJoinAsOfTransformation(d execute.Dataset, cache *MergeJoinCache, spec *JoinAsOfProcedureSpec, parents []execute.DatasetID, tableNames map[execute.DatasetID]string) *mergeJoinTransformation {
+	return NewMergeJoinTransformation(d, cache, &MergeJoinProcedureSpec{
+		TableNames: spec.TableNames,
+		On:         spec.On,
+		Method:     "left",
+	}, parents, tableNames)
+}
+
+// asOfJoin matches rows by the distance between their times.
+type asOfJoin struct {
+	timeColumn string
+	tolerance  int64
+	direction  string
+
+	// The tables and time columns of the join in progress, set by read.
+	leftTable, rightTable flux.Table
+	leftTimes, rightTimes *array.Int64
+}
+
+// validate checks that the time column of a stream exists and holds times.
+func (j *asOfJoin) validate(name string, cols []flux.ColMeta) error {
+	idx := execute.ColIdx(j.timeColumn, cols)
+	if idx < 0 {
+		return fmt.Errorf("time column %q does not exist in table %q", j.timeColumn, name)
+	}
+	if cols[idx].Type != flux.TTime {
+		return fmt.Errorf("time column %q in table %q has type %v, expected %v", j.timeColumn, name, cols[idx].Type, flux.TTime)
+	}
+	return nil
+}
+
+// join pairs every left row in leftSet with at most one right row in rightSet.
+// Both sets are equal on the on columns and sorted by time.
+// The times of left and right must have been read with read.
+func (j *asOfJoin) join(c *MergeJoinCache, builder *execute.ColListTableBuilder, left *execute.ColListTableBuilder, leftSet subset, right *execute.ColListTableBuilder, rightSet subset) error {
+	leftTimes, rightTimes := j.leftTimes, j.rightTimes
+
+	// Null times never match, and sort before every other time.
+	first := rightSet.Start
+	for first < rightSet.Stop && rightTimes.IsNull(first) {
+		first++
+	}
+
+	for l := leftSet.Start; l < leftSet.Stop; l++ {
+		match := -1
+		if leftTimes.IsValid(l) {
+			match = j.match(leftTimes.Value(l), rightTimes.Int64Values(), first, rightSet.Stop)
+		}
+		if match < 0 {
+			if err := c.appendUnmatched(builder, c.leftID, left, subset{Start: l, Stop: l + 1}); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := c.appendJoined(builder, left.GetRow(l), right.GetRow(match)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// match finds the index of the time in times[start:stop] that matches t,
+// or -1 if there is no time within the tolerance in the direction of the join.
+func (j *asOfJoin) match(t int64, times []int64, start, stop int) int {
+	// The first time after t.
+	after := start + sort.Search(stop-start, func(i int) bool {
+		return times[start+i] > t
+	})
+
+	backward, forward := -1, -1
+	if after > start && t-times[after-1] <= j.tolerance {
+		backward = after - 1
+	}
+	// An equal time is the closest in both directions.
+	if backward >= 0 && times[backward] == t {
+		forward = backward
+	} else if after < stop && times[after]-t <= j.tolerance {
+		forward = after
+	}
+
+	switch j.direction {
+	case AsOfForward:
+		return forward
+	case AsOfNearest:
+		if backward < 0 {
+			return forward
+		}
+		if forward < 0 {
+			return backward
+		}
+		// Ties go to the earlier row.
+		if times[forward]-t < t-times[backward] {
+			return forward
+		}
+		return backward
+	default:
+		return backward
+	}
+}
+
+// read reads the time columns of the left and right tables of a join,
+// once for all of the subsets that are joined.
+// The times are valid until done is called.
+func (j *asOfJoin) read(left, right *execute.ColListTableBuilder) error {
+	var err error
+	if j.leftTable, j.leftTimes, err = j.times(left); err != nil {
+		j.done()
+		return err
+	}
+	if j.rightTable, j.rightTimes, err = j.times(right); err != nil {
+		j.done()
+		return err
+	}
+	return nil
+}
+
+// done releases the tables that the times were read from.
+func (j *asOfJoin) done() {
+	if j.leftTable != nil {
+		j.leftTable.Done()
+	}
+	if j.rightTable != nil {
+		j.rightTable.Done()
+	}
+	j.leftTable, j.rightTable = nil, nil
+	j.leftTimes, j.rightTimes = nil, nil
+}
+
+func (j *asOfJoin) times(table *execute.ColListTableBuilder) (flux.Table, *array.Int64, error) {
+	tbl, err := table.Table()
+	if err != nil {
+		return nil, nil, err
+	}
+	cr := tbl.(flux.ColReader)
+	idx := execute.ColIdx(j.timeColumn, cr.Cols())
+	if idx < 0 {
+		tbl.Done()
+		return nil, nil, fmt.Errorf("time column %q does not exist", j.timeColumn)
+	}
+	return tbl, cr.Times(idx), nil
+}
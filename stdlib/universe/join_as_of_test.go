@@ -0,0 +1,348 @@
+package universe_test
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+func TestJoinAsOf_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "defaults",
+			Raw: `
+				a = from(bucket:"dbA")
+				b = from(bucket:"dbB")
+				joinAsOf(tables:{a:a,b:b}, tolerance: 5s)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "dbA",
+						},
+					},
+					{
+						ID: "from1",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "dbB",
+						},
+					},
+					{
+						ID: "joinAsOf2",
+						Spec: &universe.JoinAsOfOpSpec{
+							TableNames: map[flux.OperationID]string{"from0": "a", "from1": "b"},
+							TimeColumn: "_time",
+							Tolerance:  flux.Duration(5 * time.Second),
+							Direction:  "backward",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "joinAsOf2"},
+					{Parent: "from1", Child: "joinAsOf2"},
+				},
+			},
+		},
+		{
+			Name: "all parameters",
+			Raw: `
+				a = from(bucket:"dbA")
+				b = from(bucket:"dbB")
+				joinAsOf(tables:{a:a,b:b}, on: ["host"], timeColumn: "t", tolerance: 1m, direction: "nearest")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "dbA",
+						},
+					},
+					{
+						ID: "from1",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "dbB",
+						},
+					},
+					{
+						ID: "joinAsOf2",
+						Spec: &universe.JoinAsOfOpSpec{
+							TableNames: map[flux.OperationID]string{"from0": "a", "from1": "b"},
+							On:         []string{"host"},
+							TimeColumn: "t",
+							Tolerance:  flux.Duration(time.Minute),
+							Direction:  "nearest",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "joinAsOf2"},
+					{Parent: "from1", Child: "joinAsOf2"},
+				},
+			},
+		},
+		{
+			Name: "missing tolerance",
+			Raw: `
+				a = from(bucket:"dbA")
+				b = from(bucket:"dbB")
+				joinAsOf(tables:{a:a,b:b})`,
+			WantErr: true,
+		},
+		{
+			Name: "invalid direction",
+			Raw: `
+				a = from(bucket:"dbA")
+				b = from(bucket:"dbB")
+				joinAsOf(tables:{a:a,b:b}, tolerance: 1s, direction: "sideways")`,
+			WantErr: true,
+		},
+		{
+			Name: "time column in on",
+			Raw: `
+				a = from(bucket:"dbA")
+				b = from(bucket:"dbB")
+				joinAsOf(tables:{a:a,b:b}, on: ["_time"], tolerance: 1s)`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestJoinAsOf_Process(t *testing.T) {
+	tableNames := []string{"a", "b"}
+
+	left := func() []*executetest.Table {
+		return []*executetest.Table{
+			{
+				KeyCols: []string{"host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "host", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(10), 1.0, "a"},
+					{execute.Time(20), 2.0, "a"},
+					{execute.Time(30), 3.0, "a"},
+					{nil, 4.0, "a"},
+				},
+			},
+		}
+	}
+	right := func() []*executetest.Table {
+		return []*executetest.Table{
+			{
+				KeyCols: []string{"host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "host", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(8), 10.0, "a"},
+					{execute.Time(21), 20.0, "a"},
+					{nil, 30.0, "a"},
+				},
+			},
+		}
+	}
+	joined := func(data [][]interface{}) []*executetest.Table {
+		return []*executetest.Table{
+			{
+				KeyCols: []string{"host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value_a", Type: flux.TFloat},
+					{Label: "_value_b", Type: flux.TFloat},
+					{Label: "host", Type: flux.TString},
+				},
+				Data: data,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		spec    *universe.JoinAsOfProcedureSpec
+		data0   []*executetest.Table // data from parent 0
+		data1   []*executetest.Table // data from parent 1
+		want    []*executetest.Table
+		wantErr bool
+	}{
+		{
+			name: "backward",
+			spec: &universe.JoinAsOfProcedureSpec{
+				On:         []string{"host"},
+				TimeColumn: "_time",
+				Tolerance:  flux.Duration(5),
+				Direction:  "backward",
+			},
+			data0: left(),
+			data1: right(),
+			want: joined([][]interface{}{
+				{nil, 4.0, nil, "a"},
+				{execute.Time(10), 1.0, 10.0, "a"},
+				{execute.Time(20), 2.0, nil, "a"},
+				{execute.Time(30), 3.0, nil, "a"},
+			}),
+		},
+		{
+			name: "forward",
+			spec: &universe.JoinAsOfProcedureSpec{
+				On:         []string{"host"},
+				TimeColumn: "_time",
+				Tolerance:  flux.Duration(5),
+				Direction:  "forward",
+			},
+			data0: left(),
+			data1: right(),
+			want: joined([][]interface{}{
+				{nil, 4.0, nil, "a"},
+				{execute.Time(10), 1.0, nil, "a"},
+				{execute.Time(20), 2.0, 20.0, "a"},
+				{execute.Time(30), 3.0, nil, "a"},
+			}),
+		},
+		{
+			name: "nearest",
+			spec: &universe.JoinAsOfProcedureSpec{
+				On:         []string{"host"},
+				TimeColumn: "_time",
+				Tolerance:  flux.Duration(10),
+				Direction:  "nearest",
+			},
+			data0: left(),
+			data1: right(),
+			want: joined([][]interface{}{
+				{nil, 4.0, nil, "a"},
+				{execute.Time(10), 1.0, 10.0, "a"},
+				{execute.Time(20), 2.0, 20.0, "a"},
+				{execute.Time(30), 3.0, 20.0, "a"},
+			}),
+		},
+		{
+			name: "exact matches with zero tolerance",
+			spec: &universe.JoinAsOfProcedureSpec{
+				TimeColumn: "_time",
+				Direction:  "forward",
+			},
+			data0: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), int64(1)},
+					{execute.Time(2), int64(2)},
+				},
+			}},
+			data1: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2), int64(20)},
+					{execute.Time(3), int64(30)},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value_a", Type: flux.TInt},
+					{Label: "_value_b", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), int64(1), nil},
+					{execute.Time(2), int64(2), int64(20)},
+				},
+			}},
+		},
+		{
+			name: "missing time column",
+			spec: &universe.JoinAsOfProcedureSpec{
+				TimeColumn: "t",
+				Tolerance:  flux.Duration(5),
+				Direction:  "backward",
+			},
+			data0:   left(),
+			data1:   right(),
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.spec.TableNames = tableNames
+
+			parents := []execute.DatasetID{
+				executetest.RandomDatasetID(),
+				executetest.RandomDatasetID(),
+			}
+			tableNames := make(map[execute.DatasetID]string, len(tc.spec.TableNames))
+			for i, name := range tc.spec.TableNames {
+				tableNames[parents[i]] = name
+			}
+
+			d := executetest.NewDataset(executetest.RandomDatasetID())
+			c := universe.NewJoinAsOfCache(executetest.UnlimitedAllocator, parents, tableNames, tc.spec)
+			c.SetTriggerSpec(plan.DefaultTriggerSpec)
+			jt := universe.NewJoinAsOfTransformation(d, c, tc.spec, parents, tableNames)
+
+			var err error
+			for _, tbl := range tc.data0 {
+				if err = jt.Process(parents[0], tbl); err != nil {
+					break
+				}
+			}
+			for _, tbl := range tc.data1 {
+				if err != nil {
+					break
+				}
+				err = jt.Process(parents[1], tbl)
+			}
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			jt.Finish(parents[0], nil)
+			jt.Finish(parents[1], nil)
+
+			got, err := executetest.TablesFromCache(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			executetest.NormalizeTables(got)
+			executetest.NormalizeTables(tc.want)
+
+			sort.Sort(executetest.SortedTables(got))
+			sort.Sort(executetest.SortedTables(tc.want))
+
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
@@ -171,6 +171,93 @@ func TestJoin_NewQuery(t *testing.T) {
 			`,
 			WantErr: true,
 		},
+		{
+			Name: "left outer join",
+			Raw: `
+				a = from(bucket:"dbA")
+				b = from(bucket:"dbB")
+				join(tables:{a:a,b:b}, on:["host"], method: "left")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "dbA",
+						},
+					},
+					{
+						ID: "from1",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "dbB",
+						},
+					},
+					{
+						ID: "join2",
+						Spec: &universe.JoinOpSpec{
+							On:         []string{"host"},
+							TableNames: map[flux.OperationID]string{"from0": "a", "from1": "b"},
+							Method:     "left",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "join2"},
+					{Parent: "from1", Child: "join2"},
+				},
+			},
+		},
+		{
+			Name: "cross product",
+			Raw: `
+				a = from(bucket:"dbA")
+				b = from(bucket:"dbB")
+				join(tables:{a:a,b:b}, method: "cross")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "dbA",
+						},
+					},
+					{
+						ID: "from1",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "dbB",
+						},
+					},
+					{
+						ID: "join2",
+						Spec: &universe.JoinOpSpec{
+							TableNames: map[flux.OperationID]string{"from0": "a", "from1": "b"},
+							Method:     "cross",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "join2"},
+					{Parent: "from1", Child: "join2"},
+				},
+			},
+		},
+		{
+			Name: "cross product with 'on' parameter",
+			Raw: `
+				a = from(bucket:"flux") |> range(start:-1h)
+				b = from(bucket:"flux") |> range(start:-1h)
+				join(tables:{a:a,b:b}, on: ["host"], method: "cross")
+			`,
+			WantErr: true,
+		},
+		{
+			Name: "invalid method",
+			Raw: `
+				a = from(bucket:"flux") |> range(start:-1h)
+				b = from(bucket:"flux") |> range(start:-1h)
+				join(tables:{a:a,b:b}, on: ["host"], method: "outer")
+			`,
+			WantErr: true,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -1473,6 +1560,279 @@ func TestMergeJoin_Process(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "left outer",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+				Method:     "left",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+						{execute.Time(3), 3.0},
+						{nil, 4.0},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 20.0},
+						{execute.Time(4), 40.0},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{nil, 4.0, nil},
+						{execute.Time(1), 1.0, nil},
+						{execute.Time(2), 2.0, 20.0},
+						{execute.Time(3), 3.0, nil},
+					},
+				},
+			},
+		},
+		{
+			name: "right outer",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+				Method:     "right",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 20.0},
+						{execute.Time(4), 40.0},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 2.0, 20.0},
+						{execute.Time(4), nil, 40.0},
+					},
+				},
+			},
+		},
+		{
+			name: "full outer with unmatched tables",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time", "host"},
+				TableNames: tableNames,
+				Method:     "full",
+			},
+			data0: []*executetest.Table{
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "a"},
+						{execute.Time(2), 2.0, "a"},
+					},
+				},
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 5.0, "b"},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					KeyCols: []string{"_field", "host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_field", Type: flux.TString},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{"usage", execute.Time(2), 20.0, "a"},
+						{"usage", execute.Time(3), 30.0, "a"},
+					},
+				},
+				{
+					KeyCols: []string{"_field", "host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_field", Type: flux.TString},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{"usage", execute.Time(1), 60.0, "c"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"_field", "host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_field", Type: flux.TString},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{"usage", execute.Time(1), 1.0, nil, "a"},
+						{"usage", execute.Time(2), 2.0, 20.0, "a"},
+						{"usage", execute.Time(3), nil, 30.0, "a"},
+					},
+				},
+				{
+					KeyCols:   []string{"_field", "host"},
+					KeyValues: []interface{}{nil, "b"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_field", Type: flux.TString},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{nil, execute.Time(1), 5.0, nil, "b"},
+					},
+				},
+				{
+					KeyCols: []string{"_field", "host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_field", Type: flux.TString},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{"usage", execute.Time(1), nil, 60.0, "c"},
+					},
+				},
+			},
+		},
+		{
+			name: "left outer with empty right stream",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+				Method:     "left",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+					},
+				},
+			},
+		},
+		{
+			name: "cross product",
+			spec: &universe.MergeJoinProcedureSpec{
+				TableNames: tableNames,
+				Method:     "cross",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{"a"},
+						{"b"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "a"},
+						{execute.Time(1), 1.0, "b"},
+						{execute.Time(2), 2.0, "a"},
+						{execute.Time(2), 2.0, "b"},
+					},
+				},
+			},
+		},
 		{
 			name: "two failures",
 			spec: &universe.MergeJoinProcedureSpec{
@@ -1520,7 +1880,7 @@ func TestMergeJoin_Process(t *testing.T) {
 			}
 
 			d := executetest.NewDataset(executetest.RandomDatasetID())
-			c := universe.NewMergeJoinCache(executetest.UnlimitedAllocator, parents, tableNames, tc.spec.On)
+			c := universe.NewMergeJoinCache(executetest.UnlimitedAllocator, parents, tableNames, tc.spec.On, tc.spec.Method)
 			c.SetTriggerSpec(plan.DefaultTriggerSpec)
 			jt := universe.NewMergeJoinTransformation(d, c, tc.spec, parents, tableNames)
 
@@ -17,10 +17,22 @@ const (
 	nullValueLabel = "null"
 )
 
+// The aggregates that combine the values that pivot finds for the same cell.
+const (
+	PivotLast  = "last"
+	PivotFirst = "first"
+	PivotCount = "count"
+	PivotSum   = "sum"
+	PivotMean  = "mean"
+	PivotMin   = "min"
+	PivotMax   = "max"
+)
+
 type PivotOpSpec struct {
 	RowKey      []string `json:"rowKey"`
 	ColumnKey   []string `json:"columnKey"`
 	ValueColumn string   `json:"valueColumn"`
+	Aggregate   string   `json:"aggregate,omitempty"`
 }
 
 func init() {
@@ -29,6 +41,7 @@ func init() {
 			"rowKey":      semantic.NewArrayPolyType(semantic.String),
 			"columnKey":   semantic.NewArrayPolyType(semantic.String),
 			"valueColumn": semantic.String,
+			"aggregate":   semantic.String,
 		},
 		[]string{"rowKey", "columnKey", "valueColumn"},
 	)
@@ -84,6 +97,18 @@ func createPivotOpSpec(args flux.Arguments, a *flux.Administration) (flux.Operat
 	}
 	spec.ValueColumn = valueCol
 
+	if aggregate, ok, err := args.GetString("aggregate"); err != nil {
+		return nil, err
+	} else if ok {
+		switch aggregate {
+		case PivotLast, PivotFirst, PivotCount, PivotSum, PivotMean, PivotMin, PivotMax:
+		default:
+			return nil, fmt.Errorf("unknown pivot aggregate %q, must be one of %s, %s, %s, %s, %s, %s or %s",
+				aggregate, PivotLast, PivotFirst, PivotCount, PivotSum, PivotMean, PivotMin, PivotMax)
+		}
+		spec.Aggregate = aggregate
+	}
+
 	return spec, nil
 }
 
@@ -100,6 +125,9 @@ type PivotProcedureSpec struct {
 	RowKey      []string
 	ColumnKey   []string
 	ValueColumn string
+	// Aggregate combines the values of the same cell.
+	// When it is empty, the last value is kept.
+	Aggregate string
 }
 
 func newPivotProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -112,6 +140,7 @@ func newPivotProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.Proc
 		RowKey:      spec.RowKey,
 		ColumnKey:   spec.ColumnKey,
 		ValueColumn: spec.ValueColumn,
+		Aggregate:   spec.Aggregate,
 	}
 
 	return p, nil
@@ -127,6 +156,7 @@ func (s *PivotProcedureSpec) Copy() plan.ProcedureSpec {
 	ns.ColumnKey = make([]string, len(s.ColumnKey))
 	copy(ns.ColumnKey, s.ColumnKey)
 	ns.ValueColumn = s.ValueColumn
+	ns.Aggregate = s.Aggregate
 	return ns
 }
 
@@ -139,6 +169,12 @@ func createPivotTransformation(id execute.DatasetID, mode execute.AccumulationMo
 	cache := execute.NewTableBuilderCache(a.Allocator())
 	d := execute.NewDataset(id, mode, cache)
 	t := NewPivotTransformation(d, cache, s)
+	if _, ok := execute.GetSpillConfig(a.Dependencies()); ok {
+		// The pivoted tables are updated in place and cannot be spilled,
+		// so the input tables are buffered instead and pivoted when the input is finished.
+		t.buffer = execute.NewSpillableTableBuilderCache(a)
+		t.buffer.SetTriggerSpec(plan.DefaultTriggerSpec)
+	}
 	return t, d, nil
 }
 
@@ -155,6 +191,24 @@ type pivotTransformation struct {
 	colKeyMaps map[string]map[string]int
 	rowKeyMaps map[string]map[string]int
 	nextRowCol map[string]rowCol
+	// cells holds the state of the aggregate of every cell of each table that has a value.
+	cells map[string]map[pivotCellIndex]*pivotCell
+
+	// buffer, if set, holds the input tables until Finish, and spills them to disk
+	// under memory pressure. inputKeys are the keys of the tables in the order they arrived.
+	buffer    bufferCache
+	inputKeys []flux.GroupKey
+}
+
+type pivotCellIndex struct {
+	row, col int
+}
+
+// pivotCell is the state of the aggregate of the values of a cell.
+type pivotCell struct {
+	count int64
+	value values.Value
+	sum   float64
 }
 
 func NewPivotTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *PivotProcedureSpec) *pivotTransformation {
@@ -165,6 +219,7 @@ func NewPivotTransformation(d execute.Dataset, cache execute.TableBuilderCache,
 		colKeyMaps: make(map[string]map[string]int),
 		rowKeyMaps: make(map[string]map[string]int),
 		nextRowCol: make(map[string]rowCol),
+		cells:      make(map[string]map[pivotCellIndex]*pivotCell),
 	}
 	return t
 }
@@ -174,6 +229,41 @@ func (t *pivotTransformation) RetractTable(id execute.DatasetID, key flux.GroupK
 }
 
 func (t *pivotTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	if t.buffer == nil {
+		return t.pivot(tbl)
+	}
+	builder, created := t.buffer.TableBuilder(tbl.Key())
+	if created {
+		if err := execute.AddTableCols(tbl, builder); err != nil {
+			return err
+		}
+		t.inputKeys = append(t.inputKeys, tbl.Key())
+	}
+	return execute.AppendTable(tbl, builder)
+}
+
+// pivotBuffered pivots the buffered input tables in the order they arrived.
+func (t *pivotTransformation) pivotBuffered() error {
+	for i, key := range t.inputKeys {
+		tbl, err := t.buffer.Table(key)
+		if err == nil {
+			err = t.pivot(tbl)
+			tbl.Done()
+		}
+		t.buffer.ExpireTable(key)
+		if err != nil {
+			for _, key := range t.inputKeys[i+1:] {
+				t.buffer.ExpireTable(key)
+			}
+			return err
+		}
+	}
+	t.inputKeys = nil
+	return nil
+}
+
+// pivot pivots the rows of an input table into the table of its output group key.
+func (t *pivotTransformation) pivot(tbl flux.Table) error {
 	rowKeyIndex := make(map[string]int)
 	for _, v := range t.spec.RowKey {
 		idx := execute.ColIdx(v, tbl.Cols())
@@ -187,7 +277,7 @@ func (t *pivotTransformation) Process(id execute.DatasetID, tbl flux.Table) erro
 	// determine the initial column schema
 	colKeyIndex := make(map[string]int)
 	valueColIndex := -1
-	var valueColType flux.ColType
+	var valueColType, pivotColType flux.ColType
 	for _, v := range t.spec.ColumnKey {
 		colKeyIndex[v] = -1
 	}
@@ -226,6 +316,13 @@ func (t *pivotTransformation) Process(id execute.DatasetID, tbl flux.Table) erro
 			return fmt.Errorf("specified column does not exist in table: %v", k)
 		}
 	}
+	if valueColIndex < 0 {
+		return fmt.Errorf("specified column does not exist in table: %v", t.spec.ValueColumn)
+	}
+	pivotColType, err := pivotColumnType(t.spec.Aggregate, valueColType)
+	if err != nil {
+		return err
+	}
 
 	newGroupKey := execute.NewGroupKey(keyCols, keyValues)
 	builder, created := t.cache.TableBuilder(newGroupKey)
@@ -241,6 +338,7 @@ func (t *pivotTransformation) Process(id execute.DatasetID, tbl flux.Table) erro
 		t.colKeyMaps[groupKeyString] = make(map[string]int)
 		t.rowKeyMaps[groupKeyString] = make(map[string]int)
 		t.nextRowCol[groupKeyString] = rowCol{nextCol: len(cols), nextRow: 0}
+		t.cells[groupKeyString] = make(map[pivotCellIndex]*pivotCell)
 	}
 
 	return tbl.Do(func(cr flux.ColReader) error {
@@ -270,7 +368,7 @@ func (t *pivotTransformation) Process(id execute.DatasetID, tbl flux.Table) erro
 			if _, ok := t.colKeyMaps[groupKeyString][colKey]; !ok {
 				newCol := flux.ColMeta{
 					Label: colKey,
-					Type:  valueColType,
+					Type:  pivotColType,
 				}
 				nextCol, err := builder.AddCol(newCol)
 				if err != nil {
@@ -304,7 +402,23 @@ func (t *pivotTransformation) Process(id execute.DatasetID, tbl flux.Table) erro
 			// if we found a new row key, we added a new row with zeroes set for all the value columns
 			// so in all cases we know the row exists, and the column exists.  we need to grab the
 			// value from valueCol and assign it to its pivoted position.
-			if err := builder.SetValue(t.rowKeyMaps[groupKeyString][rowKey], t.colKeyMaps[groupKeyString][colKey], execute.ValueForRow(cr, row, valueColIndex)); err != nil {
+			idx := pivotCellIndex{row: t.rowKeyMaps[groupKeyString][rowKey], col: t.colKeyMaps[groupKeyString][colKey]}
+			if builder.Cols()[idx.col].Type != pivotColType {
+				return fmt.Errorf("pivot column %q has type %v in one table and %v in another", colKey, builder.Cols()[idx.col].Type, pivotColType)
+			}
+			v := execute.ValueForRow(cr, row, valueColIndex)
+			if t.spec.Aggregate != "" {
+				cells := t.cells[groupKeyString]
+				cell, ok := cells[idx]
+				if !ok {
+					cell = new(pivotCell)
+					cells[idx] = cell
+				}
+				if v = cell.add(t.spec.Aggregate, v); v == nil {
+					continue
+				}
+			}
+			if err := builder.SetValue(idx.row, idx.col, v); err != nil {
 				return err
 			}
 
@@ -313,6 +427,109 @@ func (t *pivotTransformation) Process(id execute.DatasetID, tbl flux.Table) erro
 	})
 }
 
+// pivotColumnType returns the type of the pivoted columns for the aggregate of values of the given type.
+func pivotColumnType(aggregate string, typ flux.ColType) (flux.ColType, error) {
+	switch aggregate {
+	case PivotCount:
+		return flux.TInt, nil
+	case PivotSum, PivotMean:
+		switch typ {
+		case flux.TInt, flux.TUInt, flux.TFloat:
+		default:
+			return flux.TInvalid, fmt.Errorf("pivot aggregate %s requires a numeric value column, got %v", aggregate, typ)
+		}
+		if aggregate == PivotMean {
+			return flux.TFloat, nil
+		}
+	case PivotMin, PivotMax:
+		if typ == flux.TBool {
+			return flux.TInvalid, fmt.Errorf("pivot aggregate %s cannot compare values of type %v", aggregate, typ)
+		}
+	}
+	return typ, nil
+}
+
+// add adds a value to the aggregate of the cell, and returns the new value of the cell.
+// It returns nil if the value of the cell does not change.
+// Null values are ignored by every aggregate but last.
+func (c *pivotCell) add(aggregate string, v values.Value) values.Value {
+	if aggregate == PivotLast {
+		return v
+	}
+	if v.IsNull() {
+		return nil
+	}
+	c.count++
+	if c.count == 1 {
+		switch aggregate {
+		case PivotCount:
+			c.value = values.NewInt(1)
+		case PivotMean:
+			c.sum = toFloat(v)
+			c.value = values.NewFloat(c.sum)
+		default:
+			c.value = v
+		}
+		return c.value
+	}
+
+	switch aggregate {
+	case PivotFirst:
+		return nil
+	case PivotCount:
+		c.value = values.NewInt(c.count)
+	case PivotMean:
+		c.sum += toFloat(v)
+		c.value = values.NewFloat(c.sum / float64(c.count))
+	case PivotSum:
+		switch v.Type() {
+		case semantic.Int:
+			c.value = values.NewInt(c.value.Int() + v.Int())
+		case semantic.UInt:
+			c.value = values.NewUInt(c.value.UInt() + v.UInt())
+		default:
+			c.value = values.NewFloat(c.value.Float() + v.Float())
+		}
+	case PivotMin, PivotMax:
+		less := lessValue(v, c.value)
+		if less == (aggregate == PivotMin) && !v.Equal(c.value) {
+			c.value = v
+		} else {
+			return nil
+		}
+	}
+	return c.value
+}
+
+// lessValue reports whether a is less than b, both values have the same type.
+func lessValue(a, b values.Value) bool {
+	switch a.Type() {
+	case semantic.Int:
+		return a.Int() < b.Int()
+	case semantic.UInt:
+		return a.UInt() < b.UInt()
+	case semantic.Float:
+		return a.Float() < b.Float()
+	case semantic.String:
+		return a.Str() < b.Str()
+	case semantic.Time:
+		return a.Time() < b.Time()
+	default:
+		return false
+	}
+}
+
+func toFloat(v values.Value) float64 {
+	switch v.Type() {
+	case semantic.Int:
+		return float64(v.Int())
+	case semantic.UInt:
+		return float64(v.UInt())
+	default:
+		return v.Float()
+	}
+}
+
 func growColumn(builder execute.TableBuilder, colIdx, nRows int) error {
 	colType := builder.Cols()[colIdx].Type
 	switch colType {
@@ -378,6 +595,14 @@ func (t *pivotTransformation) UpdateProcessingTime(id execute.DatasetID, pt exec
 }
 
 func (t *pivotTransformation) Finish(id execute.DatasetID, err error) {
-
+	if t.buffer != nil {
+		if err == nil {
+			err = t.pivotBuffered()
+		} else {
+			for _, key := range t.inputKeys {
+				t.buffer.ExpireTable(key)
+			}
+		}
+	}
 	t.d.Finish(err)
 }
@@ -55,6 +55,53 @@ func TestPivot_NewQuery(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "pivot with aggregate",
+			Raw:  `from(bucket:"testdb") |> range(start: -1h) |> pivot(rowKey: ["_time"], columnKey: ["_field"], valueColumn: "_value", aggregate: "mean")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "testdb",
+						},
+					},
+					{
+						ID: "range1",
+						Spec: &universe.RangeOpSpec{
+							Start: flux.Time{
+								Relative:   -1 * time.Hour,
+								IsRelative: true,
+							},
+							Stop: flux.Time{
+								IsRelative: true,
+							},
+							TimeColumn:  "_time",
+							StartColumn: "_start",
+							StopColumn:  "_stop",
+						},
+					},
+					{
+						ID: "pivot2",
+						Spec: &universe.PivotOpSpec{
+							RowKey:      []string{"_time"},
+							ColumnKey:   []string{"_field"},
+							ValueColumn: "_value",
+							Aggregate:   "mean",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "range1"},
+					{Parent: "range1", Child: "pivot2"},
+				},
+			},
+		},
+		{
+			Name:    "unknown aggregate",
+			Raw:     `from(bucket:"testdb") |> range(start: -1h) |> pivot(rowKey: ["_time"], columnKey: ["_field"], valueColumn: "_value", aggregate: "median")`,
+			WantErr: true,
+		},
 		{
 			Name:    "overlapping rowKey and columnKey",
 			Raw:     `from(bucket:"testdb") |> range(start: -1h) |> pivot(rowKey: ["_time", "a"], columnKey: ["_measurement", "_field", "a"], valueColumn: "_value")`,
@@ -314,6 +361,188 @@ func TestPivot_Process(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "duplicate rowKey + columnKey with sum",
+			spec: &universe.PivotProcedureSpec{
+				RowKey:      []string{"_time"},
+				ColumnKey:   []string{"_field"},
+				ValueColumn: "_value",
+				Aggregate:   universe.PivotSum,
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TInt},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), int64(1), "f1"},
+						{execute.Time(1), int64(2), "f2"},
+						{execute.Time(1), int64(5), "f1"},
+						{execute.Time(2), nil, "f1"},
+						{execute.Time(2), int64(4), "f2"},
+						{execute.Time(1), int64(7), "f1"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "f1", Type: flux.TInt},
+						{Label: "f2", Type: flux.TInt},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), int64(13), int64(2)},
+						{execute.Time(2), nil, int64(4)},
+					},
+				},
+			},
+		},
+		{
+			name: "duplicate rowKey + columnKey with mean",
+			spec: &universe.PivotProcedureSpec{
+				RowKey:      []string{"_time"},
+				ColumnKey:   []string{"_field"},
+				ValueColumn: "_value",
+				Aggregate:   universe.PivotMean,
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TInt},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), int64(1), "f1"},
+						{execute.Time(1), int64(2), "f2"},
+						{execute.Time(1), int64(4), "f1"},
+						{execute.Time(1), nil, "f1"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "f1", Type: flux.TFloat},
+						{Label: "f2", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 2.5, 2.0},
+					},
+				},
+			},
+		},
+		{
+			name: "duplicate rowKey + columnKey with count",
+			spec: &universe.PivotProcedureSpec{
+				RowKey:      []string{"_time"},
+				ColumnKey:   []string{"_field"},
+				ValueColumn: "_value",
+				Aggregate:   universe.PivotCount,
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TString},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "a", "f1"},
+						{execute.Time(1), "b", "f2"},
+						{execute.Time(1), "c", "f1"},
+						{execute.Time(2), nil, "f2"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "f1", Type: flux.TInt},
+						{Label: "f2", Type: flux.TInt},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), int64(2), int64(1)},
+						{execute.Time(2), nil, nil},
+					},
+				},
+			},
+		},
+		{
+			name: "duplicate rowKey + columnKey with first",
+			spec: &universe.PivotProcedureSpec{
+				RowKey:      []string{"_time"},
+				ColumnKey:   []string{"_field"},
+				ValueColumn: "_value",
+				Aggregate:   universe.PivotFirst,
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), nil, "f1"},
+						{execute.Time(1), 3.0, "f1"},
+						{execute.Time(1), 1.0, "f1"},
+						{execute.Time(1), 2.0, "f1"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "f1", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 3.0},
+					},
+				},
+			},
+		},
+		{
+			name: "duplicate rowKey + columnKey with max",
+			spec: &universe.PivotProcedureSpec{
+				RowKey:      []string{"_time"},
+				ColumnKey:   []string{"_field"},
+				ValueColumn: "_value",
+				Aggregate:   universe.PivotMax,
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TString},
+						{Label: "_field", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "b", "f1"},
+						{execute.Time(1), "c", "f1"},
+						{execute.Time(1), nil, "f1"},
+						{execute.Time(1), "a", "f1"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "f1", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "c"},
+					},
+				},
+			},
+		},
 		{
 			name: "dropping a column not in rowKey or groupKey",
 			spec: &universe.PivotProcedureSpec{
@@ -0,0 +1,254 @@
+package universe
+
+import (
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	"github.com/pkg/errors"
+)
+
+const SessionWindowKind = "sessionWindow"
+
+// SessionWindowOpSpec specifies windows of rows that are no further apart in time than a gap.
+type SessionWindowOpSpec struct {
+	Gap         flux.Duration `json:"gap"`
+	TimeColumn  string        `json:"timeColumn"`
+	StartColumn string        `json:"startColumn"`
+	StopColumn  string        `json:"stopColumn"`
+}
+
+func init() {
+	sessionWindowSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"gap":         semantic.Duration,
+			"timeColumn":  semantic.String,
+			"startColumn": semantic.String,
+			"stopColumn":  semantic.String,
+		},
+		[]string{"gap"},
+	)
+
+	flux.RegisterPackageValue("universe", SessionWindowKind, flux.FunctionValue(SessionWindowKind, createSessionWindowOpSpec, sessionWindowSignature))
+	flux.RegisterOpSpec(SessionWindowKind, newSessionWindowOp)
+	plan.RegisterProcedureSpec(SessionWindowKind, newSessionWindowProcedure, SessionWindowKind)
+	execute.RegisterTransformation(SessionWindowKind, createSessionWindowTransformation)
+}
+
+func createSessionWindowOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	spec := new(SessionWindowOpSpec)
+	gap, err := args.GetRequiredDuration("gap")
+	if err != nil {
+		return nil, err
+	}
+	if gap <= 0 {
+		return nil, errors.New("gap must be a positive duration")
+	}
+	spec.Gap = gap
+
+	spec.TimeColumn, spec.StartColumn, spec.StopColumn, err = getWindowColumns(args)
+	if err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// getWindowColumns reads the time, start and stop column arguments of a window function.
+func getWindowColumns(args flux.Arguments) (timeCol, startCol, stopCol string, err error) {
+	timeCol, startCol, stopCol = execute.DefaultTimeColLabel, execute.DefaultStartColLabel, execute.DefaultStopColLabel
+	if label, ok, err := args.GetString("timeColumn"); err != nil {
+		return "", "", "", err
+	} else if ok {
+		timeCol = label
+	}
+	if label, ok, err := args.GetString("startColumn"); err != nil {
+		return "", "", "", err
+	} else if ok {
+		startCol = label
+	}
+	if label, ok, err := args.GetString("stopColumn"); err != nil {
+		return "", "", "", err
+	} else if ok {
+		stopCol = label
+	}
+	return timeCol, startCol, stopCol, nil
+}
+
+func newSessionWindowOp() flux.OperationSpec {
+	return new(SessionWindowOpSpec)
+}
+
+func (s *SessionWindowOpSpec) Kind() flux.OperationKind {
+	return SessionWindowKind
+}
+
+type SessionWindowProcedureSpec struct {
+	plan.DefaultCost
+	Gap flux.Duration
+	TimeColumn,
+	StartColumn,
+	StopColumn string
+}
+
+func newSessionWindowProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	s, ok := qs.(*SessionWindowOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	return &SessionWindowProcedureSpec{
+		Gap:         s.Gap,
+		TimeColumn:  s.TimeColumn,
+		StartColumn: s.StartColumn,
+		StopColumn:  s.StopColumn,
+	}, nil
+}
+
+func (s *SessionWindowProcedureSpec) Kind() plan.ProcedureKind {
+	return SessionWindowKind
+}
+
+func (s *SessionWindowProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	return &ns
+}
+
+func createSessionWindowTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*SessionWindowProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewSessionWindowTransformation(d, cache, a.Allocator(), s)
+	return t, d, nil
+}
+
+// NewSessionWindowTransformation creates a transformation that sorts the rows of every table
+// by time, and starts a new window whenever the time between two rows is more than the gap.
+// A window starts at the time of its first row and stops one gap after the time of its last row.
+func NewSessionWindowTransformation(d execute.Dataset, cache execute.TableBuilderCache, alloc *memory.Allocator, spec *SessionWindowProcedureSpec) execute.Transformation {
+	gap := execute.Duration(spec.Gap)
+	return &rowWindowTransformation{
+		d:          d,
+		cache:      cache,
+		alloc:      alloc,
+		timeCol:    spec.TimeColumn,
+		startCol:   spec.StartColumn,
+		stopCol:    spec.StopColumn,
+		sortByTime: true,
+		split: func(times []execute.Time) []rowWindow {
+			var windows []rowWindow
+			for i := range times {
+				if i == 0 || times[i]-times[i-1] > execute.Time(gap) {
+					windows = append(windows, rowWindow{start: i, bounds: execute.Bounds{Start: times[i]}})
+				}
+				w := &windows[len(windows)-1]
+				w.stop = i + 1
+				w.bounds.Stop = times[i].Add(gap)
+			}
+			return windows
+		},
+	}
+}
+
+// rowWindow is a window of the consecutive rows in the range [start, stop).
+type rowWindow struct {
+	start, stop int
+	bounds      execute.Bounds
+}
+
+// rowWindowTransformation assigns consecutive rows of every table to windows
+// whose bounds are computed from the times of the rows.
+// Rows with a null time do not belong to any window and are dropped.
+type rowWindowTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+	alloc *memory.Allocator
+
+	timeCol,
+	startCol,
+	stopCol string
+	// sortByTime sorts the rows of every table by time before they are split.
+	sortByTime bool
+	// split returns the windows of the rows with the given times.
+	split func(times []execute.Time) []rowWindow
+}
+
+func (t *rowWindowTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *rowWindowTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	timeIdx := execute.ColIdx(t.timeCol, tbl.Cols())
+	if timeIdx < 0 {
+		return fmt.Errorf("missing time column %q", t.timeCol)
+	}
+	if typ := tbl.Cols()[timeIdx].Type; typ != flux.TTime {
+		return fmt.Errorf("time column %q must be of type %v, got %v", t.timeCol, flux.TTime, typ)
+	}
+
+	// The bounds of a window are only known once all of its rows are seen,
+	// so the table is buffered.
+	buffer := execute.NewColListTableBuilder(tbl.Key(), t.alloc)
+	defer buffer.ClearData()
+	if err := execute.AddTableCols(tbl, buffer); err != nil {
+		return err
+	}
+	if err := execute.AppendTable(tbl, buffer); err != nil {
+		return err
+	}
+	if t.sortByTime {
+		buffer.SortBy([]string{t.timeCol}, []bool{false}, true)
+	}
+	rows, err := buffer.Table()
+	if err != nil {
+		return err
+	}
+	defer rows.Done()
+
+	schema := newWindowSchema(tbl, t.startCol, t.stopCol)
+	return rows.Do(func(cr flux.ColReader) error {
+		times := cr.Times(timeIdx)
+		// The windows are computed from the rows that have a time.
+		idx := make([]int, 0, cr.Len())
+		ts := make([]execute.Time, 0, cr.Len())
+		for i := 0; i < cr.Len(); i++ {
+			if times.IsValid(i) {
+				idx = append(idx, i)
+				ts = append(ts, values.Time(times.Value(i)))
+			}
+		}
+		for _, w := range t.split(ts) {
+			builder, err := schema.tableBuilder(t.cache, tbl.Key(), w.bounds)
+			if err != nil {
+				return err
+			}
+			for _, i := range idx[w.start:w.stop] {
+				if err := schema.appendRow(builder, cr, i, w.bounds); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (t *rowWindowTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+
+func (t *rowWindowTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+
+func (t *rowWindowTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
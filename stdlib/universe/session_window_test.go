@@ -0,0 +1,208 @@
+package universe_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+func TestSessionWindow_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "from with session window",
+			Raw:  `from(bucket:"mybucket") |> sessionWindow(gap: 30m, timeColumn: "t")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mybucket",
+						},
+					},
+					{
+						ID: "sessionWindow1",
+						Spec: &universe.SessionWindowOpSpec{
+							Gap:         flux.Duration(30 * time.Minute),
+							TimeColumn:  "t",
+							StartColumn: execute.DefaultStartColLabel,
+							StopColumn:  execute.DefaultStopColLabel,
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "sessionWindow1"},
+				},
+			},
+		},
+		{
+			Name:    "missing gap",
+			Raw:     `from(bucket:"mybucket") |> sessionWindow()`,
+			WantErr: true,
+		},
+		{
+			Name:    "zero gap",
+			Raw:     `from(bucket:"mybucket") |> sessionWindow(gap: 0s)`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestSessionWindow_Process(t *testing.T) {
+	testCases := []struct {
+		name    string
+		spec    *universe.SessionWindowProcedureSpec
+		data    []flux.Table
+		want    []*executetest.Table
+		wantErr error
+	}{
+		{
+			name: "sessions",
+			spec: &universe.SessionWindowProcedureSpec{
+				Gap:         flux.Duration(10),
+				TimeColumn:  "_time",
+				StartColumn: "_start",
+				StopColumn:  "_stop",
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"user"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "user", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(25), "a", 4.0},
+					{execute.Time(1), "a", 1.0},
+					{nil, "a", 0.0},
+					{execute.Time(11), "a", 2.0},
+					{execute.Time(40), "a", 5.0},
+					{execute.Time(22), "a", 3.0},
+				},
+			}},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"user", "_start", "_stop"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "user", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "_start", Type: flux.TTime},
+						{Label: "_stop", Type: flux.TTime},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "a", 1.0, execute.Time(1), execute.Time(21)},
+						{execute.Time(11), "a", 2.0, execute.Time(1), execute.Time(21)},
+					},
+				},
+				{
+					KeyCols: []string{"user", "_start", "_stop"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "user", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "_start", Type: flux.TTime},
+						{Label: "_stop", Type: flux.TTime},
+					},
+					Data: [][]interface{}{
+						{execute.Time(22), "a", 3.0, execute.Time(22), execute.Time(35)},
+						{execute.Time(25), "a", 4.0, execute.Time(22), execute.Time(35)},
+					},
+				},
+				{
+					KeyCols: []string{"user", "_start", "_stop"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "user", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "_start", Type: flux.TTime},
+						{Label: "_stop", Type: flux.TTime},
+					},
+					Data: [][]interface{}{
+						{execute.Time(40), "a", 5.0, execute.Time(40), execute.Time(50)},
+					},
+				},
+			},
+		},
+		{
+			name: "replaces start and stop",
+			spec: &universe.SessionWindowProcedureSpec{
+				Gap:         flux.Duration(5),
+				TimeColumn:  "_time",
+				StartColumn: "_start",
+				StopColumn:  "_stop",
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_stop", Type: flux.TTime},
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), execute.Time(100), execute.Time(10), int64(1)},
+					{execute.Time(0), execute.Time(100), execute.Time(15), int64(2)},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_start", Type: flux.TTime},
+					{Label: "_stop", Type: flux.TTime},
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(10), execute.Time(20), execute.Time(10), int64(1)},
+					{execute.Time(10), execute.Time(20), execute.Time(15), int64(2)},
+				},
+			}},
+		},
+		{
+			name: "missing time column",
+			spec: &universe.SessionWindowProcedureSpec{
+				Gap:         flux.Duration(5),
+				TimeColumn:  "_time",
+				StartColumn: "_start",
+				StopColumn:  "_stop",
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{int64(1)},
+				},
+			}},
+			wantErr: errors.New(`missing time column "_time"`),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				tc.wantErr,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					return universe.NewSessionWindowTransformation(d, c, executetest.UnlimitedAllocator, tc.spec)
+				},
+			)
+		})
+	}
+}
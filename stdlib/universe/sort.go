@@ -13,16 +13,29 @@ import (
 
 const SortKind = "sort"
 
+// The positions of null values in the sort order.
+const (
+	SortNullsFirst = "first"
+	SortNullsLast  = "last"
+)
+
 type SortOpSpec struct {
 	Columns []string `json:"columns"`
 	Desc    bool     `json:"desc"`
+	// ColumnDesc is the direction of each column when the columns are given as
+	// records with a column and a desc property. Desc is ignored when it is set.
+	ColumnDesc []bool `json:"columnDesc,omitempty"`
+	// Nulls is either SortNullsFirst or SortNullsLast, nulls sort first when it is empty.
+	Nulls string `json:"nulls,omitempty"`
 }
 
 func init() {
 	sortSignature := flux.FunctionSignature(
 		map[string]semantic.PolyType{
-			"columns": semantic.NewArrayPolyType(semantic.String),
+			// The columns are either strings or records with a column and a desc property.
+			"columns": semantic.NewArrayPolyType(semantic.Tvar(1)),
 			"desc":    semantic.Bool,
+			"nulls":   semantic.String,
 		},
 		nil,
 	)
@@ -30,6 +43,7 @@ func init() {
 	flux.RegisterPackageValue("universe", SortKind, flux.FunctionValue(SortKind, createSortOpSpec, sortSignature))
 	flux.RegisterOpSpec(SortKind, newSortOp)
 	plan.RegisterProcedureSpec(SortKind, newSortProcedure, SortKind)
+	plan.RegisterLogicalRules(SortLimitRule{})
 	execute.RegisterTransformation(SortKind, createSortTransformation)
 }
 
@@ -40,10 +54,24 @@ func createSortOpSpec(args flux.Arguments, a *flux.Administration) (flux.Operati
 
 	spec := new(SortOpSpec)
 
-	if array, ok, err := args.GetArray("columns", semantic.String); err != nil {
+	if desc, ok, err := args.GetBool("desc"); err != nil {
 		return nil, err
 	} else if ok {
-		spec.Columns, err = interpreter.ToStringArray(array)
+		spec.Desc = desc
+	}
+
+	if v, ok := args.Get("columns"); ok {
+		if v.Type().Nature() != semantic.Array {
+			return nil, fmt.Errorf("keyword argument %q should be of kind %v, but got %v", "columns", semantic.Array, v.Type().Nature())
+		}
+		var err error
+		array := v.Array()
+		switch array.Type().ElementType().Nature() {
+		case semantic.Object:
+			spec.Columns, spec.ColumnDesc, err = sortColumnRecords(array, spec.Desc)
+		default:
+			spec.Columns, err = interpreter.ToStringArray(array)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -52,15 +80,49 @@ func createSortOpSpec(args flux.Arguments, a *flux.Administration) (flux.Operati
 		spec.Columns = []string{execute.DefaultValueColLabel}
 	}
 
-	if desc, ok, err := args.GetBool("desc"); err != nil {
+	if nulls, ok, err := args.GetString("nulls"); err != nil {
 		return nil, err
 	} else if ok {
-		spec.Desc = desc
+		if nulls != SortNullsFirst && nulls != SortNullsLast {
+			return nil, fmt.Errorf("nulls must be %q or %q, got %q", SortNullsFirst, SortNullsLast, nulls)
+		}
+		spec.Nulls = nulls
 	}
 
 	return spec, nil
 }
 
+// sortColumnRecords reads the columns and their directions from records like {column: "host", desc: true}.
+// Records without a desc property are sorted in the default direction.
+func sortColumnRecords(array values.Array, desc bool) ([]string, []bool, error) {
+	columns := make([]string, array.Len())
+	descs := make([]bool, array.Len())
+	var err error
+	array.Range(func(i int, v values.Value) {
+		if err != nil {
+			return
+		}
+		column, ok := v.Object().Get("column")
+		if !ok || column.Type() != semantic.String {
+			err = fmt.Errorf("sort column %d must have a string column property", i)
+			return
+		}
+		columns[i] = column.Str()
+		descs[i] = desc
+		if d, ok := v.Object().Get("desc"); ok {
+			if d.Type() != semantic.Bool {
+				err = fmt.Errorf("the desc property of sort column %q must be a bool", columns[i])
+				return
+			}
+			descs[i] = d.Bool()
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return columns, descs, nil
+}
+
 func newSortOp() flux.OperationSpec {
 	return new(SortOpSpec)
 }
@@ -73,6 +135,14 @@ type SortProcedureSpec struct {
 	plan.DefaultCost
 	Columns []string
 	Desc    bool
+	// ColumnDesc is the direction of each column, Desc is used for every column when it is empty.
+	ColumnDesc []bool
+	NullsLast  bool
+
+	// N is set when a limit has been merged into the sort.
+	// Only the rows in the range [Offset, Offset+N) of every sorted table are kept.
+	N      int64
+	Offset int64
 }
 
 func newSortProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -82,8 +152,10 @@ func newSortProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.Proce
 	}
 
 	return &SortProcedureSpec{
-		Columns: spec.Columns,
-		Desc:    spec.Desc,
+		Columns:    spec.Columns,
+		Desc:       spec.Desc,
+		ColumnDesc: spec.ColumnDesc,
+		NullsLast:  spec.Nulls == SortNullsLast,
 	}, nil
 }
 
@@ -97,6 +169,15 @@ func (s *SortProcedureSpec) Copy() plan.ProcedureSpec {
 	copy(ns.Columns, s.Columns)
 
 	ns.Desc = s.Desc
+
+	if s.ColumnDesc != nil {
+		ns.ColumnDesc = make([]bool, len(s.ColumnDesc))
+		copy(ns.ColumnDesc, s.ColumnDesc)
+	}
+
+	ns.NullsLast = s.NullsLast
+	ns.N = s.N
+	ns.Offset = s.Offset
 	return ns
 }
 
@@ -110,7 +191,7 @@ func createSortTransformation(id execute.DatasetID, mode execute.AccumulationMod
 	if !ok {
 		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
 	}
-	cache := execute.NewTableBuilderCache(a.Allocator())
+	cache := execute.NewSpillableTableBuilderCache(a)
 	d := execute.NewDataset(id, mode, cache)
 	t := NewSortTransformation(d, cache, s)
 	return t, d, nil
@@ -120,16 +201,30 @@ type sortTransformation struct {
 	d     execute.Dataset
 	cache execute.TableBuilderCache
 
-	cols []string
-	desc bool
+	cols      []string
+	desc      []bool
+	nullsLast bool
+
+	n      int64
+	offset int64
 }
 
 func NewSortTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *SortProcedureSpec) *sortTransformation {
+	desc := spec.ColumnDesc
+	if len(desc) == 0 {
+		desc = make([]bool, len(spec.Columns))
+		for i := range desc {
+			desc[i] = spec.Desc
+		}
+	}
 	return &sortTransformation{
-		d:     d,
-		cache: cache,
-		cols:  spec.Columns,
-		desc:  spec.Desc,
+		d:         d,
+		cache:     cache,
+		cols:      spec.Columns,
+		desc:      desc,
+		nullsLast: spec.NullsLast,
+		n:         spec.N,
+		offset:    spec.Offset,
 	}
 }
 
@@ -153,11 +248,39 @@ func (t *sortTransformation) Process(id execute.DatasetID, tbl flux.Table) error
 	if err := execute.AddTableCols(tbl, builder); err != nil {
 		return err
 	}
+	if t.n > 0 {
+		return t.processTopN(tbl, builder)
+	}
 	if err := execute.AppendTable(tbl, builder); err != nil {
 		return err
 	}
 
-	builder.Sort(t.cols, t.desc)
+	b, ok := builder.(*execute.ColListTableBuilder)
+	if !ok {
+		return fmt.Errorf("sort requires a column list table builder, got %T", builder)
+	}
+	b.SortBy(t.cols, t.desc, t.nullsLast)
+	return nil
+}
+
+// processTopN keeps only the rows of the table that are within the limit
+// without buffering the whole table.
+func (t *sortTransformation) processTopN(tbl flux.Table, builder execute.TableBuilder) error {
+	top, err := execute.NewTopN(builder, int(t.offset), int(t.n), t.cols, t.desc, t.nullsLast)
+	if err != nil {
+		return err
+	}
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		for i := 0; i < cr.Len(); i++ {
+			if err := top.AppendRecord(i, cr); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	top.Sort()
 	return nil
 }
 
@@ -192,3 +315,37 @@ func (t *sortTransformation) sortedKey(key flux.GroupKey) flux.GroupKey {
 	}
 	return execute.NewGroupKey(cols, vs)
 }
+
+// SortLimitRule merges a limit into the sort before it, so that the sort
+// only keeps the rows that pass the limit instead of sorting every row.
+type SortLimitRule struct{}
+
+func (SortLimitRule) Name() string {
+	return "SortLimitRule"
+}
+
+// Pattern matches sort |> limit
+func (SortLimitRule) Pattern() plan.Pattern {
+	return plan.Pat(LimitKind, plan.Pat(SortKind, plan.Any()))
+}
+
+func (SortLimitRule) Rewrite(node plan.Node) (plan.Node, bool, error) {
+	sortNode := node.Predecessors()[0]
+	sortSpec := sortNode.ProcedureSpec().(*SortProcedureSpec)
+	limitSpec := node.ProcedureSpec().(*LimitProcedureSpec)
+
+	// The sorted tables may be used by another operation,
+	// and a sort can only be merged with one limit.
+	if len(sortNode.Successors()) != 1 || sortSpec.N > 0 || limitSpec.N <= 0 {
+		return node, false, nil
+	}
+
+	newSortSpec := sortSpec.Copy().(*SortProcedureSpec)
+	newSortSpec.N = limitSpec.N
+	newSortSpec.Offset = limitSpec.Offset
+	merged, err := plan.MergeToLogicalNode(node, sortNode, newSortSpec)
+	if err != nil {
+		return nil, false, err
+	}
+	return merged, true, nil
+}
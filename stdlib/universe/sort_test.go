@@ -6,10 +6,81 @@ import (
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/plan/plantest"
 	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
 	"github.com/influxdata/flux/stdlib/universe"
 )
 
+func TestSort_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "column records",
+			Raw:  `from(bucket:"mydb") |> sort(columns: [{column: "host", desc: false}, {column: "_value", desc: true}, {column: "_time"}], nulls: "last")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID:   "from0",
+						Spec: &influxdb.FromOpSpec{Bucket: "mydb"},
+					},
+					{
+						ID: "sort1",
+						Spec: &universe.SortOpSpec{
+							Columns:    []string{"host", "_value", "_time"},
+							ColumnDesc: []bool{false, true, false},
+							Nulls:      "last",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "sort1"},
+				},
+			},
+		},
+		{
+			Name: "column records with default direction",
+			Raw:  `from(bucket:"mydb") |> sort(columns: [{column: "host"}, {column: "_value", desc: false}], desc: true)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID:   "from0",
+						Spec: &influxdb.FromOpSpec{Bucket: "mydb"},
+					},
+					{
+						ID: "sort1",
+						Spec: &universe.SortOpSpec{
+							Columns:    []string{"host", "_value"},
+							Desc:       true,
+							ColumnDesc: []bool{true, false},
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "sort1"},
+				},
+			},
+		},
+		{
+			Name:    "column record without column",
+			Raw:     `from(bucket:"mydb") |> sort(columns: [{desc: true}])`,
+			WantErr: true,
+		},
+		{
+			Name:    "invalid nulls",
+			Raw:     `from(bucket:"mydb") |> sort(nulls: "middle")`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
 func TestSortOperation_Marshaling(t *testing.T) {
 	data := []byte(`{"id":"sort","kind":"sort","spec":{"columns":["t1","t2"],"desc":true}}`)
 	op := &flux.Operation{
@@ -129,7 +200,7 @@ func TestSort_Process(t *testing.T) {
 		{
 			name: "one table multiple columns descending",
 			spec: &universe.SortProcedureSpec{
-				Columns: []string{"_value", "time"},
+				Columns: []string{"_value", "_time"},
 				Desc:    true,
 			},
 			data: []flux.Table{&executetest.Table{
@@ -390,6 +461,176 @@ func TestSort_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "per column direction with nulls last",
+			spec: &universe.SortProcedureSpec{
+				Columns:    []string{"host", "_value"},
+				ColumnDesc: []bool{false, true},
+				NullsLast:  true,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "host", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0, "b"},
+					{execute.Time(2), nil, "a"},
+					{execute.Time(3), 3.0, "a"},
+					{execute.Time(4), 2.0, nil},
+					{execute.Time(5), 5.0, "a"},
+					{execute.Time(6), 4.0, "b"},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "host", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(5), 5.0, "a"},
+					{execute.Time(3), 3.0, "a"},
+					{execute.Time(2), nil, "a"},
+					{execute.Time(6), 4.0, "b"},
+					{execute.Time(1), 1.0, "b"},
+					{execute.Time(4), 2.0, nil},
+				},
+			}},
+		},
+		{
+			name: "stable",
+			spec: &universe.SortProcedureSpec{
+				Columns: []string{"_value"},
+				Desc:    true,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), int64(1)},
+					{execute.Time(2), int64(2)},
+					{execute.Time(3), int64(1)},
+					{execute.Time(4), int64(2)},
+					{execute.Time(5), int64(1)},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2), int64(2)},
+					{execute.Time(4), int64(2)},
+					{execute.Time(1), int64(1)},
+					{execute.Time(3), int64(1)},
+					{execute.Time(5), int64(1)},
+				},
+			}},
+		},
+		{
+			name: "top n",
+			spec: &universe.SortProcedureSpec{
+				Columns: []string{"_value"},
+				Desc:    true,
+				N:       3,
+				Offset:  1,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), int64(1)},
+					{execute.Time(2), int64(7)},
+					{execute.Time(3), nil},
+					{execute.Time(4), int64(5)},
+					{execute.Time(5), int64(7)},
+					{execute.Time(6), int64(5)},
+					{execute.Time(7), int64(9)},
+					{execute.Time(8), int64(5)},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(7), int64(9)},
+					{execute.Time(2), int64(7)},
+					{execute.Time(5), int64(7)},
+				},
+			}},
+		},
+		{
+			name: "top n with nulls last and ties",
+			spec: &universe.SortProcedureSpec{
+				Columns:   []string{"_value"},
+				NullsLast: true,
+				N:         4,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil},
+					{execute.Time(2), int64(3)},
+					{execute.Time(3), int64(2)},
+					{execute.Time(4), int64(3)},
+					{execute.Time(5), int64(3)},
+					{execute.Time(6), int64(1)},
+					{execute.Time(7), int64(3)},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(6), int64(1)},
+					{execute.Time(3), int64(2)},
+					{execute.Time(2), int64(3)},
+					{execute.Time(4), int64(3)},
+				},
+			}},
+		},
+		{
+			name: "top n with offset past the end",
+			spec: &universe.SortProcedureSpec{
+				Columns: []string{"_value"},
+				N:       2,
+				Offset:  3,
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"t1"},
+				ColMeta: []flux.ColMeta{
+					{Label: "t1", Type: flux.TString},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{"a", int64(2)},
+					{"a", int64(1)},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols:   []string{"t1"},
+				KeyValues: []interface{}{"a"},
+				ColMeta: []flux.ColMeta{
+					{Label: "t1", Type: flux.TString},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}(nil),
+			}},
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -406,3 +647,82 @@ func TestSort_Process(t *testing.T) {
 		})
 	}
 }
+
+func TestSortLimitRule(t *testing.T) {
+	var (
+		from      = &influxdb.FromProcedureSpec{}
+		sortSpec  = &universe.SortProcedureSpec{Columns: []string{"_value"}, Desc: true}
+		limitSpec = &universe.LimitProcedureSpec{N: 10, Offset: 2}
+		topSpec   = &universe.SortProcedureSpec{Columns: []string{"_value"}, Desc: true, N: 10, Offset: 2}
+		filter    = &universe.FilterProcedureSpec{}
+	)
+
+	tests := []plantest.RuleTestCase{
+		{
+			Name:  "sort limit",
+			Rules: []plan.Rule{universe.SortLimitRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreateLogicalNode("from", from),
+					plan.CreateLogicalNode("sort", sortSpec),
+					plan.CreateLogicalNode("limit", limitSpec),
+				},
+				Edges: [][2]int{
+					{0, 1},
+					{1, 2},
+				},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreateLogicalNode("from", from),
+					plan.CreateLogicalNode("merged_sort_limit", topSpec),
+				},
+				Edges: [][2]int{
+					{0, 1},
+				},
+			},
+		},
+		{
+			Name:  "sort used twice",
+			Rules: []plan.Rule{universe.SortLimitRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreateLogicalNode("from", from),
+					plan.CreateLogicalNode("sort", sortSpec),
+					plan.CreateLogicalNode("limit", limitSpec),
+					plan.CreateLogicalNode("filter", filter),
+				},
+				Edges: [][2]int{
+					{0, 1},
+					{1, 2},
+					{1, 3},
+				},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "limit after top n",
+			Rules: []plan.Rule{universe.SortLimitRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreateLogicalNode("from", from),
+					plan.CreateLogicalNode("sort", topSpec),
+					plan.CreateLogicalNode("limit", limitSpec),
+				},
+				Edges: [][2]int{
+					{0, 1},
+					{1, 2},
+				},
+			},
+			NoChange: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			plantest.LogicalRuleTestHelper(t, &tc)
+		})
+	}
+}
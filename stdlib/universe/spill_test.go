@@ -0,0 +1,187 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+)
+
+// spillAdministration provides the allocator and the dependencies of a transformation.
+type spillAdministration struct {
+	alloc   *memory.Allocator
+	parents []execute.DatasetID
+	deps    execute.Dependencies
+}
+
+func (a *spillAdministration) Context() context.Context              { return context.Background() }
+func (a *spillAdministration) ResolveTime(qt flux.Time) execute.Time { return 0 }
+func (a *spillAdministration) StreamContext() execute.StreamContext  { return nil }
+func (a *spillAdministration) Allocator() *memory.Allocator          { return a.alloc }
+func (a *spillAdministration) Parents() []execute.DatasetID          { return a.parents }
+func (a *spillAdministration) Dependencies() execute.Dependencies    { return a.deps }
+
+type createFunc func(execute.DatasetID, execute.AccumulationMode, plan.ProcedureSpec, execute.Administration) (execute.Transformation, execute.Dataset, error)
+
+// runSpilled runs the transformation on the tables of every parent and returns its output.
+// If dir is set, the transformation spills to dir and the rows of the inputs must have been spilled.
+func runSpilled(t *testing.T, create createFunc, spec plan.ProcedureSpec, parents []execute.DatasetID, inputs func() [][]*executetest.Table, dir string) []*executetest.Table {
+	t.Helper()
+	a := &spillAdministration{
+		alloc:   &memory.Allocator{},
+		parents: parents,
+		deps:    make(execute.Dependencies),
+	}
+	if dir != "" {
+		limit := int64(1 << 20)
+		a.alloc.Limit = &limit
+		execute.InjectSpillConfig(a.deps, execute.SpillConfig{Dir: dir, Threshold: 0.05})
+	}
+	tx, d, err := create(executetest.RandomDatasetID(), execute.DiscardingMode, spec, a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.SetTriggerSpec(plan.DefaultTriggerSpec)
+	id := executetest.RandomDatasetID()
+	result := executetest.NewDataset(id)
+	c := execute.NewTableBuilderCache(executetest.UnlimitedAllocator)
+	c.SetTriggerSpec(plan.DefaultTriggerSpec)
+	d.AddTransformation(executetest.NewYieldTransformation(result, c))
+
+	for i, tables := range inputs() {
+		for _, tbl := range tables {
+			if err := tx.Process(parents[i], tbl); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if dir != "" {
+		if files, _ := ioutil.ReadDir(dir); len(files) == 0 {
+			t.Fatal("expected the input rows to be spilled")
+		}
+	}
+	for _, id := range parents {
+		tx.Finish(id, nil)
+	}
+	if result.FinishedErr != nil {
+		t.Fatal(result.FinishedErr)
+	}
+	got, err := executetest.TablesFromCache(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	executetest.NormalizeTables(got)
+	return got
+}
+
+// fieldTables makes a table per field with a row for each of n times.
+func fieldTables(prefix string, fields, n int) []*executetest.Table {
+	tables := make([]*executetest.Table, fields)
+	for i := range tables {
+		field := fmt.Sprintf("%s%d", prefix, i)
+		tbl := &executetest.Table{
+			KeyCols: []string{"_field"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_field", Type: flux.TString},
+				{Label: "_value", Type: flux.TFloat},
+			},
+		}
+		for j := 0; j < n; j++ {
+			tbl.Data = append(tbl.Data, []interface{}{execute.Time(j), field, float64(i*n + j)})
+		}
+		tables[i] = tbl
+	}
+	return tables
+}
+
+func TestPivot_Spill(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flux-spill-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	spec := &PivotProcedureSpec{
+		RowKey:      []string{"_time"},
+		ColumnKey:   []string{"_field"},
+		ValueColumn: "_value",
+	}
+	parents := []execute.DatasetID{executetest.RandomDatasetID()}
+	inputs := func() [][]*executetest.Table {
+		return [][]*executetest.Table{fieldTables("f", 20, 400)}
+	}
+	want := runSpilled(t, createPivotTransformation, spec, parents, inputs, "")
+	got := runSpilled(t, createPivotTransformation, spec, parents, inputs, dir)
+	if len(got) != 1 || len(got[0].Data) != 400 {
+		t.Fatalf("expected a pivoted table with a row per time, got %v", got)
+	}
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(want, got))
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 0 {
+		t.Errorf("expected the spill files to be removed, found %d", len(files))
+	}
+}
+
+func TestMergeJoin_Spill(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flux-spill-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	spec := &MergeJoinProcedureSpec{
+		TableNames: []string{"a", "b"},
+		On:         []string{"_time", "_field"},
+		Method:     "inner",
+	}
+	parents := []execute.DatasetID{executetest.RandomDatasetID(), executetest.RandomDatasetID()}
+	inputs := func() [][]*executetest.Table {
+		return [][]*executetest.Table{fieldTables("f", 10, 400), fieldTables("f", 10, 400)}
+	}
+	want := runSpilled(t, createMergeJoinTransformation, spec, parents, inputs, "")
+	got := runSpilled(t, createMergeJoinTransformation, spec, parents, inputs, dir)
+	if len(got) != 10 {
+		t.Fatalf("expected a joined table per field, got %d tables", len(got))
+	}
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestJoinAsOf_Spill(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flux-spill-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	spec := &JoinAsOfProcedureSpec{
+		TableNames: []string{"a", "b"},
+		On:         []string{"_field"},
+		TimeColumn: "_time",
+		Tolerance:  flux.Duration(1),
+		Direction:  AsOfBackward,
+	}
+	parents := []execute.DatasetID{executetest.RandomDatasetID(), executetest.RandomDatasetID()}
+	inputs := func() [][]*executetest.Table {
+		return [][]*executetest.Table{fieldTables("f", 10, 400), fieldTables("f", 10, 400)}
+	}
+	want := runSpilled(t, createJoinAsOfTransformation, spec, parents, inputs, "")
+	got := runSpilled(t, createJoinAsOfTransformation, spec, parents, inputs, dir)
+	if len(got) != 10 || len(got[0].Data) != 400 {
+		t.Fatalf("expected a joined table per field with a row per time, got %v", got)
+	}
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(want, got))
+	}
+}
@@ -0,0 +1,271 @@
+package universe
+
+import (
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	"github.com/pkg/errors"
+)
+
+const UnpivotKind = "unpivot"
+
+// UnpivotOpSpec turns columns into rows, it is the reverse of pivot.
+type UnpivotOpSpec struct {
+	Columns     []string `json:"columns"`
+	KeyColumn   string   `json:"keyColumn"`
+	ValueColumn string   `json:"valueColumn"`
+}
+
+func init() {
+	unpivotSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"columns":     semantic.NewArrayPolyType(semantic.String),
+			"keyColumn":   semantic.String,
+			"valueColumn": semantic.String,
+		},
+		[]string{"columns"},
+	)
+
+	flux.RegisterPackageValue("universe", UnpivotKind, flux.FunctionValue(UnpivotKind, createUnpivotOpSpec, unpivotSignature))
+	flux.RegisterOpSpec(UnpivotKind, newUnpivotOp)
+	plan.RegisterProcedureSpec(UnpivotKind, newUnpivotProcedure, UnpivotKind)
+	execute.RegisterTransformation(UnpivotKind, createUnpivotTransformation)
+}
+
+func createUnpivotOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	spec := &UnpivotOpSpec{
+		KeyColumn:   "_field",
+		ValueColumn: execute.DefaultValueColLabel,
+	}
+
+	array, err := args.GetRequiredArray("columns", semantic.String)
+	if err != nil {
+		return nil, err
+	}
+	spec.Columns, err = interpreter.ToStringArray(array)
+	if err != nil {
+		return nil, err
+	}
+	if len(spec.Columns) == 0 {
+		return nil, errors.New("unpivot requires at least one column")
+	}
+
+	if label, ok, err := args.GetString("keyColumn"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.KeyColumn = label
+	}
+	if label, ok, err := args.GetString("valueColumn"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.ValueColumn = label
+	}
+	if spec.KeyColumn == spec.ValueColumn {
+		return nil, fmt.Errorf("keyColumn and valueColumn must be different, both are %q", spec.KeyColumn)
+	}
+
+	seen := make(map[string]bool, len(spec.Columns))
+	for _, c := range spec.Columns {
+		if seen[c] {
+			return nil, fmt.Errorf("column %q is listed more than once", c)
+		}
+		seen[c] = true
+	}
+	return spec, nil
+}
+
+func newUnpivotOp() flux.OperationSpec {
+	return new(UnpivotOpSpec)
+}
+
+func (s *UnpivotOpSpec) Kind() flux.OperationKind {
+	return UnpivotKind
+}
+
+type UnpivotProcedureSpec struct {
+	plan.DefaultCost
+	Columns     []string
+	KeyColumn   string
+	ValueColumn string
+}
+
+func newUnpivotProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*UnpivotOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	return &UnpivotProcedureSpec{
+		Columns:     spec.Columns,
+		KeyColumn:   spec.KeyColumn,
+		ValueColumn: spec.ValueColumn,
+	}, nil
+}
+
+func (s *UnpivotProcedureSpec) Kind() plan.ProcedureKind {
+	return UnpivotKind
+}
+
+func (s *UnpivotProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	ns.Columns = make([]string, len(s.Columns))
+	copy(ns.Columns, s.Columns)
+	return &ns
+}
+
+func createUnpivotTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*UnpivotProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewUnpivotTransformation(d, cache, s)
+	return t, d, nil
+}
+
+type unpivotTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+	spec  UnpivotProcedureSpec
+}
+
+// NewUnpivotTransformation creates a transformation that turns every non-null value
+// of the unpivoted columns into a row. The row has the name of the column in the key column,
+// the value in the value column, and the values of all other columns of the input row.
+// The key column is added to the group key, so every unpivoted column gets its own table.
+func NewUnpivotTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *UnpivotProcedureSpec) *unpivotTransformation {
+	return &unpivotTransformation{
+		d:     d,
+		cache: cache,
+		spec:  *spec,
+	}
+}
+
+func (t *unpivotTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *unpivotTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	unpivotIdx := make([]int, len(t.spec.Columns))
+	for i, label := range t.spec.Columns {
+		j := execute.ColIdx(label, tbl.Cols())
+		if j < 0 {
+			return fmt.Errorf("specified column does not exist in table: %v", label)
+		}
+		if tbl.Key().HasCol(label) {
+			return fmt.Errorf("cannot unpivot group key column %q", label)
+		}
+		unpivotIdx[i] = j
+	}
+
+	// The columns that are copied to every row.
+	isUnpivoted := make([]bool, len(tbl.Cols()))
+	for _, j := range unpivotIdx {
+		isUnpivoted[j] = true
+	}
+	cols := make([]flux.ColMeta, 0, len(tbl.Cols())-len(unpivotIdx)+1)
+	keptIdx := make([]int, 0, len(tbl.Cols())-len(unpivotIdx))
+	for j, c := range tbl.Cols() {
+		if isUnpivoted[j] {
+			continue
+		}
+		if c.Label == t.spec.KeyColumn || c.Label == t.spec.ValueColumn {
+			return fmt.Errorf("unpivot output column %q already exists in table", c.Label)
+		}
+		cols = append(cols, c)
+		keptIdx = append(keptIdx, j)
+	}
+	cols = append(cols, flux.ColMeta{Label: t.spec.KeyColumn, Type: flux.TString})
+	keyIdx, valueIdx := len(cols)-1, len(cols)
+
+	// Every unpivoted column has its own output table,
+	// so the value column has the type of the unpivoted column.
+	builders := make([]execute.TableBuilder, len(unpivotIdx))
+	for i, label := range t.spec.Columns {
+		outCols := append(cols[:len(cols):len(cols)], flux.ColMeta{
+			Label: t.spec.ValueColumn,
+			Type:  tbl.Cols()[unpivotIdx[i]].Type,
+		})
+		key := unpivotGroupKey(tbl.Key(), t.spec.KeyColumn, label)
+		builder, created := t.cache.TableBuilder(key)
+		if created {
+			for _, c := range outCols {
+				if _, err := builder.AddCol(c); err != nil {
+					return err
+				}
+			}
+		} else if err := checkUnpivotCols(builder.Cols(), outCols); err != nil {
+			return err
+		}
+		builders[i] = builder
+	}
+
+	return tbl.Do(func(cr flux.ColReader) error {
+		for row := 0; row < cr.Len(); row++ {
+			for i, j := range unpivotIdx {
+				v := execute.ValueForRow(cr, row, j)
+				if v.IsNull() {
+					continue
+				}
+				builder := builders[i]
+				for k, src := range keptIdx {
+					if err := builder.AppendValue(k, execute.ValueForRow(cr, row, src)); err != nil {
+						return err
+					}
+				}
+				if err := builder.AppendString(keyIdx, t.spec.Columns[i]); err != nil {
+					return err
+				}
+				if err := builder.AppendValue(valueIdx, v); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// unpivotGroupKey adds the key column with the name of an unpivoted column to the group key.
+func unpivotGroupKey(key flux.GroupKey, keyColumn, label string) flux.GroupKey {
+	cols := make([]flux.ColMeta, 0, len(key.Cols())+1)
+	vs := make([]values.Value, 0, len(key.Cols())+1)
+	cols = append(cols, key.Cols()...)
+	vs = append(vs, key.Values()...)
+	cols = append(cols, flux.ColMeta{Label: keyColumn, Type: flux.TString})
+	vs = append(vs, values.NewString(label))
+	return execute.NewGroupKey(cols, vs)
+}
+
+// checkUnpivotCols checks that tables that unpivot into the same output table have the same columns.
+func checkUnpivotCols(have, want []flux.ColMeta) error {
+	if len(have) != len(want) {
+		return fmt.Errorf("unpivot found tables with different columns for the same group key")
+	}
+	for j := range have {
+		if have[j] != want[j] {
+			return fmt.Errorf("unpivot found column %q of type %v and column %q of type %v at the same position of tables with the same group key", have[j].Label, have[j].Type, want[j].Label, want[j].Type)
+		}
+	}
+	return nil
+}
+
+func (t *unpivotTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+
+func (t *unpivotTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+
+func (t *unpivotTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
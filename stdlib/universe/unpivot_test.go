@@ -0,0 +1,248 @@
+package universe_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+func TestUnpivot_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "unpivot with defaults",
+			Raw:  `from(bucket:"testdb") |> unpivot(columns: ["temp", "hum"])`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "testdb",
+						},
+					},
+					{
+						ID: "unpivot1",
+						Spec: &universe.UnpivotOpSpec{
+							Columns:     []string{"temp", "hum"},
+							KeyColumn:   "_field",
+							ValueColumn: "_value",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "unpivot1"},
+				},
+			},
+		},
+		{
+			Name: "unpivot with key and value columns",
+			Raw:  `from(bucket:"testdb") |> unpivot(columns: ["temp"], keyColumn: "k", valueColumn: "v")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "testdb",
+						},
+					},
+					{
+						ID: "unpivot1",
+						Spec: &universe.UnpivotOpSpec{
+							Columns:     []string{"temp"},
+							KeyColumn:   "k",
+							ValueColumn: "v",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "unpivot1"},
+				},
+			},
+		},
+		{
+			Name:    "no columns",
+			Raw:     `from(bucket:"testdb") |> unpivot(columns: [])`,
+			WantErr: true,
+		},
+		{
+			Name:    "same key and value column",
+			Raw:     `from(bucket:"testdb") |> unpivot(columns: ["temp"], keyColumn: "x", valueColumn: "x")`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestUnpivotOperation_Marshaling(t *testing.T) {
+	data := []byte(`{
+		"id":"unpivot",
+		"kind":"unpivot",
+		"spec":{
+			"columns":["temp", "hum"],
+			"keyColumn":"_field",
+			"valueColumn":"_value"
+		}
+	}`)
+	op := &flux.Operation{
+		ID: "unpivot",
+		Spec: &universe.UnpivotOpSpec{
+			Columns:     []string{"temp", "hum"},
+			KeyColumn:   "_field",
+			ValueColumn: "_value",
+		},
+	}
+	querytest.OperationMarshalingTestHelper(t, data, op)
+}
+
+func TestUnpivot_Process(t *testing.T) {
+	testCases := []struct {
+		name    string
+		spec    *universe.UnpivotProcedureSpec
+		data    []flux.Table
+		want    []*executetest.Table
+		wantErr error
+	}{
+		{
+			name: "wide to long",
+			spec: &universe.UnpivotProcedureSpec{
+				Columns:     []string{"temp", "hum"},
+				KeyColumn:   "_field",
+				ValueColumn: "_value",
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					KeyCols: []string{"_measurement"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "temp", Type: flux.TFloat},
+						{Label: "hum", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "m1", 20.0, 0.5},
+						{execute.Time(2), "m1", nil, 0.6},
+						{execute.Time(3), "m1", 22.0, nil},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"_measurement", "_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "_field", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "m1", "hum", 0.5},
+						{execute.Time(2), "m1", "hum", 0.6},
+					},
+				},
+				{
+					KeyCols: []string{"_measurement", "_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "_field", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "m1", "temp", 20.0},
+						{execute.Time(3), "m1", "temp", 22.0},
+					},
+				},
+			},
+		},
+		{
+			name: "columns with different types",
+			spec: &universe.UnpivotProcedureSpec{
+				Columns:     []string{"a", "b"},
+				KeyColumn:   "_field",
+				ValueColumn: "_value",
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "a", Type: flux.TFloat},
+						{Label: "b", Type: flux.TInt},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, int64(1)},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_field", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "a", 1.0},
+					},
+				},
+				{
+					KeyCols: []string{"_field"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_field", Type: flux.TString},
+						{Label: "_value", Type: flux.TInt},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "b", int64(1)},
+					},
+				},
+			},
+		},
+		{
+			name: "group key column",
+			spec: &universe.UnpivotProcedureSpec{
+				Columns:     []string{"a"},
+				KeyColumn:   "_field",
+				ValueColumn: "_value",
+			},
+			data: []flux.Table{
+				&executetest.Table{
+					KeyCols: []string{"a"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "a", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), "x"},
+					},
+				},
+			},
+			wantErr: errors.New(`cannot unpivot group key column "a"`),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				tc.wantErr,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					return universe.NewUnpivotTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
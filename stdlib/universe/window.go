@@ -3,6 +3,7 @@ package universe
 import (
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/execute"
@@ -15,13 +16,14 @@ import (
 const WindowKind = "window"
 
 type WindowOpSpec struct {
-	Every       flux.Duration `json:"every"`
-	Period      flux.Duration `json:"period"`
-	Offset      flux.Duration `json:"offset"`
-	TimeColumn  string        `json:"timeColumn"`
-	StopColumn  string        `json:"stopColumn"`
-	StartColumn string        `json:"startColumn"`
-	CreateEmpty bool          `json:"createEmpty"`
+	Every       values.CalendarDuration `json:"every"`
+	Period      values.CalendarDuration `json:"period"`
+	Offset      values.CalendarDuration `json:"offset"`
+	Location    string                  `json:"location,omitempty"`
+	TimeColumn  string                  `json:"timeColumn"`
+	StopColumn  string                  `json:"stopColumn"`
+	StartColumn string                  `json:"startColumn"`
+	CreateEmpty bool                    `json:"createEmpty"`
 }
 
 var infinityVar = values.NewDuration(math.MaxInt64)
@@ -32,6 +34,7 @@ func init() {
 			"every":       semantic.Duration,
 			"period":      semantic.Duration,
 			"offset":      semantic.Duration,
+			"location":    semantic.String,
 			"timeColumn":  semantic.String,
 			"startColumn": semantic.String,
 			"stopColumn":  semantic.String,
@@ -54,21 +57,21 @@ func createWindowOpSpec(args flux.Arguments, a *flux.Administration) (flux.Opera
 	}
 
 	spec := new(WindowOpSpec)
-	every, everySet, err := args.GetDuration("every")
+	every, everySet, err := args.GetCalendarDuration("every")
 	if err != nil {
 		return nil, err
 	}
 	if everySet {
-		spec.Every = flux.Duration(every)
+		spec.Every = every
 	}
-	period, periodSet, err := args.GetDuration("period")
+	period, periodSet, err := args.GetCalendarDuration("period")
 	if err != nil {
 		return nil, err
 	}
 	if periodSet {
 		spec.Period = period
 	}
-	if offset, ok, err := args.GetDuration("offset"); err != nil {
+	if offset, ok, err := args.GetCalendarDuration("offset"); err != nil {
 		return nil, err
 	} else if ok {
 		spec.Offset = offset
@@ -78,6 +81,15 @@ func createWindowOpSpec(args flux.Arguments, a *flux.Administration) (flux.Opera
 		return nil, errors.New(`window function requires at least one of "every" or "period" to be set`)
 	}
 
+	if location, ok, err := args.GetString("location"); err != nil {
+		return nil, err
+	} else if ok {
+		if _, err := loadLocation(location); err != nil {
+			return nil, err
+		}
+		spec.Location = location
+	}
+
 	if label, ok, err := args.GetString("timeColumn"); err != nil {
 		return nil, err
 	} else if ok {
@@ -141,9 +153,10 @@ func newWindowProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.Pro
 	}
 	p := &WindowProcedureSpec{
 		Window: plan.WindowSpec{
-			Every:  s.Every,
-			Period: s.Period,
-			Offset: s.Offset,
+			Every:    s.Every,
+			Period:   s.Period,
+			Offset:   s.Offset,
+			Location: s.Location,
 		},
 		TimeColumn:  s.TimeColumn,
 		StartColumn: s.StartColumn,
@@ -175,14 +188,20 @@ func createWindowTransformation(id execute.DatasetID, mode execute.AccumulationM
 		return nil, nil, errors.New("nil bounds passed to window")
 	}
 
+	loc, err := loadLocation(s.Window.Location)
+	if err != nil {
+		return nil, nil, err
+	}
+	w, err := execute.NewCalendarWindow(s.Window.Every, s.Window.Period, s.Window.Offset, loc)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	t := NewFixedWindowTransformation(
 		d,
 		cache,
 		*bounds,
-		execute.NewWindow(
-			execute.Duration(s.Window.Every),
-			execute.Duration(s.Window.Period),
-			execute.Duration(s.Window.Offset)),
+		w,
 		s.TimeColumn,
 		s.StartColumn,
 		s.StopColumn,
@@ -191,6 +210,19 @@ func createWindowTransformation(id execute.DatasetID, mode execute.AccumulationM
 	return t, d, nil
 }
 
+// loadLocation loads the time zone with the given name.
+// An empty name is UTC.
+func loadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid window location %q", name)
+	}
+	return loc, nil
+}
+
 type fixedWindowTransformation struct {
 	d         execute.Dataset
 	cache     execute.TableBuilderCache
@@ -242,48 +274,7 @@ func (t *fixedWindowTransformation) Process(id execute.DatasetID, tbl flux.Table
 		return fmt.Errorf("missing time column %q", t.timeCol)
 	}
 
-	newCols := make([]flux.ColMeta, 0, len(tbl.Cols())+2)
-	keyCols := make([]flux.ColMeta, 0, len(tbl.Cols())+2)
-	keyColMap := make([]int, 0, len(tbl.Cols())+2)
-	startColIdx := -1
-	stopColIdx := -1
-	for j, c := range tbl.Cols() {
-		keyIdx := execute.ColIdx(c.Label, tbl.Key().Cols())
-		keyed := keyIdx >= 0
-		if c.Label == t.startCol {
-			startColIdx = j
-			keyed = true
-		}
-		if c.Label == t.stopCol {
-			stopColIdx = j
-			keyed = true
-		}
-		newCols = append(newCols, c)
-		if keyed {
-			keyCols = append(keyCols, c)
-			keyColMap = append(keyColMap, keyIdx)
-		}
-	}
-	if startColIdx == -1 {
-		startColIdx = len(newCols)
-		c := flux.ColMeta{
-			Label: t.startCol,
-			Type:  flux.TTime,
-		}
-		newCols = append(newCols, c)
-		keyCols = append(keyCols, c)
-		keyColMap = append(keyColMap, len(keyColMap))
-	}
-	if stopColIdx == -1 {
-		stopColIdx = len(newCols)
-		c := flux.ColMeta{
-			Label: t.stopCol,
-			Type:  flux.TTime,
-		}
-		newCols = append(newCols, c)
-		keyCols = append(keyCols, c)
-		keyColMap = append(keyColMap, len(keyColMap))
-	}
+	schema := newWindowSchema(tbl, t.startCol, t.stopCol)
 
 	// Abort processing if no data will match bounds
 	if t.bounds.IsEmpty() {
@@ -291,15 +282,8 @@ func (t *fixedWindowTransformation) Process(id execute.DatasetID, tbl flux.Table
 	}
 
 	for _, bnds := range t.allBounds {
-		key := t.newWindowGroupKey(tbl, keyCols, bnds, keyColMap)
-		builder, created := t.cache.TableBuilder(key)
-		if created {
-			for _, c := range newCols {
-				_, err := builder.AddCol(c)
-				if err != nil {
-					return err
-				}
-			}
+		if _, err := schema.tableBuilder(t.cache, tbl.Key(), bnds); err != nil {
+			return err
 		}
 	}
 
@@ -310,32 +294,12 @@ func (t *fixedWindowTransformation) Process(id execute.DatasetID, tbl flux.Table
 			bounds := t.getWindowBounds(tm)
 
 			for _, bnds := range bounds {
-				key := t.newWindowGroupKey(tbl, keyCols, bnds, keyColMap)
-				builder, created := t.cache.TableBuilder(key)
-				if created {
-					for _, c := range newCols {
-						_, err := builder.AddCol(c)
-						if err != nil {
-							return err
-						}
-					}
+				builder, err := schema.tableBuilder(t.cache, tbl.Key(), bnds)
+				if err != nil {
+					return err
 				}
-
-				for j, c := range builder.Cols() {
-					switch c.Label {
-					case t.startCol:
-						if err := builder.AppendTime(startColIdx, bnds.Start); err != nil {
-							return err
-						}
-					case t.stopCol:
-						if err := builder.AppendTime(stopColIdx, bnds.Stop); err != nil {
-							return err
-						}
-					default:
-						if err := builder.AppendValue(j, execute.ValueForRow(cr, i, j)); err != nil {
-							return err
-						}
-					}
+				if err := schema.appendRow(builder, cr, i, bnds); err != nil {
+					return err
 				}
 			}
 		}
@@ -343,23 +307,122 @@ func (t *fixedWindowTransformation) Process(id execute.DatasetID, tbl flux.Table
 	})
 }
 
-func (t *fixedWindowTransformation) newWindowGroupKey(tbl flux.Table, keyCols []flux.ColMeta, bnds execute.Bounds, keyColMap []int) flux.GroupKey {
-	cols := make([]flux.ColMeta, len(keyCols))
-	vs := make([]values.Value, len(keyCols))
-	for j, c := range keyCols {
+// windowSchema describes the tables that a window transformation creates from an input table.
+// They have the columns of the input table, plus the start and stop columns if the input table
+// does not have them, and the start and stop columns are added to the group key.
+type windowSchema struct {
+	cols      []flux.ColMeta
+	keyCols   []flux.ColMeta
+	keyColMap []int
+	startCol,
+	stopCol string
+	startColIdx,
+	stopColIdx int
+}
+
+func newWindowSchema(tbl flux.Table, startCol, stopCol string) *windowSchema {
+	s := &windowSchema{
+		cols:        make([]flux.ColMeta, 0, len(tbl.Cols())+2),
+		keyCols:     make([]flux.ColMeta, 0, len(tbl.Cols())+2),
+		keyColMap:   make([]int, 0, len(tbl.Cols())+2),
+		startCol:    startCol,
+		stopCol:     stopCol,
+		startColIdx: -1,
+		stopColIdx:  -1,
+	}
+	for j, c := range tbl.Cols() {
+		keyIdx := execute.ColIdx(c.Label, tbl.Key().Cols())
+		keyed := keyIdx >= 0
+		if c.Label == startCol {
+			s.startColIdx = j
+			keyed = true
+		}
+		if c.Label == stopCol {
+			s.stopColIdx = j
+			keyed = true
+		}
+		s.cols = append(s.cols, c)
+		if keyed {
+			s.keyCols = append(s.keyCols, c)
+			s.keyColMap = append(s.keyColMap, keyIdx)
+		}
+	}
+	if s.startColIdx == -1 {
+		s.startColIdx = len(s.cols)
+		c := flux.ColMeta{
+			Label: startCol,
+			Type:  flux.TTime,
+		}
+		s.cols = append(s.cols, c)
+		s.keyCols = append(s.keyCols, c)
+		s.keyColMap = append(s.keyColMap, len(s.keyColMap))
+	}
+	if s.stopColIdx == -1 {
+		s.stopColIdx = len(s.cols)
+		c := flux.ColMeta{
+			Label: stopCol,
+			Type:  flux.TTime,
+		}
+		s.cols = append(s.cols, c)
+		s.keyCols = append(s.keyCols, c)
+		s.keyColMap = append(s.keyColMap, len(s.keyColMap))
+	}
+	return s
+}
+
+// groupKey returns the group key of the window with the given bounds.
+func (s *windowSchema) groupKey(key flux.GroupKey, bnds execute.Bounds) flux.GroupKey {
+	cols := make([]flux.ColMeta, len(s.keyCols))
+	vs := make([]values.Value, len(s.keyCols))
+	for j, c := range s.keyCols {
 		cols[j] = c
 		switch c.Label {
-		case t.startCol:
+		case s.startCol:
 			vs[j] = values.NewTime(bnds.Start)
-		case t.stopCol:
+		case s.stopCol:
 			vs[j] = values.NewTime(bnds.Stop)
 		default:
-			vs[j] = tbl.Key().Value(keyColMap[j])
+			vs[j] = key.Value(s.keyColMap[j])
 		}
 	}
 	return execute.NewGroupKey(cols, vs)
 }
 
+// tableBuilder returns the table builder of the window with the given bounds,
+// and adds the columns if the builder was created.
+func (s *windowSchema) tableBuilder(cache execute.TableBuilderCache, key flux.GroupKey, bnds execute.Bounds) (execute.TableBuilder, error) {
+	builder, created := cache.TableBuilder(s.groupKey(key, bnds))
+	if created {
+		for _, c := range s.cols {
+			if _, err := builder.AddCol(c); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return builder, nil
+}
+
+// appendRow appends the row i of the column reader to the builder of the window with the given bounds.
+func (s *windowSchema) appendRow(builder execute.TableBuilder, cr flux.ColReader, i int, bnds execute.Bounds) error {
+	for j, c := range builder.Cols() {
+		switch c.Label {
+		case s.startCol:
+			if err := builder.AppendTime(s.startColIdx, bnds.Start); err != nil {
+				return err
+			}
+		case s.stopCol:
+			if err := builder.AppendTime(s.stopColIdx, bnds.Stop); err != nil {
+				return err
+			}
+		default:
+			if err := builder.AppendValue(j, execute.ValueForRow(cr, i, j)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (t *fixedWindowTransformation) clipBounds(bs []execute.Bounds) {
 	for i := range bs {
 		bs[i] = t.bounds.Intersect(bs[i])
@@ -34,9 +34,9 @@ func TestWindow_NewQuery(t *testing.T) {
 					{
 						ID: "window1",
 						Spec: &universe.WindowOpSpec{
-							Every:       flux.Duration(time.Hour),
-							Period:      flux.Duration(time.Hour),
-							Offset:      flux.Duration(time.Minute * -5),
+							Every:       values.FixedDuration(values.Duration(time.Hour)),
+							Period:      values.FixedDuration(values.Duration(time.Hour)),
+							Offset:      values.FixedDuration(values.Duration(time.Minute * -5)),
 							TimeColumn:  execute.DefaultTimeColLabel,
 							StartColumn: execute.DefaultStartColLabel,
 							StopColumn:  execute.DefaultStopColLabel,
@@ -48,6 +48,40 @@ func TestWindow_NewQuery(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "from with calendar window",
+			Raw:  `from(bucket:"mybucket") |> window(every:1mo, period:1y, offset:1d, location:"America/New_York")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mybucket",
+						},
+					},
+					{
+						ID: "window1",
+						Spec: &universe.WindowOpSpec{
+							Every:       values.CalendarDuration{Months: 1},
+							Period:      values.CalendarDuration{Months: 12},
+							Offset:      values.FixedDuration(values.Duration(24 * time.Hour)),
+							Location:    "America/New_York",
+							TimeColumn:  execute.DefaultTimeColLabel,
+							StartColumn: execute.DefaultStartColLabel,
+							StopColumn:  execute.DefaultStopColLabel,
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "window1"},
+				},
+			},
+		},
+		{
+			Name:    "window with unknown location",
+			Raw:     `from(bucket:"mybucket") |> window(every:1d, location:"Nowhere/Special")`,
+			WantErr: true,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -63,9 +97,21 @@ func TestWindowOperation_Marshaling(t *testing.T) {
 	op := &flux.Operation{
 		ID: "window",
 		Spec: &universe.WindowOpSpec{
-			Every:  flux.Duration(time.Minute),
-			Period: flux.Duration(time.Hour),
-			Offset: flux.Duration(30 * time.Minute),
+			Every:  values.FixedDuration(values.Duration(time.Minute)),
+			Period: values.FixedDuration(values.Duration(time.Hour)),
+			Offset: values.FixedDuration(values.Duration(30 * time.Minute)),
+		},
+	}
+
+	querytest.OperationMarshalingTestHelper(t, data, op)
+
+	data = []byte(`{"id":"window","kind":"window","spec":{"every":"3mo","period":"1mo1h0m0s","offset":"0s","location":"Europe/Paris"}}`)
+	op = &flux.Operation{
+		ID: "window",
+		Spec: &universe.WindowOpSpec{
+			Every:    values.CalendarDuration{Months: 3},
+			Period:   values.CalendarDuration{Months: 1, Nanoseconds: values.Duration(time.Hour)},
+			Location: "Europe/Paris",
 		},
 	}
 
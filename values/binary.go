@@ -74,9 +74,9 @@ var binaryFuncLookup = map[BinaryFuncSignature]BinaryFunction{
 		return NewString(l + r), nil
 	},
 	{Operator: ast.AdditionOperator, Left: semantic.Duration, Right: semantic.Duration}: func(lv, rv Value) (Value, error) {
-		l := lv.Duration()
-		r := rv.Duration()
-		return NewDuration(l + r), nil
+		l := CalendarDurationOf(lv)
+		r := CalendarDurationOf(rv)
+		return NewCalendarDuration(l.Add(r)), nil
 	},
 	{Operator: ast.AdditionOperator, Left: semantic.Nil, Right: semantic.Nil}: nil,
 	{Operator: ast.SubtractionOperator, Left: semantic.Int, Right: semantic.Int}: func(lv, rv Value) (Value, error) {
@@ -95,9 +95,9 @@ var binaryFuncLookup = map[BinaryFuncSignature]BinaryFunction{
 		return NewFloat(l - r), nil
 	},
 	{Operator: ast.SubtractionOperator, Left: semantic.Duration, Right: semantic.Duration}: func(lv, rv Value) (Value, error) {
-		l := lv.Duration()
-		r := rv.Duration()
-		return NewDuration(l - r), nil
+		l := CalendarDurationOf(lv)
+		r := CalendarDurationOf(rv)
+		return NewCalendarDuration(l.Add(r.Neg())), nil
 	},
 	{Operator: ast.SubtractionOperator, Left: semantic.Nil, Right: semantic.Nil}: nil,
 	{Operator: ast.MultiplicationOperator, Left: semantic.Int, Right: semantic.Int}: func(lv, rv Value) (Value, error) {
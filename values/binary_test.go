@@ -41,6 +41,7 @@ func TestBinaryOperator(t *testing.T) {
 		// duration + duration
 		{lhs: values.Duration(1), op: "+", rhs: values.Duration(2), want: values.Duration(3)},
 		{lhs: values.Duration(1), op: "+", rhs: durationNullValue, want: nil},
+		{lhs: values.CalendarDuration{Months: 1}, op: "+", rhs: values.Duration(2), want: values.CalendarDuration{Months: 1, Nanoseconds: 2}},
 		// null + null
 		{lhs: nil, op: "+", rhs: nil, want: nil},
 		// int - int
@@ -55,6 +56,7 @@ func TestBinaryOperator(t *testing.T) {
 		// duration - duration
 		{lhs: values.Duration(5), op: "-", rhs: values.Duration(3), want: values.Duration(2)},
 		{lhs: values.Duration(5), op: "-", rhs: durationNullValue, want: nil},
+		{lhs: values.CalendarDuration{Months: 2, Nanoseconds: 5}, op: "-", rhs: values.CalendarDuration{Months: 2}, want: values.Duration(5)},
 		// null - null
 		{lhs: nil, op: "-", rhs: nil, want: nil},
 		// int * int
@@ -1,6 +1,9 @@
 package values
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -78,3 +81,134 @@ func ParseDuration(s string) (Duration, error) {
 	}
 	return Duration(d), nil
 }
+
+// AverageMonth is the length of a calendar month, averaged over a year of 365.25 days.
+// It is used where a duration with calendar months must be converted to a fixed duration.
+const AverageMonth = Duration(365.25 / 12 * 24 * float64(time.Hour))
+
+// CalendarDuration is a duration made of a number of calendar months and a fixed number of nanoseconds.
+// The length of the months depends on the time the duration is added to.
+type CalendarDuration struct {
+	Months      int64
+	Nanoseconds Duration
+}
+
+// FixedDuration returns a calendar duration with only a fixed number of nanoseconds.
+func FixedDuration(d Duration) CalendarDuration {
+	return CalendarDuration{Nanoseconds: d}
+}
+
+// IsZero reports whether the duration has neither months nor nanoseconds.
+func (d CalendarDuration) IsZero() bool {
+	return d.Months == 0 && d.Nanoseconds == 0
+}
+
+// IsFixed reports whether the duration has no calendar months.
+func (d CalendarDuration) IsFixed() bool {
+	return d.Months == 0
+}
+
+// Approximate returns the duration with every month as long as the average month.
+func (d CalendarDuration) Approximate() Duration {
+	return Duration(d.Months)*AverageMonth + d.Nanoseconds
+}
+
+// Weeks returns the duration with the months converted to whole weeks,
+// the way durations were converted before they kept their calendar months.
+// A month is 4 weeks and a year is 52 weeks.
+func (d CalendarDuration) Weeks() Duration {
+	weeks := int64(float64(d.Months) * (365.25 / 12 / 7))
+	return Duration(weeks)*Duration(7*24*time.Hour) + d.Nanoseconds
+}
+
+// Add returns the sum of two durations.
+func (d CalendarDuration) Add(o CalendarDuration) CalendarDuration {
+	return CalendarDuration{
+		Months:      d.Months + o.Months,
+		Nanoseconds: d.Nanoseconds + o.Nanoseconds,
+	}
+}
+
+// Mul returns the duration multiplied by n.
+func (d CalendarDuration) Mul(n int64) CalendarDuration {
+	return CalendarDuration{
+		Months:      d.Months * n,
+		Nanoseconds: d.Nanoseconds * Duration(n),
+	}
+}
+
+// Neg returns the negated duration.
+func (d CalendarDuration) Neg() CalendarDuration {
+	return d.Mul(-1)
+}
+
+// String formats the duration as the months followed by the nanoseconds, e.g. "1mo2h0m0s".
+func (d CalendarDuration) String() string {
+	if d.Months == 0 {
+		return d.Nanoseconds.String()
+	}
+	s := strconv.FormatInt(d.Months, 10) + "mo"
+	if d.Nanoseconds != 0 {
+		s += d.Nanoseconds.String()
+	}
+	return s
+}
+
+func (d CalendarDuration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *CalendarDuration) UnmarshalText(data []byte) error {
+	cd, err := ParseCalendarDuration(string(data))
+	if err != nil {
+		return err
+	}
+	*d = cd
+	return nil
+}
+
+// ParseCalendarDuration parses a duration in the format of CalendarDuration.String.
+func ParseCalendarDuration(s string) (CalendarDuration, error) {
+	var d CalendarDuration
+	if i := strings.Index(s, "mo"); i >= 0 {
+		months, err := strconv.ParseInt(s[:i], 10, 64)
+		if err != nil {
+			return CalendarDuration{}, fmt.Errorf("invalid duration %q: %v", s, err)
+		}
+		d.Months = months
+		s = s[i+len("mo"):]
+		if s == "" {
+			return d, nil
+		}
+	}
+	ns, err := ParseDuration(s)
+	if err != nil {
+		return CalendarDuration{}, err
+	}
+	d.Nanoseconds = ns
+	return d, nil
+}
+
+// AddCalendar adds a calendar duration to the time. The months are added to the
+// date in the location, and the day of the month is kept, or clipped to the last
+// day of the month when the month is too short. A nil location is UTC.
+func (t Time) AddCalendar(d CalendarDuration, loc *time.Location) Time {
+	if d.Months != 0 {
+		if loc == nil {
+			loc = time.UTC
+		}
+		tm := time.Unix(0, int64(t)).In(loc)
+		year, month, day := tm.Date()
+		first := time.Date(year, month+time.Month(d.Months), 1, tm.Hour(), tm.Minute(), tm.Second(), tm.Nanosecond(), loc)
+		if last := daysIn(first.Year(), first.Month()); day > last {
+			day = last
+		}
+		t = ConvertTime(first.AddDate(0, 0, day-1))
+	}
+	return t.Add(d.Nanoseconds)
+}
+
+// daysIn returns the number of days in the month of the year.
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -81,3 +81,97 @@ func TestTime_Truncate(t *testing.T) {
 		})
 	}
 }
+
+func TestCalendarDuration_String(t *testing.T) {
+	for _, tt := range []struct {
+		d    values.CalendarDuration
+		want string
+	}{
+		{d: values.CalendarDuration{}, want: "0s"},
+		{d: values.CalendarDuration{Nanoseconds: values.Duration(time.Hour)}, want: "1h0m0s"},
+		{d: values.CalendarDuration{Months: 14}, want: "14mo"},
+		{d: values.CalendarDuration{Months: -1, Nanoseconds: values.Duration(90 * time.Minute)}, want: "-1mo1h30m0s"},
+		{d: values.CalendarDuration{Months: 1, Nanoseconds: -values.Duration(time.Second)}, want: "1mo-1s"},
+	} {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.d.String(); got != tt.want {
+				t.Fatalf("unexpected string: want %q, got %q", tt.want, got)
+			}
+			d, err := values.ParseCalendarDuration(tt.want)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d != tt.d {
+				t.Fatalf("unexpected duration: want %v, got %v", tt.d, d)
+			}
+		})
+	}
+}
+
+func TestTime_AddCalendar(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	for _, tt := range []struct {
+		name string
+		ts   string
+		d    values.CalendarDuration
+		loc  *time.Location
+		want string
+	}{
+		{
+			name: "month",
+			ts:   "2019-01-15T10:00:00Z",
+			d:    values.CalendarDuration{Months: 1, Nanoseconds: values.Duration(time.Hour)},
+			want: "2019-02-15T11:00:00Z",
+		},
+		{
+			name: "end of month",
+			ts:   "2019-01-31T00:00:00Z",
+			d:    values.CalendarDuration{Months: 1},
+			want: "2019-02-28T00:00:00Z",
+		},
+		{
+			name: "leap year",
+			ts:   "2020-02-29T00:00:00Z",
+			d:    values.CalendarDuration{Months: -12},
+			want: "2019-02-28T00:00:00Z",
+		},
+		{
+			name: "location",
+			ts:   "2019-03-01T05:00:00Z",
+			d:    values.CalendarDuration{Months: 1},
+			loc:  newYork,
+			want: "2019-04-01T04:00:00Z",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ts, err := time.Parse(time.RFC3339, tt.ts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := values.ConvertTime(ts).AddCalendar(tt.d, tt.loc).Time().Format(time.RFC3339)
+			if got != tt.want {
+				t.Fatalf("unexpected time: want %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCalendarDuration_Weeks(t *testing.T) {
+	day := values.Duration(24 * time.Hour)
+	for _, tt := range []struct {
+		d    values.CalendarDuration
+		want values.Duration
+	}{
+		{d: values.CalendarDuration{Nanoseconds: values.Duration(time.Hour)}, want: values.Duration(time.Hour)},
+		{d: values.CalendarDuration{Months: 1}, want: 28 * day},
+		{d: values.CalendarDuration{Months: 12}, want: 364 * day},
+		{d: values.CalendarDuration{Months: -1, Nanoseconds: values.Duration(time.Hour)}, want: -28*day + values.Duration(time.Hour)},
+	} {
+		if got := tt.d.Weeks(); got != tt.want {
+			t.Errorf("unexpected duration of %v: want %v, got %v", tt.d, tt.want, got)
+		}
+	}
+}
@@ -76,6 +76,9 @@ func (v value) Time() Time {
 }
 func (v value) Duration() Duration {
 	CheckKind(v.t.Nature(), semantic.Duration)
+	if d, ok := v.v.(CalendarDuration); ok {
+		return d.Weeks()
+	}
 	return v.v.(Duration)
 }
 func (v value) Regexp() *regexp.Regexp {
@@ -117,7 +120,7 @@ func (v value) Equal(r Value) bool {
 	case semantic.Time:
 		return v.Time() == r.Time()
 	case semantic.Duration:
-		return v.Duration() == r.Duration()
+		return CalendarDurationOf(v) == CalendarDurationOf(r)
 	case semantic.Regexp:
 		return v.Regexp().String() == r.Regexp().String()
 	case semantic.Object:
@@ -165,6 +168,8 @@ func New(v interface{}) Value {
 		return NewTime(v)
 	case Duration:
 		return NewDuration(v)
+	case CalendarDuration:
+		return NewCalendarDuration(v)
 	case *regexp.Regexp:
 		return NewRegexp(v)
 	default:
@@ -264,6 +269,28 @@ func NewDuration(v Duration) Value {
 		v: v,
 	}
 }
+
+// NewCalendarDuration creates a duration value that keeps its calendar months.
+// The Duration method of the value converts the months to whole weeks.
+func NewCalendarDuration(v CalendarDuration) Value {
+	if v.IsFixed() {
+		return NewDuration(v.Nanoseconds)
+	}
+	return value{
+		t: semantic.Duration,
+		v: v,
+	}
+}
+
+// CalendarDurationOf returns the calendar months and the nanoseconds of a duration value.
+func CalendarDurationOf(v Value) CalendarDuration {
+	if v, ok := v.(value); ok {
+		if d, ok := v.v.(CalendarDuration); ok {
+			return d
+		}
+	}
+	return FixedDuration(v.Duration())
+}
 func NewRegexp(v *regexp.Regexp) Value {
 	return value{
 		t: semantic.Regexp,